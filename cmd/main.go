@@ -2,16 +2,20 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	applicationgateways "github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
-	"github.com/jackc/pgx/v5"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/workers"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 )
 
@@ -22,7 +26,7 @@ func main() {
 		panic("environment variable 'AWS_REGION' not set")
 	}
 
-	awsConfig, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion(os.Getenv("AWS_REGION")))
+	awsConfig, err := config.LoadDefaultConfig(ctx, config.WithRegion(os.Getenv("AWS_REGION")))
 	if err != nil {
 		panic(err)
 	}
@@ -32,12 +36,12 @@ func main() {
 		SecretManager: secretManager,
 	}
 
-	dbUrl, err := awsSecretManagerGateway.Get("DATABASE_URL")
+	dbUrl, err := awsSecretManagerGateway.Get(ctx, "DATABASE_URL")
 	if err != nil {
 		panic(err)
 	}
 
-	dbConn, err := pgx.Connect(ctx, os.Getenv(dbUrl))
+	dbPool, err := pgxpool.New(ctx, os.Getenv(dbUrl))
 	if err != nil {
 		panic(err)
 	}
@@ -45,34 +49,752 @@ func main() {
 	validator := infra.NewValidator()
 
 	cartRepository := repositories.CartRepository{
-		Conn: dbConn,
+		Conn: dbPool,
 	}
 
 	customerGateway := gateways.CustomerGateway{
-		Conn: dbConn,
+		Conn: dbPool,
 	}
 
 	productGateway := gateways.ProductGateway{
-		Conn: dbConn,
+		Conn: dbPool,
+	}
+
+	inventoryGateway := gateways.InventoryGateway{
+		Conn: dbPool,
+	}
+
+	promotionRepository := repositories.PromotionRepository{
+		Conn: dbPool,
+	}
+
+	clockGateway := gateways.SystemClockGateway{}
+
+	if _, ok := os.LookupEnv("EXCHANGE_RATES_FILE"); !ok {
+		panic("environment variable 'EXCHANGE_RATES_FILE' not set")
+	}
+
+	exchangeRateGateway := gateways.FileExchangeRateGateway{
+		Path: os.Getenv("EXCHANGE_RATES_FILE"),
+	}
+
+	err = exchangeRateGateway.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	currencyConverter := usecases.CurrencyConverter{
+		ExchangeRateGateway: &exchangeRateGateway,
+		ClockGateway:        &clockGateway,
+		Ttl:                 time.Hour,
+		MaxAge:              48 * time.Hour,
+	}
+
+	taxRulesPath := "config/tax-rules.json"
+	if path, ok := os.LookupEnv("TAX_RULES_FILE"); ok {
+		taxRulesPath = path
+	}
+
+	taxRuleGateway := gateways.FileTaxRuleGateway{
+		Path: taxRulesPath,
+	}
+
+	err = taxRuleGateway.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	taxCalculator := usecases.TaxCalculator{
+		TaxRuleGateway: &taxRuleGateway,
+	}
+
+	shippingRateGateway := gateways.TableShippingRateGateway{
+		Methods: gateways.DefaultShippingMethods,
+	}
+
+	shippingQuoter := usecases.ShippingQuoter{
+		ShippingRateGateway: &shippingRateGateway,
+	}
+
+	cartTokenGateway := gateways.HmacCartTokenGateway{
+		SecretManagerGateway: &awsSecretManagerGateway,
 	}
 
 	addProductToCart := usecases.AddProductToCart{
+		CustomerGateway:  &customerGateway,
+		ProductGateway:   &productGateway,
+		InventoryGateway: &inventoryGateway,
+		CartRepository:   &cartRepository,
+		CartTokenGateway: &cartTokenGateway,
+	}
+
+	removeProductFromCart := usecases.RemoveProductFromCart{
+		CustomerGateway:  &customerGateway,
+		CartRepository:   &cartRepository,
+		CartTokenGateway: &cartTokenGateway,
+	}
+
+	updateCartItemQuantity := usecases.UpdateCartItemQuantity{
+		CustomerGateway:  &customerGateway,
+		InventoryGateway: &inventoryGateway,
+		CartRepository:   &cartRepository,
+		CartTokenGateway: &cartTokenGateway,
+	}
+
+	getCustomerCart := usecases.GetCustomerCart{
+		ClockGateway:        &clockGateway,
+		ProductGateway:      &productGateway,
+		CartRepository:      &cartRepository,
+		PromotionRepository: &promotionRepository,
+		CurrencyConverter:   &currencyConverter,
+		TaxCalculator:       &taxCalculator,
+		ShippingQuoter:      &shippingQuoter,
+		CartTokenGateway:    &cartTokenGateway,
+	}
+
+	applyCouponToCart := usecases.ApplyCouponToCart{
+		CustomerGateway:     &customerGateway,
+		ClockGateway:        &clockGateway,
+		CartRepository:      &cartRepository,
+		PromotionRepository: &promotionRepository,
+	}
+
+	removeCouponFromCart := usecases.RemoveCouponFromCart{
 		CustomerGateway: &customerGateway,
-		ProductGateway:  &productGateway,
 		CartRepository:  &cartRepository,
 	}
 
-	addProductToCartHandler := handlers.SecurityHandlerDecorator{
+	listShippingMethods := usecases.ListShippingMethods{
+		ProductGateway:      &productGateway,
+		ClockGateway:        &clockGateway,
+		CartRepository:      &cartRepository,
+		PromotionRepository: &promotionRepository,
+		ShippingQuoter:      &shippingQuoter,
+	}
+
+	selectShippingMethod := usecases.SelectShippingMethod{
+		CustomerGateway:     &customerGateway,
+		ProductGateway:      &productGateway,
+		ClockGateway:        &clockGateway,
+		CartRepository:      &cartRepository,
+		PromotionRepository: &promotionRepository,
+		ShippingQuoter:      &shippingQuoter,
+	}
+
+	orderRepository := repositories.OrderRepository{
+		Conn: dbPool,
+	}
+
+	if os.Getenv("PAYMENT_GATEWAY") != "fake" {
+		panic("environment variable 'PAYMENT_GATEWAY' must be set to 'fake', the only payment gateway available")
+	}
+
+	paymentGateway := gateways.NewFakePaymentGateway()
+
+	addressBookRepository := repositories.AddressBookRepository{
+		Conn: dbPool,
+	}
+
+	addAddress := usecases.AddAddress{
+		CustomerGateway:       &customerGateway,
+		AddressBookRepository: &addressBookRepository,
+	}
+
+	updateAddress := usecases.UpdateAddress{
+		CustomerGateway:       &customerGateway,
+		AddressBookRepository: &addressBookRepository,
+	}
+
+	removeAddress := usecases.RemoveAddress{
+		CustomerGateway:       &customerGateway,
+		AddressBookRepository: &addressBookRepository,
+	}
+
+	listAddresses := usecases.ListAddresses{
+		CustomerGateway:       &customerGateway,
+		AddressBookRepository: &addressBookRepository,
+	}
+
+	unitOfWork := repositories.PgxUnitOfWork{
+		Pool: dbPool,
+	}
+
+	checkout := usecases.Checkout{
+		CustomerGateway:       &customerGateway,
+		ProductGateway:        &productGateway,
+		InventoryGateway:      &inventoryGateway,
+		PaymentGateway:        paymentGateway,
+		ClockGateway:          &clockGateway,
+		CartRepository:        &cartRepository,
+		OrderRepository:       &orderRepository,
+		PromotionRepository:   &promotionRepository,
+		AddressBookRepository: &addressBookRepository,
+		UnitOfWork:            &unitOfWork,
+		TaxCalculator:         &taxCalculator,
+		ShippingQuoter:        &shippingQuoter,
+	}
+
+	changeOrderStatus := usecases.ChangeOrderStatus{
+		ClockGateway:    &clockGateway,
+		OrderRepository: &orderRepository,
+	}
+
+	getOrderStatusHistory := usecases.GetOrderStatusHistory{
+		OrderRepository: &orderRepository,
+	}
+
+	refundOrder := usecases.RefundOrder{
+		ClockGateway:    &clockGateway,
+		PaymentGateway:  paymentGateway,
+		OrderRepository: &orderRepository,
+		UnitOfWork:      &unitOfWork,
+	}
+
+	customerRepository := repositories.CustomerRepository{
+		Conn: dbPool,
+	}
+
+	refreshTokenRepository := repositories.RefreshTokenRepository{
+		Conn: dbPool,
+	}
+
+	passwordHasherGateway := gateways.BcryptPasswordHasherGateway{}
+
+	accessTokenIssuer := "ecommerce-go"
+	if issuer, ok := os.LookupEnv("AUTH_ACCESS_TOKEN_ISSUER"); ok {
+		accessTokenIssuer = issuer
+	}
+
+	accessTokenAudience := "ecommerce-go-api"
+	if audience, ok := os.LookupEnv("AUTH_ACCESS_TOKEN_AUDIENCE"); ok {
+		accessTokenAudience = audience
+	}
+
+	accessTokenGateway := gateways.JwtAccessTokenGateway{
 		SecretManagerGateway: &awsSecretManagerGateway,
+		Issuer:               accessTokenIssuer,
+		Audience:             accessTokenAudience,
+	}
+
+	var publicKeyGateway applicationgateways.IPublicKeyGateway
+	if path, ok := os.LookupEnv("AUTH_JWKS_FILE"); ok {
+		publicKeyGateway = gateways.NewFileJwksPublicKeyGateway(path, &clockGateway, 10*time.Minute)
+	}
+
+	if url, ok := os.LookupEnv("AUTH_JWKS_URL"); ok {
+		publicKeyGateway = gateways.NewUrlJwksPublicKeyGateway(url, &http.Client{Timeout: 5 * time.Second}, &clockGateway, 10*time.Minute)
+	}
+
+	accessTokenDenylistGateway := gateways.CachedAccessTokenDenylistGateway{
+		AccessTokenDenylistGateway: &gateways.AccessTokenDenylistGateway{
+			Conn: dbPool,
+		},
+		ClockGateway: &clockGateway,
+		Ttl:          30 * time.Second,
+	}
+
+	signUp := usecases.SignUp{
+		PasswordHasherGateway: &passwordHasherGateway,
+		CustomerRepository:    &customerRepository,
+	}
+
+	mergeCarts := usecases.MergeCarts{
+		CartRepository:   &cartRepository,
+		CartTokenGateway: &cartTokenGateway,
+		UnitOfWork:       &unitOfWork,
+	}
+
+	login := usecases.Login{
+		PasswordHasherGateway:  &passwordHasherGateway,
+		AccessTokenGateway:     &accessTokenGateway,
+		ClockGateway:           &clockGateway,
+		CustomerRepository:     &customerRepository,
+		RefreshTokenRepository: &refreshTokenRepository,
+		MergeCarts:             &mergeCarts,
+	}
+
+	refreshSession := usecases.RefreshSession{
+		AccessTokenGateway:     &accessTokenGateway,
+		ClockGateway:           &clockGateway,
+		CustomerRepository:     &customerRepository,
+		RefreshTokenRepository: &refreshTokenRepository,
+	}
+
+	logout := usecases.Logout{
+		ClockGateway:           &clockGateway,
+		RefreshTokenRepository: &refreshTokenRepository,
+	}
+
+	revokeAccessToken := usecases.RevokeAccessToken{
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+	}
+
+	productRepository := repositories.ProductRepository{
+		Conn: dbPool,
+	}
+
+	createProduct := usecases.CreateProduct{
+		ProductRepository: &productRepository,
+		UnitOfWork:        &unitOfWork,
+	}
+
+	updateProduct := usecases.UpdateProduct{
+		ProductRepository: &productRepository,
+	}
+
+	archiveProduct := usecases.ArchiveProduct{
+		ProductRepository: &productRepository,
+	}
+
+	listProducts := usecases.ListProducts{
+		ProductRepository: &productRepository,
+		CurrencyConverter: &currencyConverter,
+	}
+
+	signUpHandler := handlers.SignUpHandler{
+		Validator: validator,
+		SignUp:    &signUp,
+	}
+
+	loginHandler := handlers.LoginHandler{
+		Validator: validator,
+		Login:     &login,
+	}
+
+	refreshSessionHandler := handlers.RefreshSessionHandler{
+		Validator:      validator,
+		RefreshSession: &refreshSession,
+	}
+
+	logoutHandler := handlers.LogoutHandler{
+		Validator: validator,
+		Logout:    &logout,
+	}
+
+	addProductToCartHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		AllowAnonymous:             true,
 		HttpHandler: &handlers.AddProductToCartHandler{
 			Validator:        validator,
 			AddProductToCart: &addProductToCart,
 		},
 	}
 
+	removeProductFromCartHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		AllowAnonymous:             true,
+		HttpHandler: &handlers.RemoveProductFromCartHandler{
+			Validator:             validator,
+			RemoveProductFromCart: &removeProductFromCart,
+		},
+	}
+
+	updateCartItemQuantityHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		AllowAnonymous:             true,
+		HttpHandler: &handlers.UpdateCartItemQuantityHandler{
+			Validator:              validator,
+			UpdateCartItemQuantity: &updateCartItemQuantity,
+		},
+	}
+
+	getCustomerCartHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		AllowAnonymous:             true,
+		HttpHandler: &handlers.GetCustomerCartHandler{
+			GetCustomerCart: &getCustomerCart,
+		},
+	}
+
+	applyCouponToCartHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ApplyCouponToCartHandler{
+			Validator:         validator,
+			ApplyCouponToCart: &applyCouponToCart,
+		},
+	}
+
+	removeCouponFromCartHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.RemoveCouponFromCartHandler{
+			RemoveCouponFromCart: &removeCouponFromCart,
+		},
+	}
+
+	listShippingMethodsHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ListShippingMethodsHandler{
+			ListShippingMethods: &listShippingMethods,
+		},
+	}
+
+	selectShippingMethodHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"cart"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.SelectShippingMethodHandler{
+			Validator:            validator,
+			SelectShippingMethod: &selectShippingMethod,
+		},
+	}
+
+	addAddressHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"addresses"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.AddAddressHandler{
+			Validator:  validator,
+			AddAddress: &addAddress,
+		},
+	}
+
+	updateAddressHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"addresses"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.UpdateAddressHandler{
+			Validator:     validator,
+			UpdateAddress: &updateAddress,
+		},
+	}
+
+	removeAddressHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"addresses"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.RemoveAddressHandler{
+			Validator:     validator,
+			RemoveAddress: &removeAddress,
+		},
+	}
+
+	listAddressesHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"addresses"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ListAddressesHandler{
+			ListAddresses: &listAddresses,
+		},
+	}
+
+	checkoutHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"customer"}, Scopes: []string{"checkout"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.CheckoutHandler{
+			Validator: validator,
+			Checkout:  &checkout,
+		},
+	}
+
+	changeOrderStatusHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"orders"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ChangeOrderStatusHandler{
+			Validator:         validator,
+			ChangeOrderStatus: &changeOrderStatus,
+		},
+	}
+
+	getOrderStatusHistoryHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"orders"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.GetOrderStatusHistoryHandler{
+			Validator:             validator,
+			GetOrderStatusHistory: &getOrderStatusHistory,
+		},
+	}
+
+	refundOrderHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"orders"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.RefundOrderHandler{
+			Validator:   validator,
+			RefundOrder: &refundOrder,
+		},
+	}
+
+	revokeAccessTokenHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"tokens"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.RevokeAccessTokenHandler{
+			Validator:         validator,
+			RevokeAccessToken: &revokeAccessToken,
+		},
+	}
+
+	createProductHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.CreateProductHandler{
+			Validator:     validator,
+			CreateProduct: &createProduct,
+		},
+	}
+
+	updateProductHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.UpdateProductHandler{
+			Validator:     validator,
+			UpdateProduct: &updateProduct,
+		},
+	}
+
+	archiveProductHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ArchiveProductHandler{
+			Validator:      validator,
+			ArchiveProduct: &archiveProduct,
+		},
+	}
+
+	listProductsHandler := handlers.SecurityHandlerDecorator{
+		Policy:                     handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}},
+		SecretManagerGateway:       &awsSecretManagerGateway,
+		PublicKeyGateway:           publicKeyGateway,
+		AccessTokenDenylistGateway: &accessTokenDenylistGateway,
+		ClockGateway:               &clockGateway,
+		Issuer:                     accessTokenIssuer,
+		Audience:                   accessTokenAudience,
+		Leeway:                     30 * time.Second,
+		HttpHandler: &handlers.ListProductsHandler{
+			ListProducts: &listProducts,
+		},
+	}
+
+	reservationSweeper := workers.ReservationSweeper{
+		Interval: time.Minute,
+		ReleaseExpiredReservations: &usecases.ReleaseExpiredReservations{
+			InventoryGateway: &inventoryGateway,
+			ClockGateway:     &clockGateway,
+		},
+	}
+
+	go reservationSweeper.Start(ctx)
+
 	e := echo.New()
 
+	e.POST("/sign-up", func(c echo.Context) error {
+		return signUpHandler.Handle(c)
+	})
+
+	e.POST("/login", func(c echo.Context) error {
+		return loginHandler.Handle(c)
+	})
+
+	e.POST("/refresh-token", func(c echo.Context) error {
+		return refreshSessionHandler.Handle(c)
+	})
+
+	e.POST("/logout", func(c echo.Context) error {
+		return logoutHandler.Handle(c)
+	})
+
 	e.GET("/add-product-to-cart", func(c echo.Context) error {
 		return addProductToCartHandler.Handle(c)
 	})
+
+	e.DELETE("/remove-product-from-cart", func(c echo.Context) error {
+		return removeProductFromCartHandler.Handle(c)
+	})
+
+	e.PATCH("/update-cart-item-quantity", func(c echo.Context) error {
+		return updateCartItemQuantityHandler.Handle(c)
+	})
+
+	e.GET("/get-customer-cart", func(c echo.Context) error {
+		return getCustomerCartHandler.Handle(c)
+	})
+
+	e.POST("/apply-coupon-to-cart", func(c echo.Context) error {
+		return applyCouponToCartHandler.Handle(c)
+	})
+
+	e.DELETE("/remove-coupon-from-cart", func(c echo.Context) error {
+		return removeCouponFromCartHandler.Handle(c)
+	})
+
+	e.GET("/list-shipping-methods", func(c echo.Context) error {
+		return listShippingMethodsHandler.Handle(c)
+	})
+
+	e.POST("/select-shipping-method", func(c echo.Context) error {
+		return selectShippingMethodHandler.Handle(c)
+	})
+
+	e.POST("/add-address", func(c echo.Context) error {
+		return addAddressHandler.Handle(c)
+	})
+
+	e.PUT("/update-address", func(c echo.Context) error {
+		return updateAddressHandler.Handle(c)
+	})
+
+	e.DELETE("/remove-address", func(c echo.Context) error {
+		return removeAddressHandler.Handle(c)
+	})
+
+	e.GET("/list-addresses", func(c echo.Context) error {
+		return listAddressesHandler.Handle(c)
+	})
+
+	e.POST("/checkout", func(c echo.Context) error {
+		return checkoutHandler.Handle(c)
+	})
+
+	e.POST("/admin/change-order-status", func(c echo.Context) error {
+		return changeOrderStatusHandler.Handle(c)
+	})
+
+	e.GET("/admin/get-order-status-history", func(c echo.Context) error {
+		return getOrderStatusHistoryHandler.Handle(c)
+	})
+
+	e.POST("/admin/refund-order", func(c echo.Context) error {
+		return refundOrderHandler.Handle(c)
+	})
+
+	e.POST("/admin/revoke-access-token", func(c echo.Context) error {
+		return revokeAccessTokenHandler.Handle(c)
+	})
+
+	e.POST("/admin/create-product", func(c echo.Context) error {
+		return createProductHandler.Handle(c)
+	})
+
+	e.PUT("/admin/update-product", func(c echo.Context) error {
+		return updateProductHandler.Handle(c)
+	})
+
+	e.POST("/admin/archive-product", func(c echo.Context) error {
+		return archiveProductHandler.Handle(c)
+	})
+
+	e.GET("/admin/list-products", func(c echo.Context) error {
+		return listProductsHandler.Handle(c)
+	})
 }
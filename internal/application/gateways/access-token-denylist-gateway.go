@@ -0,0 +1,11 @@
+package gateways
+
+import (
+	"context"
+	"time"
+)
+
+type IAccessTokenDenylistGateway interface {
+	IsRevoked(ctx context.Context, tokenId string) (bool, error)
+	Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error
+}
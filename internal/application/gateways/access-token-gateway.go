@@ -0,0 +1,20 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenClaimsDTO struct {
+	CustomerId uuid.UUID
+	Roles      []string
+	Scopes     []string
+	IssuedAt   time.Time
+	ExpiresAt  time.Time
+}
+
+type IAccessTokenGateway interface {
+	Issue(ctx context.Context, claims AccessTokenClaimsDTO) (string, error)
+}
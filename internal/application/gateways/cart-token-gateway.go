@@ -0,0 +1,12 @@
+package gateways
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ICartTokenGateway interface {
+	Issue(ctx context.Context, cartId uuid.UUID) (string, error)
+	Verify(ctx context.Context, cartToken string) (*uuid.UUID, error)
+}
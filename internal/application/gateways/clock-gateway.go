@@ -0,0 +1,7 @@
+package gateways
+
+import "time"
+
+type IClockGateway interface {
+	Now() time.Time
+}
@@ -1,7 +1,11 @@
 package gateways
 
-import "github.com/google/uuid"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type ICustomerGateway interface {
-	ExistsById(customerId uuid.UUID) (bool, error)
+	ExistsById(ctx context.Context, customerId uuid.UUID) (bool, error)
 }
@@ -0,0 +1,17 @@
+package gateways
+
+import (
+	"context"
+	"time"
+)
+
+type ExchangeRateDTO struct {
+	From string
+	To   string
+	Rate string
+	AsOf time.Time
+}
+
+type IExchangeRateGateway interface {
+	FindRate(ctx context.Context, from string, to string) (*ExchangeRateDTO, error)
+}
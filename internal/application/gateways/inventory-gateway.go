@@ -0,0 +1,32 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var ErrStockReservationExpired = errs.Conflict("STOCK_RESERVATION_EXPIRED", "stock reservation expired")
+
+type InventoryDTO struct {
+	ProductId uuid.UUID
+	OnHand    int32
+	Reserved  int32
+}
+
+type StockReservationItemDTO struct {
+	ProductId uuid.UUID
+	Quantity  int32
+}
+
+type IInventoryGateway interface {
+	Create(ctx context.Context, inventory InventoryDTO) error
+	FindOneByProductId(ctx context.Context, productId uuid.UUID) (*InventoryDTO, error)
+	Reserve(ctx context.Context, reservationId uuid.UUID, items []StockReservationItemDTO, expiresAt time.Time) error
+	Release(ctx context.Context, reservationId uuid.UUID) error
+	Commit(ctx context.Context, reservationId uuid.UUID, now time.Time) error
+	ReleaseExpired(ctx context.Context, now time.Time) (int, error)
+	Restock(ctx context.Context, items []StockReservationItemDTO) error
+}
@@ -0,0 +1,6 @@
+package gateways
+
+type IPasswordHasherGateway interface {
+	Hash(password string) (string, error)
+	Compare(passwordHash string, password string) (bool, error)
+}
@@ -0,0 +1,29 @@
+package gateways
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrPaymentAmountInvalid         = errs.Validation("PAYMENT_AMOUNT_INVALID", "payment amount must be greater than zero")
+	ErrPaymentDeclined              = errs.PaymentRequired("PAYMENT_DECLINED", "payment declined")
+	ErrPaymentProviderTimeout       = errs.Unavailable("PAYMENT_PROVIDER_TIMEOUT", "payment provider timeout")
+	ErrPaymentAuthorizationNotFound = errs.NotFound("PAYMENT_AUTHORIZATION_NOT_FOUND", "payment authorization not found")
+	ErrPaymentCannotBeCaptured      = errs.Conflict("PAYMENT_CANNOT_BE_CAPTURED", "payment cannot be captured")
+	ErrPaymentCannotBeVoided        = errs.Conflict("PAYMENT_CANNOT_BE_VOIDED", "payment cannot be voided")
+	ErrPaymentCannotBeRefunded      = errs.Conflict("PAYMENT_CANNOT_BE_REFUNDED", "payment cannot be refunded")
+)
+
+type PaymentAuthorizationDTO struct {
+	Id     string
+	Amount int64
+}
+
+type IPaymentGateway interface {
+	Authorize(ctx context.Context, cardNumber string, amount int64) (*PaymentAuthorizationDTO, error)
+	Capture(ctx context.Context, authorizationId string, amount int64) error
+	Void(ctx context.Context, authorizationId string) error
+	Refund(ctx context.Context, authorizationId string, amount int64) error
+}
@@ -1,12 +1,22 @@
 package gateways
 
-import "github.com/google/uuid"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type ProductDTO struct {
-	Id    uuid.UUID
-	Price int64
+	Id          uuid.UUID
+	Price       int64
+	Currency    string
+	TaxClass    string
+	WeightGrams int32
+	LengthCm    int32
+	WidthCm     int32
+	HeightCm    int32
 }
 
 type IProductGateway interface {
-	FindOneById(id uuid.UUID) (*ProductDTO, error)
+	FindOneById(ctx context.Context, id uuid.UUID) (*ProductDTO, error)
 }
@@ -0,0 +1,16 @@
+package gateways
+
+import (
+	"context"
+	"crypto"
+)
+
+type PublicKeyDTO struct {
+	KeyId     string
+	Algorithm string
+	Key       crypto.PublicKey
+}
+
+type IPublicKeyGateway interface {
+	FindPublicKey(ctx context.Context, keyId string) (*PublicKeyDTO, error)
+}
@@ -1,5 +1,9 @@
 package gateways
 
+import (
+	"context"
+)
+
 type ISecretManagerGateway interface {
-	Get(key string) (string, error)
+	Get(ctx context.Context, key string) (string, error)
 }
@@ -0,0 +1,26 @@
+package gateways
+
+import (
+	"context"
+)
+
+type ShippingWeightBandDTO struct {
+	MaxWeightGrams int64
+	Rate           int64
+}
+
+type ShippingMethodDTO struct {
+	Code              string
+	Name              string
+	RateType          string
+	Currency          string
+	Rate              int64
+	WeightBands       []ShippingWeightBandDTO
+	FreeOverThreshold int64
+	VolumetricDivisor int64
+}
+
+type IShippingRateGateway interface {
+	FindAll(ctx context.Context) ([]ShippingMethodDTO, error)
+	FindOneByCode(ctx context.Context, code string) (*ShippingMethodDTO, error)
+}
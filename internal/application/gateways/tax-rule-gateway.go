@@ -0,0 +1,17 @@
+package gateways
+
+import (
+	"context"
+)
+
+type TaxRuleDTO struct {
+	Country   string
+	Region    string
+	TaxClass  string
+	Rate      string
+	Inclusive bool
+}
+
+type ITaxRuleGateway interface {
+	FindByCountry(ctx context.Context, country string) ([]TaxRuleDTO, error)
+}
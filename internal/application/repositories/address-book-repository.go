@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+)
+
+type IAddressBookRepository interface {
+	Save(ctx context.Context, addressBook address.AddressBook) error
+	FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*address.AddressBook, error)
+}
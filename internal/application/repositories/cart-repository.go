@@ -1,12 +1,32 @@
 package repositories
 
 import (
+	"context"
+
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
 )
 
+var ErrCartVersionConflict = errs.Conflict("CART_VERSION_CONFLICT", "cart version conflict")
+
+type CartVersionConflictError struct {
+	CartId  uuid.UUID
+	Version int32
+}
+
+func (c *CartVersionConflictError) Error() string {
+	return ErrCartVersionConflict.Error()
+}
+
+func (c *CartVersionConflictError) Unwrap() error {
+	return ErrCartVersionConflict
+}
+
 type ICartRepository interface {
-	Create(cart cart.Cart) error
-	Update(cart cart.Cart) error
-	FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart, error)
+	Create(ctx context.Context, cart cart.Cart) error
+	Update(ctx context.Context, cart cart.Cart) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindOneById(ctx context.Context, id uuid.UUID) (*cart.Cart, error)
+	FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*cart.Cart, error)
 }
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+var ErrCustomerEmailAlreadyInUse = errs.Conflict("CUSTOMER_EMAIL_ALREADY_IN_USE", "customer email already in use")
+
+type ICustomerRepository interface {
+	Create(ctx context.Context, customer customer.Customer) error
+	FindOneById(ctx context.Context, id uuid.UUID) (*customer.Customer, error)
+	FindOneByEmail(ctx context.Context, email string) (*customer.Customer, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+)
+
+var ErrOrderStatusConflict = errs.Conflict("ORDER_STATUS_CONFLICT", "order status was changed by another request")
+
+type IOrderRepository interface {
+	CreateFromCart(ctx context.Context, order order.Order, checkedOutCart cart.Cart) error
+	Update(ctx context.Context, order order.Order, previousStatus order.OrderStatus) error
+	FindOneById(ctx context.Context, id uuid.UUID) (*order.Order, error)
+}
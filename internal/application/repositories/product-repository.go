@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+)
+
+type IProductRepository interface {
+	Create(ctx context.Context, product product.Product) error
+	Update(ctx context.Context, product product.Product) error
+	FindOneById(ctx context.Context, id uuid.UUID) (*product.Product, error)
+	FindOneBySku(ctx context.Context, sku string) (*product.Product, error)
+	FindAll(ctx context.Context) ([]product.Product, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+)
+
+type IPromotionRepository interface {
+	FindOneByCode(ctx context.Context, code string) (*promotion.Promotion, error)
+}
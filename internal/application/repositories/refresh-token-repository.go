@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+var ErrRefreshTokenReused = errs.Unauthorized("REFRESH_TOKEN_REUSED", "refresh token was reused")
+
+type IRefreshTokenRepository interface {
+	Create(ctx context.Context, refreshToken customer.RefreshToken) error
+	Rotate(ctx context.Context, current customer.RefreshToken, next customer.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyId uuid.UUID, revokedAt time.Time) error
+	FindOneByTokenHash(ctx context.Context, tokenHash string) (*customer.RefreshToken, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type UnitOfWorkRepositories struct {
+	CartRepository        ICartRepository
+	OrderRepository       IOrderRepository
+	ProductRepository     IProductRepository
+	PromotionRepository   IPromotionRepository
+	AddressBookRepository IAddressBookRepository
+	InventoryGateway      gateways.IInventoryGateway
+}
+
+type IUnitOfWork interface {
+	Execute(ctx context.Context, work func(repositories UnitOfWorkRepositories) error) error
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+)
+
+type AddAddressInput struct {
+	CustomerId      uuid.UUID
+	RecipientName   string
+	Line1           string
+	Line2           string
+	City            string
+	Region          string
+	PostalCode      string
+	Country         string
+	DefaultShipping bool
+	DefaultBilling  bool
+}
+
+type AddAddressOutput struct {
+	AddressId uuid.UUID
+}
+
+type IAddAddress interface {
+	Execute(ctx context.Context, input AddAddressInput) (AddAddressOutput, error)
+}
+
+type AddAddress struct {
+	CustomerGateway       gateways.ICustomerGateway
+	AddressBookRepository repositories.IAddressBookRepository
+}
+
+func (a *AddAddress) Execute(ctx context.Context, input AddAddressInput) (AddAddressOutput, error) {
+	customerExists, err := a.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return AddAddressOutput{}, err
+	}
+
+	if !customerExists {
+		return AddAddressOutput{}, ErrCustomerNotFound
+	}
+
+	addressBook, err := a.AddressBookRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return AddAddressOutput{}, err
+	}
+
+	if addressBook == nil {
+		newAddressBook := address.NewAddressBook(input.CustomerId)
+		addressBook = &newAddressBook
+	}
+
+	newAddress, err := address.NewAddress(input.RecipientName, input.Line1, input.Line2, input.City, input.Region,
+		input.PostalCode, input.Country)
+	if err != nil {
+		return AddAddressOutput{}, err
+	}
+
+	err = addressBook.Add(newAddress)
+	if err != nil {
+		return AddAddressOutput{}, err
+	}
+
+	if input.DefaultShipping {
+		err = addressBook.SetDefaultShipping(newAddress.Id)
+		if err != nil {
+			return AddAddressOutput{}, err
+		}
+	}
+
+	if input.DefaultBilling {
+		err = addressBook.SetDefaultBilling(newAddress.Id)
+		if err != nil {
+			return AddAddressOutput{}, err
+		}
+	}
+
+	err = a.AddressBookRepository.Save(ctx, *addressBook)
+	if err != nil {
+		return AddAddressOutput{}, err
+	}
+
+	return AddAddressOutput{
+		AddressId: newAddress.Id,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressBookRepositoryMock struct {
+	mock.Mock
+}
+
+func (a *AddressBookRepositoryMock) Save(ctx context.Context, addressBook address.AddressBook) error {
+	args := a.Called(ctx, addressBook)
+	return args.Error(0)
+}
+
+func (a *AddressBookRepositoryMock) FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*address.AddressBook, error) {
+	args := a.Called(ctx, customerId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*address.AddressBook), args.Error(1)
+}
+
+type AddAddressSuite struct {
+	suite.Suite
+	addAddress                usecases.AddAddress
+	customerGatewayMock       CustomerGatewayMock
+	addressBookRepositoryMock AddressBookRepositoryMock
+}
+
+func (a *AddAddressSuite) SetupTest() {
+	a.customerGatewayMock = CustomerGatewayMock{}
+	a.addressBookRepositoryMock = AddressBookRepositoryMock{}
+
+	a.addAddress = usecases.AddAddress{
+		CustomerGateway:       &a.customerGatewayMock,
+		AddressBookRepository: &a.addressBookRepositoryMock,
+	}
+}
+
+func (a *AddAddressSuite) TestAddAddress_Execute_OnFirstAddress_SavesItAsDefault() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	a.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+	a.addressBookRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := a.addAddress.Execute(context.Background(), usecases.AddAddressInput{
+		CustomerId:    customerId,
+		RecipientName: "John Doe",
+		Line1:         "Av. Paulista, 1000",
+		City:          "São Paulo",
+		Region:        "sp",
+		PostalCode:    "01310100",
+		Country:       "br",
+	})
+
+	a.NoError(err)
+	a.NotEqual(uuid.Nil, output.AddressId)
+	a.addressBookRepositoryMock.AssertCalled(a.T(), "Save", mock.Anything, mock.MatchedBy(func(b address.AddressBook) bool {
+		return len(b.Addresses) == 1 &&
+			b.Addresses[0].Id == output.AddressId &&
+			b.Addresses[0].PostalCode == "01310-100" &&
+			b.Addresses[0].Region == "SP" &&
+			b.Addresses[0].DefaultShipping &&
+			b.Addresses[0].DefaultBilling
+	}))
+}
+
+func (a *AddAddressSuite) TestAddAddress_Execute_OnDefaultShippingFlag_MovesTheDefault() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	addressBook.Add(home)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	a.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+	a.addressBookRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := a.addAddress.Execute(context.Background(), usecases.AddAddressInput{
+		CustomerId:      customerId,
+		RecipientName:   "John Doe",
+		Line1:           "1 Main St",
+		City:            "Springfield",
+		Region:          "IL",
+		PostalCode:      "62701",
+		Country:         "US",
+		DefaultShipping: true,
+	})
+
+	a.NoError(err)
+	a.addressBookRepositoryMock.AssertCalled(a.T(), "Save", mock.Anything, mock.MatchedBy(func(b address.AddressBook) bool {
+		return len(b.Addresses) == 2 &&
+			b.DefaultShipping().Id == output.AddressId &&
+			b.Addresses[0].DefaultBilling &&
+			!b.Addresses[1].DefaultBilling
+	}))
+}
+
+func (a *AddAddressSuite) TestAddAddress_Execute_OnCustomerNotFound_ReturnsError() {
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := a.addAddress.Execute(context.Background(), usecases.AddAddressInput{
+		CustomerId: uuid.New(),
+	})
+
+	a.EqualError(err, "customer not found")
+}
+
+func (a *AddAddressSuite) TestAddAddress_Execute_OnInvalidPostalCode_ReturnsError() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	a.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	_, err := a.addAddress.Execute(context.Background(), usecases.AddAddressInput{
+		CustomerId:    customerId,
+		RecipientName: "John Doe",
+		Line1:         "Av. Paulista, 1000",
+		City:          "São Paulo",
+		Region:        "SP",
+		PostalCode:    "1310-100",
+		Country:       "BR",
+	})
+
+	a.EqualError(err, "address postal code is invalid")
+	a.addressBookRepositoryMock.AssertNumberOfCalls(a.T(), "Save", 0)
+}
+
+func (a *AddAddressSuite) TestAddAddress_Execute_OnRepositoryFailure_ReturnsError() {
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := a.addAddress.Execute(context.Background(), usecases.AddAddressInput{
+		CustomerId: uuid.New(),
+	})
+
+	a.EqualError(err, "connection refused")
+}
+
+func TestAddAddress(t *testing.T) {
+	suite.Run(t, new(AddAddressSuite))
+}
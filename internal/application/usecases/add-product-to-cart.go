@@ -1,82 +1,147 @@
 package usecases
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
 )
 
+const MaxCartWriteAttempts = 3
+
 type AddProductToCartInput struct {
 	CustomerId uuid.UUID
 	ProductId  uuid.UUID
 	Quantity   int32
+	CartToken  string
+}
+
+type AddProductToCartOutput struct {
+	CartToken string
 }
 
 type IAddProductToCart interface {
-	Execute(input AddProductToCartInput) error
+	Execute(ctx context.Context, input AddProductToCartInput) (AddProductToCartOutput, error)
 }
 
 type AddProductToCart struct {
-	CustomerGateway gateways.ICustomerGateway
-	ProductGateway  gateways.IProductGateway
-	CartRepository  repositories.ICartRepository
+	CustomerGateway  gateways.ICustomerGateway
+	ProductGateway   gateways.IProductGateway
+	InventoryGateway gateways.IInventoryGateway
+	CartRepository   repositories.ICartRepository
+	CartTokenGateway gateways.ICartTokenGateway
 }
 
-func (a *AddProductToCart) Execute(input AddProductToCartInput) error {
-	customerExists, err := a.CustomerGateway.ExistsById(input.CustomerId)
-	if err != nil {
+func (a *AddProductToCart) Execute(ctx context.Context, input AddProductToCartInput) (AddProductToCartOutput, error) {
+	var output AddProductToCartOutput
+	err := retryOnCartVersionConflict(func() error {
+		var err error
+		output, err = a.execute(ctx, input)
 		return err
-	}
+	})
+
+	return output, err
+}
+
+func (a *AddProductToCart) execute(ctx context.Context, input AddProductToCartInput) (AddProductToCartOutput, error) {
+	if input.CustomerId != uuid.Nil {
+		customerExists, err := a.CustomerGateway.ExistsById(ctx, input.CustomerId)
+		if err != nil {
+			return AddProductToCartOutput{}, err
+		}
 
-	if !customerExists {
-		return errors.New("customer not found")
+		if !customerExists {
+			return AddProductToCartOutput{}, ErrCustomerNotFound
+		}
 	}
 
-	product, err := a.ProductGateway.FindOneById(input.ProductId)
+	product, err := a.ProductGateway.FindOneById(ctx, input.ProductId)
 	if err != nil {
-		return err
+		return AddProductToCartOutput{}, err
 	}
 
 	if product == nil {
-		return errors.New("product not found")
+		return AddProductToCartOutput{}, ErrProductNotFound
 	}
 
-	customerCart, err := a.CartRepository.FindOneByCustomerId(input.CustomerId)
+	stock, err := a.InventoryGateway.FindOneByProductId(ctx, product.Id)
 	if err != nil {
-		return err
+		return AddProductToCartOutput{}, err
+	}
+
+	available := int32(0)
+	if stock != nil {
+		productInventory, err := inventory.NewInventory(stock.ProductId, stock.OnHand, stock.Reserved)
+		if err != nil {
+			return AddProductToCartOutput{}, err
+		}
+
+		available = productInventory.Available().Value
+	}
+
+	customerCart, err := findCart(ctx, a.CartRepository, a.CartTokenGateway, input.CustomerId, input.CartToken)
+	if err != nil {
+		return AddProductToCartOutput{}, err
 	}
 
 	if customerCart != nil {
-		err := customerCart.AddItem(product.Id, input.Quantity, product.Price)
+		err := customerCart.AddItemWithinStock(product.Id, input.Quantity, product.Price, product.Currency, available)
 		if err != nil {
-			return err
+			return AddProductToCartOutput{}, err
 		}
 
-		err = a.CartRepository.Update(*customerCart)
+		err = a.CartRepository.Update(ctx, *customerCart)
 		if err != nil {
-			return err
+			return AddProductToCartOutput{}, err
 		}
 
-		return nil
+		return AddProductToCartOutput{}, nil
+	}
+
+	newCart := cart.NewGuestCart()
+	if input.CustomerId != uuid.Nil {
+		newCart, err = cart.NewCart(input.CustomerId)
+		if err != nil {
+			return AddProductToCartOutput{}, err
+		}
 	}
 
-	newCart, err := cart.NewCart(input.CustomerId)
+	err = newCart.AddItemWithinStock(product.Id, input.Quantity, product.Price, product.Currency, available)
 	if err != nil {
-		return err
+		return AddProductToCartOutput{}, err
 	}
 
-	err = newCart.AddItem(product.Id, input.Quantity, product.Price)
+	err = a.CartRepository.Create(ctx, newCart)
 	if err != nil {
-		return err
+		return AddProductToCartOutput{}, err
 	}
 
-	err = a.CartRepository.Create(newCart)
+	if !newCart.IsGuest() {
+		return AddProductToCartOutput{}, nil
+	}
+
+	cartToken, err := a.CartTokenGateway.Issue(ctx, newCart.Id)
 	if err != nil {
-		return err
+		return AddProductToCartOutput{}, err
+	}
+
+	return AddProductToCartOutput{CartToken: cartToken}, nil
+}
+
+func retryOnCartVersionConflict(write func() error) error {
+	var err error
+	for attempt := 0; attempt < MaxCartWriteAttempts; attempt++ {
+		err = write()
+
+		var versionConflictError *repositories.CartVersionConflictError
+		if !errors.As(err, &versionConflictError) {
+			return err
+		}
 	}
 
-	return nil
+	return err
 }
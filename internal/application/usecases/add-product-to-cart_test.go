@@ -1,11 +1,15 @@
 package usecases_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -15,8 +19,8 @@ type CustomerGatewayMock struct {
 	mock.Mock
 }
 
-func (c *CustomerGatewayMock) ExistsById(customerId uuid.UUID) (bool, error) {
-	args := c.Called(customerId)
+func (c *CustomerGatewayMock) ExistsById(ctx context.Context, customerId uuid.UUID) (bool, error) {
+	args := c.Called(ctx, customerId)
 	return args.Bool(0), args.Error(1)
 }
 
@@ -24,18 +28,32 @@ type CartRepositoryMock struct {
 	mock.Mock
 }
 
-func (c *CartRepositoryMock) Create(cart cart.Cart) error {
-	args := c.Called(cart)
+func (c *CartRepositoryMock) Create(ctx context.Context, cart cart.Cart) error {
+	args := c.Called(ctx, cart)
 	return args.Error(0)
 }
 
-func (c *CartRepositoryMock) Update(cart cart.Cart) error {
-	args := c.Called(cart)
+func (c *CartRepositoryMock) Update(ctx context.Context, cart cart.Cart) error {
+	args := c.Called(ctx, cart)
 	return args.Error(0)
 }
 
-func (c *CartRepositoryMock) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart, error) {
-	args := c.Called(customerId)
+func (c *CartRepositoryMock) Delete(ctx context.Context, id uuid.UUID) error {
+	args := c.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (c *CartRepositoryMock) FindOneById(ctx context.Context, id uuid.UUID) (*cart.Cart, error) {
+	args := c.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*cart.Cart), args.Error(1)
+}
+
+func (c *CartRepositoryMock) FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*cart.Cart, error) {
+	args := c.Called(ctx, customerId)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -47,8 +65,8 @@ type ProductGatewayMock struct {
 	mock.Mock
 }
 
-func (p *ProductGatewayMock) FindOneById(id uuid.UUID) (*gateways.ProductDTO, error) {
-	args := p.Called(id)
+func (p *ProductGatewayMock) FindOneById(ctx context.Context, id uuid.UUID) (*gateways.ProductDTO, error) {
+	args := p.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -56,42 +74,93 @@ func (p *ProductGatewayMock) FindOneById(id uuid.UUID) (*gateways.ProductDTO, er
 	return args.Get(0).(*gateways.ProductDTO), args.Error(1)
 }
 
+type InventoryGatewayMock struct {
+	mock.Mock
+}
+
+func (i *InventoryGatewayMock) Create(ctx context.Context, inventory gateways.InventoryDTO) error {
+	args := i.Called(ctx, inventory)
+	return args.Error(0)
+}
+
+func (i *InventoryGatewayMock) FindOneByProductId(ctx context.Context, productId uuid.UUID) (*gateways.InventoryDTO, error) {
+	args := i.Called(ctx, productId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.InventoryDTO), args.Error(1)
+}
+
+func (i *InventoryGatewayMock) Reserve(ctx context.Context, reservationId uuid.UUID, items []gateways.StockReservationItemDTO, expiresAt time.Time) error {
+	args := i.Called(ctx, reservationId, items, expiresAt)
+	return args.Error(0)
+}
+
+func (i *InventoryGatewayMock) Release(ctx context.Context, reservationId uuid.UUID) error {
+	args := i.Called(ctx, reservationId)
+	return args.Error(0)
+}
+
+func (i *InventoryGatewayMock) Commit(ctx context.Context, reservationId uuid.UUID, now time.Time) error {
+	args := i.Called(ctx, reservationId, now)
+	return args.Error(0)
+}
+
+func (i *InventoryGatewayMock) ReleaseExpired(ctx context.Context, now time.Time) (int, error) {
+	args := i.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (i *InventoryGatewayMock) Restock(ctx context.Context, items []gateways.StockReservationItemDTO) error {
+	args := i.Called(ctx, items)
+	return args.Error(0)
+}
+
 type AddProductToCartSuite struct {
 	suite.Suite
-	addProductToCart    usecases.AddProductToCart
-	customerGatewayMock CustomerGatewayMock
-	productGatewayMock  ProductGatewayMock
-	cartRepositoryMock  CartRepositoryMock
+	addProductToCart     usecases.AddProductToCart
+	customerGatewayMock  CustomerGatewayMock
+	productGatewayMock   ProductGatewayMock
+	inventoryGatewayMock InventoryGatewayMock
+	cartRepositoryMock   CartRepositoryMock
+	cartTokenGatewayMock CartTokenGatewayMock
 }
 
 func (a *AddProductToCartSuite) SetupTest() {
 	a.customerGatewayMock = CustomerGatewayMock{}
 	a.productGatewayMock = ProductGatewayMock{}
+	a.inventoryGatewayMock = InventoryGatewayMock{}
 	a.cartRepositoryMock = CartRepositoryMock{}
+	a.cartTokenGatewayMock = CartTokenGatewayMock{}
 
 	a.addProductToCart = usecases.AddProductToCart{
-		CustomerGateway: &a.customerGatewayMock,
-		ProductGateway:  &a.productGatewayMock,
-		CartRepository:  &a.cartRepositoryMock,
+		CustomerGateway:  &a.customerGatewayMock,
+		ProductGateway:   &a.productGatewayMock,
+		InventoryGateway: &a.inventoryGatewayMock,
+		CartRepository:   &a.cartRepositoryMock,
+		CartTokenGateway: &a.cartTokenGatewayMock,
 	}
 }
 
 func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnNewCartAndNoErrors_SavesCartAndReturnsNil() {
 	product := gateways.ProductDTO{
-		Id:    uuid.New(),
-		Price: int64(3550),
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
 	}
-	a.customerGatewayMock.On("ExistsById", mock.Anything).Return(true, nil)
-	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything).Return(nil, nil)
-	a.productGatewayMock.On("FindOneById", mock.Anything).Return(&product, nil)
-	a.cartRepositoryMock.On("Create", mock.Anything).Return(nil)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10, Reserved: 2}, nil)
+	a.cartRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
 	input := usecases.AddProductToCartInput{
 		CustomerId: uuid.New(),
 		ProductId:  uuid.New(),
 		Quantity:   int32(3),
 	}
 
-	err := a.addProductToCart.Execute(input)
+	_, err := a.addProductToCart.Execute(context.Background(), input)
 
 	a.Equal(nil, err)
 	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Create", 1)
@@ -105,58 +174,277 @@ func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnExistingCartAndNo
 		Items:      []cart.CartItem{},
 	}
 	product := gateways.ProductDTO{
-		Id:    uuid.New(),
-		Price: int64(3550),
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
 	}
-	a.customerGatewayMock.On("ExistsById", mock.Anything).Return(true, nil)
-	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything).Return(&customerCart, nil)
-	a.productGatewayMock.On("FindOneById", mock.Anything).Return(&product, nil)
-	a.cartRepositoryMock.On("Update", mock.Anything).Return(nil)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10, Reserved: 2}, nil)
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
 	input := usecases.AddProductToCartInput{
 		CustomerId: uuid.New(),
 		ProductId:  uuid.New(),
 		Quantity:   int32(3),
 	}
 
-	err := a.addProductToCart.Execute(input)
+	_, err := a.addProductToCart.Execute(context.Background(), input)
 
 	a.Equal(nil, err)
 	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Create", 0)
 	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 1)
 }
 
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnProductInOtherCurrency_ReturnsError() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 1},
+				Price:     models.Money{Value: 5000, Currency: models.BRL},
+			},
+		},
+	}
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(1200),
+		Currency: "USD",
+	}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+
+	_, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  product.Id,
+		Quantity:   int32(1),
+	})
+
+	a.EqualError(err, "cart cannot mix currencies")
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 0)
+}
+
 func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnCustomerNotFound_ReturnsError() {
 	product := gateways.ProductDTO{
-		Id:    uuid.New(),
-		Price: int64(3550),
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
 	}
-	a.productGatewayMock.On("FindOneById", mock.Anything).Return(&product, nil)
-	a.customerGatewayMock.On("ExistsById", mock.Anything).Return(false, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
 	input := usecases.AddProductToCartInput{
 		CustomerId: uuid.New(),
 		ProductId:  uuid.New(),
 		Quantity:   int32(3),
 	}
 
-	err := a.addProductToCart.Execute(input)
+	_, err := a.addProductToCart.Execute(context.Background(), input)
 
 	a.EqualError(err, "customer not found")
 }
 
 func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnProductNotFound_ReturnsError() {
-	a.customerGatewayMock.On("ExistsById", mock.Anything).Return(true, nil)
-	a.productGatewayMock.On("FindOneById", mock.Anything).Return(nil, nil)
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
 	input := usecases.AddProductToCartInput{
 		CustomerId: uuid.New(),
 		ProductId:  uuid.New(),
 		Quantity:   int32(3),
 	}
 
-	err := a.addProductToCart.Execute(input)
+	_, err := a.addProductToCart.Execute(context.Background(), input)
 
 	a.EqualError(err, "product not found")
 }
 
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnQuantityExceedingAvailableStock_ReturnsError() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items:      []cart.CartItem{},
+	}
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10, Reserved: 8}, nil)
+	input := usecases.AddProductToCartInput{
+		CustomerId: uuid.New(),
+		ProductId:  product.Id,
+		Quantity:   int32(3),
+	}
+
+	_, err := a.addProductToCart.Execute(context.Background(), input)
+
+	a.EqualError(err, "insufficient stock")
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 0)
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnInventoryNotFound_ReturnsError() {
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(nil, nil)
+	input := usecases.AddProductToCartInput{
+		CustomerId: uuid.New(),
+		ProductId:  product.Id,
+		Quantity:   int32(1),
+	}
+
+	_, err := a.addProductToCart.Execute(context.Background(), input)
+
+	a.EqualError(err, "insufficient stock")
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Create", 0)
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnGuestWithoutCart_CreatesGuestCartAndReturnsCartToken() {
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+	a.cartRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	a.cartTokenGatewayMock.On("Issue", mock.Anything, mock.Anything).Return("cart-token", nil)
+
+	output, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		ProductId: product.Id,
+		Quantity:  int32(2),
+	})
+
+	a.NoError(err)
+	a.Equal("cart-token", output.CartToken)
+	a.customerGatewayMock.AssertNotCalled(a.T(), "ExistsById", mock.Anything, mock.Anything)
+	a.cartRepositoryMock.AssertCalled(a.T(), "Create", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.IsGuest() && c.TotalQuantity().Value == 2
+	}))
+	a.cartTokenGatewayMock.AssertCalled(a.T(), "Issue", mock.Anything, mock.MatchedBy(func(cartId uuid.UUID) bool {
+		return cartId != uuid.Nil
+	}))
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnGuestWithCartToken_UpdatesGuestCart() {
+	guestCart := cart.NewGuestCart()
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+	a.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	a.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		ProductId: product.Id,
+		Quantity:  int32(1),
+		CartToken: "cart-token",
+	})
+
+	a.NoError(err)
+	a.Empty(output.CartToken)
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Create", 0)
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 1)
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnCartVersionConflict_ReloadsCartAndRetries() {
+	customerId := uuid.New()
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	staleCart := cart.Cart{Id: uuid.New(), CustomerId: customerId, Items: []cart.CartItem{}, Version: 4}
+	freshCart := cart.Cart{Id: staleCart.Id, CustomerId: customerId, Items: []cart.CartItem{}, Version: 5}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, product.Id).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&staleCart, nil).Once()
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&freshCart, nil).Once()
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool { return c.Version == 4 })).
+		Return(&repositories.CartVersionConflictError{CartId: staleCart.Id, Version: 4})
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool { return c.Version == 5 })).Return(nil)
+
+	_, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		CustomerId: customerId,
+		ProductId:  product.Id,
+		Quantity:   int32(1),
+	})
+
+	a.NoError(err)
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "FindOneByCustomerId", 2)
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 2)
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnConcurrentCartCreation_UpdatesTheCreatedCart() {
+	customerId := uuid.New()
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	concurrentCart := cart.Cart{Id: uuid.New(), CustomerId: customerId, Items: []cart.CartItem{}}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, product.Id).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(nil, nil).Once()
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&concurrentCart, nil).Once()
+	a.cartRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(&repositories.CartVersionConflictError{})
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		CustomerId: customerId,
+		ProductId:  product.Id,
+		Quantity:   int32(1),
+	})
+
+	a.NoError(err)
+	a.cartRepositoryMock.AssertCalled(a.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.Id == concurrentCart.Id && c.TotalQuantity().Value == 1
+	}))
+}
+
+func (a *AddProductToCartSuite) TestAddProductToCart_Execute_OnPersistentCartVersionConflict_GivesUpAfterMaxAttempts() {
+	customerCart := cart.Cart{Id: uuid.New(), CustomerId: uuid.New(), Items: []cart.CartItem{}}
+	product := gateways.ProductDTO{
+		Id:       uuid.New(),
+		Price:    int64(3550),
+		Currency: "BRL",
+	}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&product, nil)
+	a.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, product.Id).Return(&gateways.InventoryDTO{ProductId: product.Id, OnHand: 10}, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(&repositories.CartVersionConflictError{CartId: customerCart.Id})
+
+	_, err := a.addProductToCart.Execute(context.Background(), usecases.AddProductToCartInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  product.Id,
+		Quantity:   int32(1),
+	})
+
+	var versionConflictError *repositories.CartVersionConflictError
+	a.ErrorAs(err, &versionConflictError)
+	a.Equal(customerCart.Id, versionConflictError.CartId)
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", usecases.MaxCartWriteAttempts)
+}
+
 func TestAddProductToCart(t *testing.T) {
 	suite.Run(t, new(AddProductToCartSuite))
 }
@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type ApplyCouponToCartInput struct {
+	CustomerId uuid.UUID
+	CouponCode string
+}
+
+type ApplyCouponToCartOutput struct {
+	CouponCode   string
+	Currency     string
+	Subtotal     int64
+	Discount     int64
+	Total        int64
+	FreeShipping bool
+}
+
+type IApplyCouponToCart interface {
+	Execute(ctx context.Context, input ApplyCouponToCartInput) (ApplyCouponToCartOutput, error)
+}
+
+type ApplyCouponToCart struct {
+	CustomerGateway     gateways.ICustomerGateway
+	ClockGateway        gateways.IClockGateway
+	CartRepository      repositories.ICartRepository
+	PromotionRepository repositories.IPromotionRepository
+}
+
+func (a *ApplyCouponToCart) Execute(ctx context.Context, input ApplyCouponToCartInput) (ApplyCouponToCartOutput, error) {
+	var output ApplyCouponToCartOutput
+	err := retryOnCartVersionConflict(func() error {
+		var err error
+		output, err = a.execute(ctx, input)
+		return err
+	})
+
+	return output, err
+}
+
+func (a *ApplyCouponToCart) execute(ctx context.Context, input ApplyCouponToCartInput) (ApplyCouponToCartOutput, error) {
+	customerExists, err := a.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	if !customerExists {
+		return ApplyCouponToCartOutput{}, ErrCustomerNotFound
+	}
+
+	customerCart, err := a.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	if customerCart == nil {
+		return ApplyCouponToCartOutput{}, ErrCartNotFound
+	}
+
+	err = customerCart.ApplyCoupon(input.CouponCode)
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	promotion, err := a.PromotionRepository.FindOneByCode(ctx, customerCart.CouponCode)
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	if promotion == nil {
+		return ApplyCouponToCartOutput{}, ErrCouponNotFound
+	}
+
+	breakdown, err := promotion.Apply(*customerCart, a.ClockGateway.Now())
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	err = a.CartRepository.Update(ctx, *customerCart)
+	if err != nil {
+		return ApplyCouponToCartOutput{}, err
+	}
+
+	return ApplyCouponToCartOutput{
+		CouponCode:   customerCart.CouponCode,
+		Currency:     breakdown.Subtotal.Currency.Code,
+		Subtotal:     breakdown.Subtotal.Value,
+		Discount:     breakdown.Discount.Value,
+		Total:        breakdown.Total.Value,
+		FreeShipping: breakdown.FreeShipping,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromotionRepositoryMock struct {
+	mock.Mock
+}
+
+func (p *PromotionRepositoryMock) FindOneByCode(ctx context.Context, code string) (*promotion.Promotion, error) {
+	args := p.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*promotion.Promotion), args.Error(1)
+}
+
+type ApplyCouponToCartSuite struct {
+	suite.Suite
+	applyCouponToCart       usecases.ApplyCouponToCart
+	customerGatewayMock     CustomerGatewayMock
+	cartRepositoryMock      CartRepositoryMock
+	promotionRepositoryMock PromotionRepositoryMock
+	clockGateway            *infragateways.FakeClockGateway
+}
+
+func (a *ApplyCouponToCartSuite) SetupTest() {
+	a.customerGatewayMock = CustomerGatewayMock{}
+	a.cartRepositoryMock = CartRepositoryMock{}
+	a.promotionRepositoryMock = PromotionRepositoryMock{}
+	a.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+
+	a.applyCouponToCart = usecases.ApplyCouponToCart{
+		CustomerGateway:     &a.customerGatewayMock,
+		ClockGateway:        a.clockGateway,
+		CartRepository:      &a.cartRepositoryMock,
+		PromotionRepository: &a.promotionRepositoryMock,
+	}
+}
+
+func (a *ApplyCouponToCartSuite) newCustomerCart() cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 5000},
+			},
+		},
+	}
+}
+
+func (a *ApplyCouponToCartSuite) newPromotion() promotion.Promotion {
+	return promotion.Promotion{
+		Id:         uuid.New(),
+		Code:       "SAVE20",
+		Type:       promotion.PercentageOff,
+		PercentOff: 20,
+		StartsAt:   time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:     time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnValidCoupon_UpdatesCartAndReturnsBreakdown() {
+	customerCart := a.newCustomerCart()
+	existingPromotion := a.newPromotion()
+	a.customerGatewayMock.On("ExistsById", mock.Anything, customerCart.CustomerId).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	a.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SAVE20").Return(&existingPromotion, nil)
+	a.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	sut, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerCart.CustomerId,
+		CouponCode: " save20 ",
+	})
+
+	a.NoError(err)
+	a.Equal(usecases.ApplyCouponToCartOutput{
+		CouponCode:   "SAVE20",
+		Subtotal:     10000,
+		Discount:     2000,
+		Total:        8000,
+		FreeShipping: false,
+	}, sut)
+	a.cartRepositoryMock.AssertCalled(a.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.CouponCode == "SAVE20"
+	}))
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnCustomerNotFound_ReturnsError() {
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: uuid.New(),
+		CouponCode: "SAVE20",
+	})
+
+	a.EqualError(err, "customer not found")
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnCartNotFound_ReturnsError() {
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: uuid.New(),
+		CouponCode: "SAVE20",
+	})
+
+	a.EqualError(err, "cart not found")
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnCouponNotFound_ReturnsError() {
+	customerCart := a.newCustomerCart()
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "UNKNOWN").Return(nil, nil)
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerCart.CustomerId,
+		CouponCode: "UNKNOWN",
+	})
+
+	a.EqualError(err, "coupon not found")
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 0)
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnCouponNotApplicable_ReturnsErrorWithoutUpdatingCart() {
+	customerCart := a.newCustomerCart()
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.clockGateway.Set(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))
+	existingPromotion := a.newPromotion()
+	a.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SAVE20").Return(&existingPromotion, nil)
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerCart.CustomerId,
+		CouponCode: "SAVE20",
+	})
+
+	a.EqualError(err, "coupon has expired")
+	a.cartRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 0)
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnEmptyCart_ReturnsError() {
+	customerCart := cart.Cart{Id: uuid.New(), CustomerId: uuid.New(), Items: []cart.CartItem{}}
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerCart.CustomerId,
+		CouponCode: "SAVE20",
+	})
+
+	a.EqualError(err, "cart is empty")
+	a.promotionRepositoryMock.AssertNumberOfCalls(a.T(), "FindOneByCode", 0)
+}
+
+func (a *ApplyCouponToCartSuite) TestApplyCouponToCart_Execute_OnRepositoryError_ReturnsError() {
+	customerCart := a.newCustomerCart()
+	a.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	a.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	a.promotionRepositoryMock.On("FindOneByCode", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := a.applyCouponToCart.Execute(context.Background(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerCart.CustomerId,
+		CouponCode: "SAVE20",
+	})
+
+	a.EqualError(err, "connection refused")
+}
+
+func TestApplyCouponToCart(t *testing.T) {
+	suite.Run(t, new(ApplyCouponToCartSuite))
+}
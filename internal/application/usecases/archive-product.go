@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type ArchiveProductInput struct {
+	ProductId uuid.UUID
+}
+
+type IArchiveProduct interface {
+	Execute(ctx context.Context, input ArchiveProductInput) error
+}
+
+type ArchiveProduct struct {
+	ProductRepository repositories.IProductRepository
+}
+
+func (a *ArchiveProduct) Execute(ctx context.Context, input ArchiveProductInput) error {
+	existingProduct, err := a.ProductRepository.FindOneById(ctx, input.ProductId)
+	if err != nil {
+		return err
+	}
+
+	if existingProduct == nil {
+		return ErrProductNotFound
+	}
+
+	err = existingProduct.Archive()
+	if err != nil {
+		return err
+	}
+
+	err = a.ProductRepository.Update(ctx, *existingProduct)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
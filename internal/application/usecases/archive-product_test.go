@@ -0,0 +1,68 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ArchiveProductSuite struct {
+	suite.Suite
+	archiveProduct        usecases.ArchiveProduct
+	productRepositoryMock ProductRepositoryMock
+}
+
+func (a *ArchiveProductSuite) SetupTest() {
+	a.productRepositoryMock = ProductRepositoryMock{}
+
+	a.archiveProduct = usecases.ArchiveProduct{
+		ProductRepository: &a.productRepositoryMock,
+	}
+}
+
+func (a *ArchiveProductSuite) TestArchiveProduct_Execute_OnActiveProduct_ArchivesProduct() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, Active: true}
+	a.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	a.productRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := a.archiveProduct.Execute(context.Background(), usecases.ArchiveProductInput{
+		ProductId: existingProduct.Id,
+	})
+
+	a.NoError(err)
+	a.productRepositoryMock.AssertCalled(a.T(), "Update", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.Id == existingProduct.Id && !p.Active
+	}))
+}
+
+func (a *ArchiveProductSuite) TestArchiveProduct_Execute_OnArchivedProduct_ReturnsError() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, Active: false}
+	a.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+
+	err := a.archiveProduct.Execute(context.Background(), usecases.ArchiveProductInput{
+		ProductId: existingProduct.Id,
+	})
+
+	a.EqualError(err, "product is already archived")
+	a.productRepositoryMock.AssertNumberOfCalls(a.T(), "Update", 0)
+}
+
+func (a *ArchiveProductSuite) TestArchiveProduct_Execute_OnProductNotFound_ReturnsError() {
+	a.productRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := a.archiveProduct.Execute(context.Background(), usecases.ArchiveProductInput{
+		ProductId: uuid.New(),
+	})
+
+	a.EqualError(err, "product not found")
+}
+
+func TestArchiveProduct(t *testing.T) {
+	suite.Run(t, new(ArchiveProductSuite))
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+)
+
+type ChangeOrderStatusInput struct {
+	OrderId uuid.UUID
+	Status  string
+	Actor   string
+}
+
+type IChangeOrderStatus interface {
+	Execute(ctx context.Context, input ChangeOrderStatusInput) error
+}
+
+type ChangeOrderStatus struct {
+	ClockGateway    gateways.IClockGateway
+	OrderRepository repositories.IOrderRepository
+}
+
+func (c *ChangeOrderStatus) Execute(ctx context.Context, input ChangeOrderStatusInput) error {
+	status, err := order.NewOrderStatus(input.Status)
+	if err != nil {
+		return err
+	}
+
+	if status == order.Refunded {
+		return ErrOrderRefundRequired
+	}
+
+	existingOrder, err := c.OrderRepository.FindOneById(ctx, input.OrderId)
+	if err != nil {
+		return err
+	}
+
+	if existingOrder == nil {
+		return ErrOrderNotFound
+	}
+
+	if status == order.Cancelled && existingOrder.Status == order.Paid {
+		return ErrOrderRefundRequired
+	}
+
+	previousStatus := existingOrder.Status
+	err = existingOrder.ChangeStatus(status, input.Actor, c.ClockGateway.Now())
+	if err != nil {
+		return err
+	}
+
+	err = c.OrderRepository.Update(ctx, *existingOrder, previousStatus)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
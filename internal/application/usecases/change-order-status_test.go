@@ -0,0 +1,151 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeOrderStatusSuite struct {
+	suite.Suite
+	changeOrderStatus   usecases.ChangeOrderStatus
+	clockGateway        *infragateways.FakeClockGateway
+	orderRepositoryMock OrderRepositoryMock
+}
+
+func (c *ChangeOrderStatusSuite) SetupTest() {
+	c.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	c.orderRepositoryMock = OrderRepositoryMock{}
+
+	c.changeOrderStatus = usecases.ChangeOrderStatus{
+		ClockGateway:    c.clockGateway,
+		OrderRepository: &c.orderRepositoryMock,
+	}
+}
+
+func (c *ChangeOrderStatusSuite) newOrder(status order.OrderStatus) order.Order {
+	return order.Order{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Status:     status,
+		Lines: []order.OrderLine{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 1},
+				UnitPrice: models.Money{Value: 2550},
+			},
+		},
+		StatusHistory: []order.OrderStatusChange{},
+	}
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnAllowedTransition_UpdatesOrderAndReturnsNil() {
+	existingOrder := c.newOrder(order.Paid)
+	c.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.Paid).Return(nil)
+
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: existingOrder.Id,
+		Status:  "FULFILLING",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Status == order.Fulfilling &&
+			len(o.StatusHistory) == 1 &&
+			o.StatusHistory[0].From == order.Paid &&
+			o.StatusHistory[0].Actor == "5ad98fc5-6b0f-45fd-a886-d6a15a63c833" &&
+			o.StatusHistory[0].ChangedAt.Equal(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	}), order.Paid)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnConcurrentStatusChange_ReturnsError() {
+	existingOrder := c.newOrder(order.Paid)
+	c.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.Paid).Return(repositories.ErrOrderStatusConflict)
+
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: existingOrder.Id,
+		Status:  "FULFILLING",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.ErrorIs(err, repositories.ErrOrderStatusConflict)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnIllegalTransition_ReturnsError() {
+	existingOrder := c.newOrder(order.PendingPayment)
+	c.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: existingOrder.Id,
+		Status:  "DELIVERED",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.EqualError(err, "order status transition is not allowed")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "Update", 0)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnInvalidStatus_ReturnsError() {
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: uuid.New(),
+		Status:  "LOST",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.EqualError(err, "order status is invalid")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "FindOneById", 0)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnRefundedStatus_ReturnsError() {
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: uuid.New(),
+		Status:  "REFUNDED",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.EqualError(err, "order must be refunded through the payment gateway")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "FindOneById", 0)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnCancellingPaidOrder_ReturnsErrorWithoutUpdating() {
+	existingOrder := c.newOrder(order.Paid)
+	c.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: existingOrder.Id,
+		Status:  "CANCELLED",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.EqualError(err, "order must be refunded through the payment gateway")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "Update", 0)
+}
+
+func (c *ChangeOrderStatusSuite) TestChangeOrderStatus_Execute_OnOrderNotFound_ReturnsError() {
+	c.orderRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := c.changeOrderStatus.Execute(context.Background(), usecases.ChangeOrderStatusInput{
+		OrderId: uuid.New(),
+		Status:  "PAID",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	c.EqualError(err, "order not found")
+}
+
+func TestChangeOrderStatus(t *testing.T) {
+	suite.Run(t, new(ChangeOrderStatusSuite))
+}
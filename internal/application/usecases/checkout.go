@@ -0,0 +1,296 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+)
+
+type CheckoutInput struct {
+	CustomerId uuid.UUID
+	CardNumber string
+	AddressId  uuid.UUID
+}
+
+type CheckoutOutput struct {
+	OrderId uuid.UUID
+}
+
+type ICheckout interface {
+	Execute(ctx context.Context, input CheckoutInput) (CheckoutOutput, error)
+}
+
+const CheckoutReservationTtl = 15 * time.Minute
+
+type Checkout struct {
+	CustomerGateway       gateways.ICustomerGateway
+	ProductGateway        gateways.IProductGateway
+	InventoryGateway      gateways.IInventoryGateway
+	PaymentGateway        gateways.IPaymentGateway
+	ClockGateway          gateways.IClockGateway
+	CartRepository        repositories.ICartRepository
+	OrderRepository       repositories.IOrderRepository
+	PromotionRepository   repositories.IPromotionRepository
+	AddressBookRepository repositories.IAddressBookRepository
+	UnitOfWork            repositories.IUnitOfWork
+	TaxCalculator         ITaxCalculator
+	ShippingQuoter        IShippingQuoter
+}
+
+func (c *Checkout) Execute(ctx context.Context, input CheckoutInput) (CheckoutOutput, error) {
+	customerExists, err := c.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	if !customerExists {
+		return CheckoutOutput{}, ErrCustomerNotFound
+	}
+
+	customerCart, err := c.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	if customerCart == nil {
+		return CheckoutOutput{}, ErrCartNotFound
+	}
+
+	if len(customerCart.Items) == 0 {
+		return CheckoutOutput{}, cart.ErrCartEmpty
+	}
+
+	addressBook, err := c.AddressBookRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	var shippingAddress *address.Address
+	if addressBook != nil && input.AddressId != uuid.Nil {
+		shippingAddress = addressBook.Find(input.AddressId)
+	}
+
+	if addressBook != nil && input.AddressId == uuid.Nil {
+		shippingAddress = addressBook.DefaultShipping()
+	}
+
+	if shippingAddress == nil && input.AddressId != uuid.Nil {
+		return CheckoutOutput{}, address.ErrAddressNotFound
+	}
+
+	if shippingAddress == nil {
+		return CheckoutOutput{}, ErrShippingAddressRequired
+	}
+
+	orderLines := []order.OrderLine{}
+	reservationItems := []gateways.StockReservationItemDTO{}
+	pricedItems := []cart.CartItem{}
+	taxableLines := []tax.TaxableLine{}
+	parcel := shipping.Parcel{}
+	for _, item := range customerCart.Items {
+		productDTO, err := c.ProductGateway.FindOneById(ctx, item.ProductId)
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		if productDTO == nil {
+			return CheckoutOutput{}, ErrProductNotFound
+		}
+
+		orderLine, err := order.NewOrderLine(item.ProductId, item.Quantity.Value, productDTO.Price, productDTO.Currency)
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		orderLines = append(orderLines, orderLine)
+		reservationItems = append(reservationItems, gateways.StockReservationItemDTO{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity.Value,
+		})
+		pricedItems = append(pricedItems, cart.CartItem{
+			Id:        item.Id,
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Price:     orderLine.UnitPrice,
+		})
+
+		orderLineTotalPrice, err := orderLine.TotalPrice()
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		taxClass := productDTO.TaxClass
+		if taxClass == "" {
+			taxClass = product.StandardTaxClass
+		}
+
+		taxableLines = append(taxableLines, tax.TaxableLine{
+			ProductId: item.ProductId,
+			TaxClass:  taxClass,
+			Amount:    orderLineTotalPrice,
+		})
+		parcel = parcel.Add(int64(productDTO.WeightGrams), int64(productDTO.LengthCm), int64(productDTO.WidthCm), int64(productDTO.HeightCm),
+			item.Quantity.Value)
+	}
+
+	newOrder, err := order.NewOrder(input.CustomerId, orderLines)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	newOrder.ShippingAddress = order.OrderAddress{
+		RecipientName: shippingAddress.RecipientName,
+		Line1:         shippingAddress.Line1,
+		Line2:         shippingAddress.Line2,
+		City:          shippingAddress.City,
+		Region:        shippingAddress.Region,
+		PostalCode:    shippingAddress.PostalCode,
+		Country:       shippingAddress.Country,
+	}
+
+	pricedCart := *customerCart
+	pricedCart.Items = pricedItems
+	breakdown, err := pricedCart.Breakdown(models.Money{Value: 0}, false)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	if customerCart.CouponCode != "" {
+		promotion, err := c.PromotionRepository.FindOneByCode(ctx, customerCart.CouponCode)
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		if promotion == nil {
+			return CheckoutOutput{}, ErrCouponNotFound
+		}
+
+		breakdown, err = promotion.Apply(pricedCart, c.ClockGateway.Now())
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		newOrder.CouponCode = customerCart.CouponCode
+		newOrder.Discount = breakdown.Discount
+	}
+
+	if customerCart.ShippingMethodCode != "" {
+		quote, err := c.ShippingQuoter.Quote(ctx, customerCart.ShippingMethodCode, parcel, breakdown.Total, breakdown.FreeShipping)
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+
+		err = newOrder.ApplyShipping(quote)
+		if err != nil {
+			return CheckoutOutput{}, err
+		}
+	}
+
+	calculation, err := c.TaxCalculator.Calculate(ctx, shippingAddress.Country, shippingAddress.Region, taxableLines, newOrder.Discount)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	err = newOrder.ApplyTax(calculation)
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	reservationId := uuid.New()
+	err = c.InventoryGateway.Reserve(ctx, reservationId, reservationItems, c.ClockGateway.Now().Add(CheckoutReservationTtl))
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	amountDue, err := newOrder.AmountDue()
+	if err != nil {
+		c.InventoryGateway.Release(ctx, reservationId)
+		return CheckoutOutput{}, err
+	}
+
+	var paymentAuthorization *gateways.PaymentAuthorizationDTO
+	if amountDue.Value > 0 {
+		paymentAuthorization, err = c.PaymentGateway.Authorize(ctx, input.CardNumber, amountDue.Value)
+		if err != nil {
+			c.InventoryGateway.Release(ctx, reservationId)
+			return CheckoutOutput{}, err
+		}
+
+		newOrder.PaymentId = paymentAuthorization.Id
+	}
+
+	customerCart.Clear()
+
+	err = c.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+		err := transaction.OrderRepository.CreateFromCart(ctx, newOrder, *customerCart)
+		if err != nil {
+			return err
+		}
+
+		return transaction.InventoryGateway.Commit(ctx, reservationId, c.ClockGateway.Now())
+	})
+
+	if err != nil {
+		if paymentAuthorization != nil {
+			c.PaymentGateway.Void(ctx, paymentAuthorization.Id)
+		}
+
+		c.InventoryGateway.Release(ctx, reservationId)
+		return CheckoutOutput{}, err
+	}
+
+	pendingStatus := newOrder.Status
+	paidOrder := newOrder
+	paidOrder.StatusHistory = slices.Clone(newOrder.StatusHistory)
+	err = paidOrder.ChangeStatus(order.Paid, "payment-gateway", c.ClockGateway.Now())
+	if err != nil {
+		return CheckoutOutput{}, err
+	}
+
+	err = c.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+		err := transaction.OrderRepository.Update(ctx, paidOrder, pendingStatus)
+		if err != nil {
+			return err
+		}
+
+		if paymentAuthorization == nil {
+			return nil
+		}
+
+		return c.PaymentGateway.Capture(ctx, paymentAuthorization.Id, paymentAuthorization.Amount)
+	})
+
+	if err != nil {
+		if paymentAuthorization != nil {
+			c.PaymentGateway.Void(ctx, paymentAuthorization.Id)
+		}
+
+		if newOrder.ChangeStatus(order.Cancelled, "payment-gateway", c.ClockGateway.Now()) == nil {
+			c.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+				err := transaction.OrderRepository.Update(ctx, newOrder, pendingStatus)
+				if err != nil {
+					return err
+				}
+
+				return transaction.InventoryGateway.Restock(ctx, reservationItems)
+			})
+		}
+
+		return CheckoutOutput{}, err
+	}
+
+	return CheckoutOutput{
+		OrderId: newOrder.Id,
+	}, nil
+}
@@ -0,0 +1,827 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderRepositoryMock struct {
+	mock.Mock
+}
+
+func (o *OrderRepositoryMock) CreateFromCart(ctx context.Context, order order.Order, checkedOutCart cart.Cart) error {
+	args := o.Called(ctx, order, checkedOutCart)
+	return args.Error(0)
+}
+
+func (o *OrderRepositoryMock) Update(ctx context.Context, order order.Order, previousStatus order.OrderStatus) error {
+	args := o.Called(ctx, order, previousStatus)
+	return args.Error(0)
+}
+
+func (o *OrderRepositoryMock) FindOneById(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	args := o.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+type PaymentGatewayMock struct {
+	mock.Mock
+}
+
+func (p *PaymentGatewayMock) Authorize(ctx context.Context, cardNumber string, amount int64) (*gateways.PaymentAuthorizationDTO, error) {
+	args := p.Called(ctx, cardNumber, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.PaymentAuthorizationDTO), args.Error(1)
+}
+
+func (p *PaymentGatewayMock) Capture(ctx context.Context, authorizationId string, amount int64) error {
+	args := p.Called(ctx, authorizationId, amount)
+	return args.Error(0)
+}
+
+func (p *PaymentGatewayMock) Void(ctx context.Context, authorizationId string) error {
+	args := p.Called(ctx, authorizationId)
+	return args.Error(0)
+}
+
+func (p *PaymentGatewayMock) Refund(ctx context.Context, authorizationId string, amount int64) error {
+	args := p.Called(ctx, authorizationId, amount)
+	return args.Error(0)
+}
+
+type CheckoutSuite struct {
+	suite.Suite
+	checkout                  usecases.Checkout
+	customerGatewayMock       CustomerGatewayMock
+	productGatewayMock        ProductGatewayMock
+	inventoryGatewayMock      InventoryGatewayMock
+	paymentGatewayMock        PaymentGatewayMock
+	cartRepositoryMock        CartRepositoryMock
+	orderRepositoryMock       OrderRepositoryMock
+	promotionRepositoryMock   PromotionRepositoryMock
+	taxRuleGatewayMock        TaxRuleGatewayMock
+	addressBookRepositoryMock AddressBookRepositoryMock
+	addressBook               address.AddressBook
+	clockGateway              *infragateways.FakeClockGateway
+	unitOfWork                *UnitOfWorkFake
+}
+
+func (c *CheckoutSuite) SetupTest() {
+	c.customerGatewayMock = CustomerGatewayMock{}
+	c.productGatewayMock = ProductGatewayMock{}
+	c.inventoryGatewayMock = InventoryGatewayMock{}
+	c.paymentGatewayMock = PaymentGatewayMock{}
+	c.cartRepositoryMock = CartRepositoryMock{}
+	c.orderRepositoryMock = OrderRepositoryMock{}
+	c.promotionRepositoryMock = PromotionRepositoryMock{}
+	c.taxRuleGatewayMock = TaxRuleGatewayMock{}
+	c.addressBookRepositoryMock = AddressBookRepositoryMock{}
+	c.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	c.unitOfWork = &UnitOfWorkFake{
+		Repositories: repositories.UnitOfWorkRepositories{
+			CartRepository:   &c.cartRepositoryMock,
+			OrderRepository:  &c.orderRepositoryMock,
+			InventoryGateway: &c.inventoryGatewayMock,
+		},
+	}
+
+	c.addressBook = address.NewAddressBook(uuid.New())
+	defaultAddress, _ := address.NewAddress("John Doe", "Unter den Linden 1", "", "Berlin", "", "10117", "DE")
+	c.addressBook.Add(defaultAddress)
+	c.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&c.addressBook, nil)
+	c.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "DE").Return([]gateways.TaxRuleDTO{
+		{Country: "DE", TaxClass: "*", Rate: "19", Inclusive: true},
+	}, nil)
+	c.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "PT").Return([]gateways.TaxRuleDTO{
+		{Country: "PT", TaxClass: "*", Rate: "23", Inclusive: true},
+	}, nil)
+
+	c.checkout = usecases.Checkout{
+		CustomerGateway:       &c.customerGatewayMock,
+		ProductGateway:        &c.productGatewayMock,
+		InventoryGateway:      &c.inventoryGatewayMock,
+		PaymentGateway:        &c.paymentGatewayMock,
+		ClockGateway:          c.clockGateway,
+		CartRepository:        &c.cartRepositoryMock,
+		OrderRepository:       &c.orderRepositoryMock,
+		PromotionRepository:   &c.promotionRepositoryMock,
+		AddressBookRepository: &c.addressBookRepositoryMock,
+		UnitOfWork:            c.unitOfWork,
+		TaxCalculator:         &usecases.TaxCalculator{TaxRuleGateway: &c.taxRuleGatewayMock},
+		ShippingQuoter: &usecases.ShippingQuoter{
+			ShippingRateGateway: &infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods},
+		},
+	}
+}
+
+func (c *CheckoutSuite) newCustomerCart(productId uuid.UUID) cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 3},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnNoErrors_CreatesPaidOrderWithCurrentPricesAndEmptiesCart() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	product := gateways.ProductDTO{
+		Id:       productId,
+		Price:    int64(4000),
+		Currency: "BRL",
+	}
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&product, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, []gateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}},
+		time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC)).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(12000)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 12000}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(12000)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	sut, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.NotEqual(uuid.Nil, sut.OrderId)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			totalPrice, err := o.TotalPrice()
+			return err == nil &&
+				o.Id == sut.OrderId &&
+				o.PaymentId == "auth_1" &&
+				len(o.Lines) == 1 &&
+				o.Lines[0].UnitPrice.Value == 4000 &&
+				totalPrice.Value == 12000
+		}),
+		mock.MatchedBy(func(checkedOutCart cart.Cart) bool {
+			return checkedOutCart.Id == customerCart.Id && len(checkedOutCart.Items) == 0
+		}))
+	c.orderRepositoryMock.AssertCalled(c.T(), "Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Id == sut.OrderId && o.Status == order.Paid
+	}), order.PendingPayment)
+	c.Equal([]string{"OrderRepository.CreateFromCart", "InventoryGateway.Commit", "OrderRepository.Update"}, c.unitOfWork.Writes)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Void", 0)
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Release", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCouponApplied_ChargesDiscountedAmount() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.CouponCode = "SAVE10"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SAVE10").Return(&promotion.Promotion{
+		Code:       "SAVE10",
+		Type:       promotion.PercentageOff,
+		PercentOff: 10,
+		StartsAt:   time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:     time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(10800)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 10800}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(10800)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			amountDue, err := o.AmountDue()
+			return err == nil && o.CouponCode == "SAVE10" && o.Discount.Value == 1200 && amountDue.Value == 10800
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnNothingDue_CreatesPaidOrderWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.CouponCode = "FREE100"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "FREE100").Return(&promotion.Promotion{
+		Code:       "FREE100",
+		Type:       promotion.PercentageOff,
+		PercentOff: 100,
+		StartsAt:   time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:     time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			amountDue, err := o.AmountDue()
+			return err == nil && amountDue.Value == 0 && o.PaymentId == ""
+		}),
+		mock.Anything)
+	c.orderRepositoryMock.AssertCalled(c.T(), "Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Status == order.Paid
+	}), order.PendingPayment)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Capture", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnRegionWithExclusiveTax_FreezesTaxAndChargesIt() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.addressBook = address.NewAddressBook(customerCart.CustomerId)
+	homeAddress, _ := address.NewAddress("John Doe", "1 Market St", "", "San Francisco", "ca", "94105", "US")
+	c.addressBook.Add(homeAddress)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "USD", TaxClass: "standard"}, nil)
+	c.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "US").Return([]gateways.TaxRuleDTO{
+		{Country: "US", Region: "CA", TaxClass: "standard", Rate: "10", Inclusive: false},
+	}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(13200)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 13200}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(13200)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			totalTax, err := o.TotalTax()
+			return err == nil &&
+				o.TaxRegion == "CA" &&
+				o.Lines[0].TaxClass == "standard" &&
+				o.Lines[0].TaxRateBasisPoints == 1000 &&
+				!o.Lines[0].TaxInclusive &&
+				totalTax.Value == 1200
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnAddressWithoutRegion_TaxesByCountryRule() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "EUR", TaxClass: "standard"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(12000)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 12000}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(12000)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			return o.TaxRegion == "" &&
+				o.ShippingAddress.Country == "DE" &&
+				o.Lines[0].TaxRateBasisPoints == 1900 &&
+				o.Lines[0].TaxInclusive
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnChosenAddress_FreezesAddressSnapshotIntoOrder() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	chosenAddress, _ := address.NewAddress("Jane Doe", "Rua Augusta, 500", "Apt 3", "Lisboa", "", "1100-048", "PT")
+	c.addressBook.Add(chosenAddress)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 12000}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+		AddressId:  chosenAddress.Id,
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			return o.ShippingAddress == order.OrderAddress{
+				RecipientName: "Jane Doe",
+				Line1:         "Rua Augusta, 500",
+				Line2:         "Apt 3",
+				City:          "Lisboa",
+				Region:        "",
+				PostalCode:    "1100-048",
+				Country:       "PT",
+			}
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnDefaultAddressWithRegion_TaxesByAddressRegion() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.addressBook = address.NewAddressBook(customerCart.CustomerId)
+	homeAddress, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	c.addressBook.Add(homeAddress)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL", TaxClass: "standard"}, nil)
+	c.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "18", Inclusive: true},
+	}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(12000)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 12000}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(12000)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			return o.TaxRegion == "SP" && o.ShippingAddress.PostalCode == "01310-100"
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnUnknownAddress_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+		AddressId:  uuid.New(),
+	})
+
+	c.EqualError(err, "address not found")
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Reserve", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnEmptyAddressBook_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.addressBook = address.NewAddressBook(customerCart.CustomerId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "shipping address is required")
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Reserve", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnSelectedShippingMethod_ChargesShippingAndFreezesItIntoOrder() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.ShippingMethodCode = "STANDARD"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL", WeightGrams: 500}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(14990)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 14990}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(14990)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			return o.ShippingMethod == "STANDARD" && o.ShippingCost.Value == 2990
+		}),
+		mock.MatchedBy(func(checkedOutCart cart.Cart) bool {
+			return checkedOutCart.ShippingMethodCode == ""
+		}))
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnFreeShippingCoupon_DoesNotChargeShipping() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.CouponCode = "SHIPFREE"
+	customerCart.ShippingMethodCode = "EXPRESS"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SHIPFREE").Return(&promotion.Promotion{
+		Code:     "SHIPFREE",
+		Type:     promotion.FreeShipping,
+		StartsAt: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, "4242424242424242", int64(12000)).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 12000}, nil)
+	c.paymentGatewayMock.On("Capture", mock.Anything, "auth_1", int64(12000)).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.NoError(err)
+	c.orderRepositoryMock.AssertCalled(c.T(), "CreateFromCart", mock.Anything,
+		mock.MatchedBy(func(o order.Order) bool {
+			return o.ShippingMethod == "EXPRESS" && o.ShippingCost.Value == 0
+		}),
+		mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnShippingMethodThatCannotShipCart_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.ShippingMethodCode = "STANDARD"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL", WeightGrams: 12000}, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "shipping method cannot ship this weight")
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Reserve", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnRegionWithoutTaxRule_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.addressBook = address.NewAddressBook(customerCart.CustomerId)
+	homeAddress, _ := address.NewAddress("John Doe", "Av. Eduardo Ribeiro, 500", "", "Manaus", "AM", "69010-001", "BR")
+	c.addressBook.Add(homeAddress)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).
+		Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL", TaxClass: "standard"}, nil)
+	c.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "*", Rate: "18", Inclusive: true},
+	}, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "tax rule not found")
+	c.inventoryGatewayMock.AssertNotCalled(c.T(), "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	c.paymentGatewayMock.AssertNotCalled(c.T(), "Authorize", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCouponExpired_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.CouponCode = "SAVE10"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SAVE10").Return(&promotion.Promotion{
+		Code:       "SAVE10",
+		Type:       promotion.PercentageOff,
+		PercentOff: 10,
+		StartsAt:   time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:     time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "coupon has expired")
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Reserve", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCouponNotFound_ReturnsError() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	customerCart.CouponCode = "GONE"
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 4000, Currency: "BRL"}, nil)
+	c.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "GONE").Return(nil, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "coupon not found")
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnPaymentDeclined_ReturnsErrorAndKeepsCart() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Release", mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil, gateways.ErrPaymentDeclined)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4000000000000002",
+	})
+
+	c.EqualError(err, "payment declined")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "CreateFromCart", 0)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Capture", 0)
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Release", 1)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnOrderPersistenceError_VoidsAuthorization() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Release", mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 10650}, nil)
+	c.paymentGatewayMock.On("Void", mock.Anything, "auth_1").Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "connection refused")
+	c.Equal(1, c.unitOfWork.RolledBack)
+	c.Empty(c.unitOfWork.Writes)
+	c.paymentGatewayMock.AssertCalled(c.T(), "Void", mock.Anything, "auth_1")
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Capture", 0)
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Commit", 0)
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Release", 1)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCaptureDeclined_VoidsPaymentCancelsOrderAndRestocks() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	paymentGateway := infragateways.NewFakePaymentGateway()
+	c.checkout.PaymentGateway = paymentGateway
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Restock", mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: infragateways.FakeCardCaptureDeclined,
+	})
+
+	c.EqualError(err, "payment declined")
+	c.Equal([]string{"OrderRepository.CreateFromCart", "InventoryGateway.Commit", "OrderRepository.Update", "InventoryGateway.Restock"},
+		c.unitOfWork.Writes)
+	c.inventoryGatewayMock.AssertCalled(c.T(), "Restock", mock.Anything, []gateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}})
+	c.inventoryGatewayMock.AssertNumberOfCalls(c.T(), "Release", 0)
+	cancelledOrder := c.orderRepositoryMock.Calls[len(c.orderRepositoryMock.Calls)-1].Arguments.Get(1).(order.Order)
+	c.Equal(order.Cancelled, cancelledOrder.Status)
+	c.EqualError(paymentGateway.Capture(context.Background(), cancelledOrder.PaymentId, 1), "payment cannot be captured")
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnPaidStatusPersistenceError_VoidsPaymentWithoutCapturing() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Restock", mock.Anything, mock.Anything).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 10650}, nil)
+	c.paymentGatewayMock.On("Void", mock.Anything, "auth_1").Return(nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Status == order.Paid
+	}), order.PendingPayment).Return(errors.New("connection refused"))
+	c.orderRepositoryMock.On("Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Status == order.Cancelled
+	}), order.PendingPayment).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "connection refused")
+	c.Equal([]string{"OrderRepository.CreateFromCart", "InventoryGateway.Commit", "OrderRepository.Update", "InventoryGateway.Restock"},
+		c.unitOfWork.Writes)
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Capture", 0)
+	c.paymentGatewayMock.AssertCalled(c.T(), "Void", mock.Anything, "auth_1")
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCustomerNotFound_ReturnsError() {
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: uuid.New(),
+	})
+
+	c.EqualError(err, "customer not found")
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCartNotFound_ReturnsError() {
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: uuid.New(),
+	})
+
+	c.EqualError(err, "cart not found")
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnCartEmpty_ReturnsError() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items:      []cart.CartItem{},
+	}
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	c.EqualError(err, "cart is empty")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "CreateFromCart", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnProductNotFound_ReturnsError() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 1},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	c.EqualError(err, "product not found")
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "CreateFromCart", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnInsufficientStock_ReturnsErrorWithoutCharging() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(inventory.ErrInsufficientStock)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "insufficient stock")
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Authorize", 0)
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "CreateFromCart", 0)
+}
+
+func (c *CheckoutSuite) TestCheckout_Execute_OnReservationExpiredBeforeCommit_VoidsPaymentAndRollsBackOrder() {
+	productId := uuid.New()
+	customerCart := c.newCustomerCart(productId)
+	c.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	c.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	c.productGatewayMock.On("FindOneById", mock.Anything, productId).Return(&gateways.ProductDTO{Id: productId, Price: 3550, Currency: "BRL"}, nil)
+	c.inventoryGatewayMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything, time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC)).Return(nil)
+	c.paymentGatewayMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) {
+			c.clockGateway.Advance(usecases.CheckoutReservationTtl + time.Minute)
+		}).
+		Return(&gateways.PaymentAuthorizationDTO{Id: "auth_1", Amount: 10650}, nil)
+	c.orderRepositoryMock.On("CreateFromCart", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Commit", mock.Anything, mock.Anything, time.Date(2024, 11, 20, 10, 16, 0, 0, time.UTC)).Return(gateways.ErrStockReservationExpired)
+	c.paymentGatewayMock.On("Void", mock.Anything, "auth_1").Return(nil)
+	c.inventoryGatewayMock.On("Release", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := c.checkout.Execute(context.Background(), usecases.CheckoutInput{
+		CustomerId: customerCart.CustomerId,
+		CardNumber: "4242424242424242",
+	})
+
+	c.EqualError(err, "stock reservation expired")
+	c.Equal(1, c.unitOfWork.RolledBack)
+	c.Empty(c.unitOfWork.Writes)
+	c.paymentGatewayMock.AssertCalled(c.T(), "Void", mock.Anything, "auth_1")
+	c.paymentGatewayMock.AssertNumberOfCalls(c.T(), "Capture", 0)
+	c.orderRepositoryMock.AssertNumberOfCalls(c.T(), "Update", 0)
+}
+
+func TestCheckout(t *testing.T) {
+	suite.Run(t, new(CheckoutSuite))
+}
@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+)
+
+type ProductDimensionsInput struct {
+	WeightGrams int32
+	LengthCm    int32
+	WidthCm     int32
+	HeightCm    int32
+}
+
+type CreateProductInput struct {
+	Name         string
+	Description  string
+	Sku          string
+	Price        int64
+	Currency     string
+	TaxClass     string
+	Dimensions   *ProductDimensionsInput
+	InitialStock int32
+}
+
+type CreateProductOutput struct {
+	ProductId uuid.UUID
+}
+
+type ICreateProduct interface {
+	Execute(ctx context.Context, input CreateProductInput) (CreateProductOutput, error)
+}
+
+type CreateProduct struct {
+	ProductRepository repositories.IProductRepository
+	UnitOfWork        repositories.IUnitOfWork
+}
+
+func (c *CreateProduct) Execute(ctx context.Context, input CreateProductInput) (CreateProductOutput, error) {
+	currency := input.Currency
+	if currency == "" {
+		currency = models.BRL.Code
+	}
+
+	taxClass := input.TaxClass
+	if taxClass == "" {
+		taxClass = product.StandardTaxClass
+	}
+
+	newProduct, err := product.NewProduct(input.Name, input.Description, input.Sku, input.Price, currency, taxClass)
+	if err != nil {
+		return CreateProductOutput{}, err
+	}
+
+	if input.Dimensions != nil {
+		err = newProduct.SetDimensions(input.Dimensions.WeightGrams, input.Dimensions.LengthCm, input.Dimensions.WidthCm,
+			input.Dimensions.HeightCm)
+		if err != nil {
+			return CreateProductOutput{}, err
+		}
+	}
+
+	productInventory, err := inventory.NewInventory(newProduct.Id, input.InitialStock, 0)
+	if err != nil {
+		return CreateProductOutput{}, err
+	}
+
+	productWithSku, err := c.ProductRepository.FindOneBySku(ctx, newProduct.Sku)
+	if err != nil {
+		return CreateProductOutput{}, err
+	}
+
+	if productWithSku != nil {
+		return CreateProductOutput{}, ErrProductSkuAlreadyExists
+	}
+
+	err = c.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+		err := transaction.ProductRepository.Create(ctx, newProduct)
+		if err != nil {
+			return err
+		}
+
+		return transaction.InventoryGateway.Create(ctx, gateways.InventoryDTO{
+			ProductId: productInventory.ProductId,
+			OnHand:    productInventory.OnHand.Value,
+			Reserved:  productInventory.Reserved.Value,
+		})
+	})
+
+	if err != nil {
+		return CreateProductOutput{}, err
+	}
+
+	return CreateProductOutput{
+		ProductId: newProduct.Id,
+	}, nil
+}
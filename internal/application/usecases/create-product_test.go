@@ -0,0 +1,277 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductRepositoryMock struct {
+	mock.Mock
+}
+
+func (p *ProductRepositoryMock) Create(ctx context.Context, product product.Product) error {
+	args := p.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (p *ProductRepositoryMock) Update(ctx context.Context, product product.Product) error {
+	args := p.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (p *ProductRepositoryMock) FindOneById(ctx context.Context, id uuid.UUID) (*product.Product, error) {
+	args := p.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*product.Product), args.Error(1)
+}
+
+func (p *ProductRepositoryMock) FindOneBySku(ctx context.Context, sku string) (*product.Product, error) {
+	args := p.Called(ctx, sku)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*product.Product), args.Error(1)
+}
+
+func (p *ProductRepositoryMock) FindAll(ctx context.Context) ([]product.Product, error) {
+	args := p.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]product.Product), args.Error(1)
+}
+
+type CreateProductSuite struct {
+	suite.Suite
+	createProduct         usecases.CreateProduct
+	productRepositoryMock ProductRepositoryMock
+	inventoryGatewayMock  InventoryGatewayMock
+	unitOfWork            *UnitOfWorkFake
+}
+
+func (c *CreateProductSuite) SetupTest() {
+	c.productRepositoryMock = ProductRepositoryMock{}
+	c.inventoryGatewayMock = InventoryGatewayMock{}
+	c.unitOfWork = &UnitOfWorkFake{
+		Repositories: repositories.UnitOfWorkRepositories{
+			ProductRepository: &c.productRepositoryMock,
+			InventoryGateway:  &c.inventoryGatewayMock,
+		},
+	}
+
+	c.createProduct = usecases.CreateProduct{
+		ProductRepository: &c.productRepositoryMock,
+		UnitOfWork:        c.unitOfWork,
+	}
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnNewSku_CreatesProductAndReturnsId() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	sut, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:        "Mechanical Keyboard",
+		Description: "Hot-swappable switches",
+		Sku:         "kb-001",
+		Price:       45990,
+		Currency:    "BRL",
+	})
+
+	c.NoError(err)
+	c.NotEqual(uuid.Nil, sut.ProductId)
+	c.productRepositoryMock.AssertCalled(c.T(), "Create", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.Id == sut.ProductId && p.Sku == "KB-001" && p.Price.Value == 45990 && p.Active
+	}))
+	c.inventoryGatewayMock.AssertCalled(c.T(), "Create", mock.Anything, gateways.InventoryDTO{ProductId: sut.ProductId})
+	c.Equal([]string{"ProductRepository.Create", "InventoryGateway.Create"}, c.unitOfWork.Writes)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnInitialStock_CreatesInventoryWithStock() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	sut, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:         "Mechanical Keyboard",
+		Sku:          "KB-001",
+		Price:        45990,
+		InitialStock: 25,
+	})
+
+	c.NoError(err)
+	c.inventoryGatewayMock.AssertCalled(c.T(), "Create", mock.Anything, gateways.InventoryDTO{ProductId: sut.ProductId, OnHand: 25})
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnNegativeInitialStock_ReturnsError() {
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:         "Mechanical Keyboard",
+		Sku:          "KB-001",
+		Price:        45990,
+		InitialStock: -1,
+	})
+
+	c.EqualError(err, "quantity value cannot be negative")
+	c.productRepositoryMock.AssertNumberOfCalls(c.T(), "Create", 0)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnInventoryError_RollsBackProduct() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:  "Mechanical Keyboard",
+		Sku:   "KB-001",
+		Price: 45990,
+	})
+
+	c.EqualError(err, "connection refused")
+	c.Empty(c.unitOfWork.Writes)
+	c.Equal(1, c.unitOfWork.RolledBack)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnNoCurrency_DefaultsToBrl() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:  "Mechanical Keyboard",
+		Sku:   "KB-001",
+		Price: 45990,
+	})
+
+	c.NoError(err)
+	c.productRepositoryMock.AssertCalled(c.T(), "Create", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.Price.Currency == models.BRL
+	}))
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnTaxClass_CreatesProductWithTaxClass() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "BK-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:     "Domain-Driven Design",
+		Sku:      "BK-001",
+		Price:    18990,
+		TaxClass: "Books",
+	})
+
+	c.NoError(err)
+	c.productRepositoryMock.AssertCalled(c.T(), "Create", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.TaxClass == "books"
+	}))
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnNoTaxClass_DefaultsToStandard() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:  "Mechanical Keyboard",
+		Sku:   "KB-001",
+		Price: 45990,
+	})
+
+	c.NoError(err)
+	c.productRepositoryMock.AssertCalled(c.T(), "Create", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.TaxClass == product.StandardTaxClass
+	}))
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnDimensions_CreatesProductWithDimensions() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(nil, nil)
+	c.productRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	c.inventoryGatewayMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:       "Mechanical Keyboard",
+		Sku:        "KB-001",
+		Price:      45990,
+		Dimensions: &usecases.ProductDimensionsInput{WeightGrams: 1200, LengthCm: 45, WidthCm: 15, HeightCm: 4},
+	})
+
+	c.NoError(err)
+	c.productRepositoryMock.AssertCalled(c.T(), "Create", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.WeightGrams == 1200 && p.LengthCm == 45 && p.WidthCm == 15 && p.HeightCm == 4
+	}))
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnNegativeDimensions_ReturnsError() {
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:       "Mechanical Keyboard",
+		Sku:        "KB-001",
+		Price:      45990,
+		Dimensions: &usecases.ProductDimensionsInput{WeightGrams: -1},
+	})
+
+	c.EqualError(err, "product dimensions cannot be negative")
+	c.productRepositoryMock.AssertNumberOfCalls(c.T(), "Create", 0)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnUnsupportedCurrency_ReturnsError() {
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:     "Mechanical Keyboard",
+		Sku:      "KB-001",
+		Price:    45990,
+		Currency: "JPY",
+	})
+
+	c.EqualError(err, "currency is not supported")
+	c.productRepositoryMock.AssertNumberOfCalls(c.T(), "Create", 0)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnExistingSku_ReturnsError() {
+	c.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(&product.Product{
+		Id:     uuid.New(),
+		Name:   "Keyboard",
+		Sku:    "KB-001",
+		Price:  models.Money{Value: 100},
+		Active: true,
+	}, nil)
+
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:     "Mechanical Keyboard",
+		Sku:      "KB-001",
+		Price:    45990,
+		Currency: "BRL",
+	})
+
+	c.EqualError(err, "product sku already exists")
+	c.productRepositoryMock.AssertNumberOfCalls(c.T(), "Create", 0)
+}
+
+func (c *CreateProductSuite) TestCreateProduct_Execute_OnInvalidProduct_ReturnsError() {
+	_, err := c.createProduct.Execute(context.Background(), usecases.CreateProductInput{
+		Name:     "",
+		Sku:      "KB-001",
+		Price:    45990,
+		Currency: "BRL",
+	})
+
+	c.EqualError(err, "product name cannot be empty")
+	c.productRepositoryMock.AssertNumberOfCalls(c.T(), "Create", 0)
+}
+
+func TestCreateProduct(t *testing.T) {
+	suite.Run(t, new(CreateProductSuite))
+}
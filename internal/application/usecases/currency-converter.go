@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+type cachedExchangeRate struct {
+	rate      models.ExchangeRate
+	asOf      time.Time
+	fetchedAt time.Time
+}
+
+type ICurrencyConverter interface {
+	Convert(ctx context.Context, money models.Money, currency string) (models.Money, error)
+}
+
+type CurrencyConverter struct {
+	ExchangeRateGateway gateways.IExchangeRateGateway
+	ClockGateway        gateways.IClockGateway
+	Ttl                 time.Duration
+	MaxAge              time.Duration
+
+	mutex sync.Mutex
+	cache map[string]cachedExchangeRate
+}
+
+func (c *CurrencyConverter) Convert(ctx context.Context, money models.Money, currency string) (models.Money, error) {
+	target, err := models.NewCurrency(currency)
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	if money.Currency == target {
+		return money, nil
+	}
+
+	rate, err := c.findRate(ctx, money.Currency, target)
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	return rate.Convert(money)
+}
+
+func (c *CurrencyConverter) findRate(ctx context.Context, from models.Currency, to models.Currency) (models.ExchangeRate, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := from.Code + "/" + to.Code
+	now := c.ClockGateway.Now()
+
+	if cached, ok := c.cache[key]; ok && now.Sub(cached.fetchedAt) < c.Ttl && now.Sub(cached.asOf) <= c.MaxAge {
+		return cached.rate, nil
+	}
+
+	rateDTO, err := c.ExchangeRateGateway.FindRate(ctx, from.Code, to.Code)
+	if err != nil {
+		return models.ExchangeRate{}, err
+	}
+
+	if rateDTO == nil {
+		return models.ExchangeRate{}, ErrExchangeRateNotFound
+	}
+
+	if now.Sub(rateDTO.AsOf) > c.MaxAge {
+		return models.ExchangeRate{}, ErrExchangeRateExpired
+	}
+
+	rate, err := models.NewExchangeRate(rateDTO.From, rateDTO.To, rateDTO.Rate)
+	if err != nil {
+		return models.ExchangeRate{}, err
+	}
+
+	if c.cache == nil {
+		c.cache = map[string]cachedExchangeRate{}
+	}
+
+	c.cache[key] = cachedExchangeRate{rate: rate, asOf: rateDTO.AsOf, fetchedAt: now}
+	return rate, nil
+}
@@ -0,0 +1,164 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExchangeRateGatewayMock struct {
+	mock.Mock
+}
+
+func (e *ExchangeRateGatewayMock) FindRate(ctx context.Context, from string, to string) (*gateways.ExchangeRateDTO, error) {
+	args := e.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.ExchangeRateDTO), args.Error(1)
+}
+
+type CurrencyConverterSuite struct {
+	suite.Suite
+	currencyConverter       *usecases.CurrencyConverter
+	exchangeRateGatewayMock ExchangeRateGatewayMock
+	clockGateway            *infragateways.FakeClockGateway
+}
+
+func (c *CurrencyConverterSuite) SetupTest() {
+	c.exchangeRateGatewayMock = ExchangeRateGatewayMock{}
+	c.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+
+	c.currencyConverter = &usecases.CurrencyConverter{
+		ExchangeRateGateway: &c.exchangeRateGatewayMock,
+		ClockGateway:        c.clockGateway,
+		Ttl:                 time.Hour,
+		MaxAge:              48 * time.Hour,
+	}
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnRateFound_ReturnsConvertedMoney() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.18",
+		AsOf: c.clockGateway.Now(),
+	}, nil)
+
+	sut, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "usd")
+
+	c.NoError(err)
+	c.Equal(models.Money{Value: 639, Currency: models.USD}, sut)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnSameCurrency_ReturnsMoneyWithoutFetchingRate() {
+	sut, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "BRL")
+
+	c.NoError(err)
+	c.Equal(models.Money{Value: 3550, Currency: models.BRL}, sut)
+	c.exchangeRateGatewayMock.AssertNotCalled(c.T(), "FindRate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_WithinTtl_UsesCachedRate() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.18",
+		AsOf: c.clockGateway.Now(),
+	}, nil)
+
+	c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "USD")
+	c.clockGateway.Advance(59 * time.Minute)
+	sut, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 1000, Currency: models.BRL}, "USD")
+
+	c.NoError(err)
+	c.Equal(models.Money{Value: 180, Currency: models.USD}, sut)
+	c.exchangeRateGatewayMock.AssertNumberOfCalls(c.T(), "FindRate", 1)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_AfterTtl_RefreshesRate() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.18",
+		AsOf: c.clockGateway.Now(),
+	}, nil).Once()
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.2",
+		AsOf: c.clockGateway.Now().Add(time.Hour),
+	}, nil).Once()
+
+	c.currencyConverter.Convert(context.Background(), models.Money{Value: 1000, Currency: models.BRL}, "USD")
+	c.clockGateway.Advance(time.Hour)
+	sut, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 1000, Currency: models.BRL}, "USD")
+
+	c.NoError(err)
+	c.Equal(models.Money{Value: 200, Currency: models.USD}, sut)
+	c.exchangeRateGatewayMock.AssertNumberOfCalls(c.T(), "FindRate", 2)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnRateOlderThanMaxAge_ReturnsError() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.18",
+		AsOf: c.clockGateway.Now().Add(-49 * time.Hour),
+	}, nil)
+
+	_, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "USD")
+
+	c.ErrorIs(err, usecases.ErrExchangeRateExpired)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnCachedRateReachingMaxAge_RefreshesRate() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "USD").Return(&gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "0.18",
+		AsOf: c.clockGateway.Now().Add(-48*time.Hour + 30*time.Minute),
+	}, nil)
+
+	c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "USD")
+	c.clockGateway.Advance(31 * time.Minute)
+	_, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "USD")
+
+	c.ErrorIs(err, usecases.ErrExchangeRateExpired)
+	c.exchangeRateGatewayMock.AssertNumberOfCalls(c.T(), "FindRate", 2)
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnUnsupportedCurrency_ReturnsError() {
+	_, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "JPY")
+
+	c.EqualError(err, "currency is not supported")
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnRateNotFound_ReturnsError() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "EUR").Return(nil, nil)
+
+	_, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "EUR")
+
+	c.EqualError(err, "exchange rate not found")
+}
+
+func (c *CurrencyConverterSuite) TestCurrencyConverter_Convert_OnGatewayError_ReturnsError() {
+	c.exchangeRateGatewayMock.On("FindRate", mock.Anything, "BRL", "EUR").Return(nil, errors.New("connection refused"))
+
+	_, err := c.currencyConverter.Convert(context.Background(), models.Money{Value: 3550, Currency: models.BRL}, "EUR")
+
+	c.EqualError(err, "connection refused")
+}
+
+func TestCurrencyConverter(t *testing.T) {
+	suite.Run(t, new(CurrencyConverterSuite))
+}
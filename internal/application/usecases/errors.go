@@ -0,0 +1,21 @@
+package usecases
+
+import "github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+
+var (
+	ErrCustomerNotFound        = errs.NotFound("CUSTOMER_NOT_FOUND", "customer not found")
+	ErrProductNotFound         = errs.NotFound("PRODUCT_NOT_FOUND", "product not found")
+	ErrCartNotFound            = errs.NotFound("CART_NOT_FOUND", "cart not found")
+	ErrCouponNotFound          = errs.NotFound("COUPON_NOT_FOUND", "coupon not found")
+	ErrOrderNotFound           = errs.NotFound("ORDER_NOT_FOUND", "order not found")
+	ErrShippingMethodNotFound  = errs.NotFound("SHIPPING_METHOD_NOT_FOUND", "shipping method not found")
+	ErrExchangeRateNotFound    = errs.Unavailable("EXCHANGE_RATE_NOT_FOUND", "exchange rate not found")
+	ErrExchangeRateExpired     = errs.Unavailable("EXCHANGE_RATE_EXPIRED", "exchange rate has expired")
+	ErrProductSkuAlreadyExists = errs.Conflict("PRODUCT_SKU_ALREADY_EXISTS", "product sku already exists")
+	ErrShippingAddressRequired = errs.Validation("SHIPPING_ADDRESS_REQUIRED", "shipping address is required")
+	ErrOrderHasNoPayment       = errs.Conflict("ORDER_HAS_NO_PAYMENT", "order has no payment")
+	ErrOrderRefundRequired     = errs.Conflict("ORDER_REFUND_REQUIRED", "order must be refunded through the payment gateway")
+	ErrInvalidCredentials      = errs.Unauthorized("INVALID_CREDENTIALS", "invalid credentials")
+	ErrRefreshTokenInvalid     = errs.Unauthorized("REFRESH_TOKEN_INVALID", "refresh token is invalid")
+	ErrRefreshTokenExpired     = errs.Unauthorized("REFRESH_TOKEN_EXPIRED", "refresh token has expired")
+)
@@ -0,0 +1,352 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+)
+
+type GetCustomerCartInput struct {
+	CustomerId uuid.UUID
+	Currency   string
+	Country    string
+	Region     string
+	CartToken  string
+}
+
+type GetCustomerCartItemOutput struct {
+	ProductId  uuid.UUID
+	Quantity   int32
+	UnitPrice  int64
+	TotalPrice int64
+}
+
+type GetCustomerCartConvertedItemOutput struct {
+	ProductId  uuid.UUID
+	UnitPrice  int64
+	TotalPrice int64
+}
+
+type GetCustomerCartConvertedOutput struct {
+	Currency string
+	Items    []GetCustomerCartConvertedItemOutput
+	Subtotal int64
+	Discount int64
+	Total    int64
+}
+
+type GetCustomerCartTaxLineOutput struct {
+	ProductId       uuid.UUID
+	TaxClass        string
+	RateBasisPoints int64
+	Inclusive       bool
+	Net             int64
+	Tax             int64
+	Gross           int64
+}
+
+type GetCustomerCartTaxOutput struct {
+	Country string
+	Region  string
+	Lines   []GetCustomerCartTaxLineOutput
+	Net     int64
+	Tax     int64
+	Gross   int64
+}
+
+type GetCustomerCartShippingOutput struct {
+	MethodCode string
+	MethodName string
+	Cost       int64
+}
+
+type GetCustomerCartOutput struct {
+	Items         []GetCustomerCartItemOutput
+	TotalQuantity int32
+	TotalPrice    int64
+	Currency      string
+	CouponCode    string
+	Subtotal      int64
+	Discount      int64
+	Total         int64
+	FreeShipping  bool
+	Converted     *GetCustomerCartConvertedOutput
+	Tax           *GetCustomerCartTaxOutput
+	Shipping      *GetCustomerCartShippingOutput
+}
+
+type IGetCustomerCart interface {
+	Execute(ctx context.Context, input GetCustomerCartInput) (GetCustomerCartOutput, error)
+}
+
+type GetCustomerCart struct {
+	ClockGateway        gateways.IClockGateway
+	ProductGateway      gateways.IProductGateway
+	CartRepository      repositories.ICartRepository
+	PromotionRepository repositories.IPromotionRepository
+	CurrencyConverter   ICurrencyConverter
+	TaxCalculator       ITaxCalculator
+	ShippingQuoter      IShippingQuoter
+	CartTokenGateway    gateways.ICartTokenGateway
+}
+
+func (g *GetCustomerCart) Execute(ctx context.Context, input GetCustomerCartInput) (GetCustomerCartOutput, error) {
+	customerCart, err := findCart(ctx, g.CartRepository, g.CartTokenGateway, input.CustomerId, input.CartToken)
+	if err != nil {
+		return GetCustomerCartOutput{}, err
+	}
+
+	if customerCart == nil {
+		output := GetCustomerCartOutput{
+			Items:         []GetCustomerCartItemOutput{},
+			TotalQuantity: 0,
+			TotalPrice:    0,
+		}
+
+		if input.Currency != "" {
+			currency, err := models.NewCurrency(input.Currency)
+			if err != nil {
+				return GetCustomerCartOutput{}, err
+			}
+
+			output.Converted = &GetCustomerCartConvertedOutput{
+				Currency: currency.Code,
+				Items:    []GetCustomerCartConvertedItemOutput{},
+			}
+		}
+
+		if input.Country != "" || input.Region != "" {
+			if tax.NormalizeCountry(input.Country) == "" {
+				return GetCustomerCartOutput{}, tax.ErrTaxCountryEmpty
+			}
+
+			output.Tax = &GetCustomerCartTaxOutput{
+				Country: tax.NormalizeCountry(input.Country),
+				Region:  tax.NormalizeRegion(input.Region),
+				Lines:   []GetCustomerCartTaxLineOutput{},
+			}
+		}
+
+		return output, nil
+	}
+
+	items := []GetCustomerCartItemOutput{}
+	for _, item := range customerCart.Items {
+		itemTotalPrice, err := item.TotalPrice()
+		if err != nil {
+			return GetCustomerCartOutput{}, err
+		}
+
+		items = append(items, GetCustomerCartItemOutput{
+			ProductId:  item.ProductId,
+			Quantity:   item.Quantity.Value,
+			UnitPrice:  item.Price.Value,
+			TotalPrice: itemTotalPrice.Value,
+		})
+	}
+
+	breakdown, err := cartBreakdown(ctx, *customerCart, g.PromotionRepository, g.ClockGateway)
+	if err != nil {
+		return GetCustomerCartOutput{}, err
+	}
+
+	var converted *GetCustomerCartConvertedOutput
+	if input.Currency != "" {
+		converted, err = g.convert(ctx, *customerCart, breakdown, input.Currency)
+		if err != nil {
+			return GetCustomerCartOutput{}, err
+		}
+	}
+
+	var taxOutput *GetCustomerCartTaxOutput
+	if input.Country != "" || input.Region != "" {
+		taxOutput, err = g.calculateTax(ctx, *customerCart, breakdown, input.Country, input.Region)
+		if err != nil {
+			return GetCustomerCartOutput{}, err
+		}
+	}
+
+	var shippingOutput *GetCustomerCartShippingOutput
+	if customerCart.ShippingMethodCode != "" && len(customerCart.Items) > 0 {
+		shippingOutput, err = g.quoteShipping(ctx, *customerCart, breakdown)
+		if err != nil {
+			return GetCustomerCartOutput{}, err
+		}
+	}
+
+	return GetCustomerCartOutput{
+		Items:         items,
+		TotalQuantity: customerCart.TotalQuantity().Value,
+		TotalPrice:    breakdown.Subtotal.Value,
+		Currency:      breakdown.Subtotal.Currency.Code,
+		CouponCode:    customerCart.CouponCode,
+		Subtotal:      breakdown.Subtotal.Value,
+		Discount:      breakdown.Discount.Value,
+		Total:         breakdown.Total.Value,
+		FreeShipping:  breakdown.FreeShipping,
+		Converted:     converted,
+		Tax:           taxOutput,
+		Shipping:      shippingOutput,
+	}, nil
+}
+
+func (g *GetCustomerCart) quoteShipping(ctx context.Context, customerCart cart.Cart, breakdown cart.CartBreakdown) (*GetCustomerCartShippingOutput, error) {
+	parcel, err := cartParcel(ctx, g.ProductGateway, customerCart.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := g.ShippingQuoter.Quote(ctx, customerCart.ShippingMethodCode, parcel, breakdown.Total, breakdown.FreeShipping)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrShippingMethodNotFound), errors.Is(err, shipping.ErrShippingWeightNotSupported),
+			errors.Is(err, shipping.ErrShippingMethodCurrencyMismatch):
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &GetCustomerCartShippingOutput{
+		MethodCode: quote.MethodCode,
+		MethodName: quote.MethodName,
+		Cost:       quote.Cost.Value,
+	}, nil
+}
+
+func cartBreakdown(ctx context.Context, customerCart cart.Cart, promotionRepository repositories.IPromotionRepository,
+	clockGateway gateways.IClockGateway) (cart.CartBreakdown, error) {
+	breakdown, err := customerCart.Breakdown(models.Money{Value: 0}, false)
+	if err != nil {
+		return cart.CartBreakdown{}, err
+	}
+
+	if customerCart.CouponCode == "" {
+		return breakdown, nil
+	}
+
+	promotion, err := promotionRepository.FindOneByCode(ctx, customerCart.CouponCode)
+	if err != nil {
+		return cart.CartBreakdown{}, err
+	}
+
+	if promotion != nil {
+		discountedBreakdown, err := promotion.Apply(customerCart, clockGateway.Now())
+		if err == nil {
+			breakdown = discountedBreakdown
+		}
+	}
+
+	return breakdown, nil
+}
+
+func (g *GetCustomerCart) calculateTax(ctx context.Context, customerCart cart.Cart, breakdown cart.CartBreakdown, country string,
+	region string) (*GetCustomerCartTaxOutput, error) {
+	taxableLines := []tax.TaxableLine{}
+	for _, item := range customerCart.Items {
+		productDTO, err := g.ProductGateway.FindOneById(ctx, item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		taxClass := product.StandardTaxClass
+		if productDTO != nil && productDTO.TaxClass != "" {
+			taxClass = productDTO.TaxClass
+		}
+
+		itemTotalPrice, err := item.TotalPrice()
+		if err != nil {
+			return nil, err
+		}
+
+		taxableLines = append(taxableLines, tax.TaxableLine{
+			ProductId: item.ProductId,
+			TaxClass:  taxClass,
+			Amount:    itemTotalPrice,
+		})
+	}
+
+	calculation, err := g.TaxCalculator.Calculate(ctx, country, region, taxableLines, breakdown.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []GetCustomerCartTaxLineOutput{}
+	for _, line := range calculation.Lines {
+		lines = append(lines, GetCustomerCartTaxLineOutput{
+			ProductId:       line.ProductId,
+			TaxClass:        line.TaxClass,
+			RateBasisPoints: line.RateBasisPoints,
+			Inclusive:       line.Inclusive,
+			Net:             line.Net.Value,
+			Tax:             line.Tax.Value,
+			Gross:           line.Gross.Value,
+		})
+	}
+
+	return &GetCustomerCartTaxOutput{
+		Country: calculation.Country,
+		Region:  calculation.Region,
+		Lines:   lines,
+		Net:     calculation.Net.Value,
+		Tax:     calculation.Tax.Value,
+		Gross:   calculation.Gross.Value,
+	}, nil
+}
+
+func (g *GetCustomerCart) convert(ctx context.Context, customerCart cart.Cart, breakdown cart.CartBreakdown, currency string) (*GetCustomerCartConvertedOutput, error) {
+	items := []GetCustomerCartConvertedItemOutput{}
+	for _, item := range customerCart.Items {
+		itemTotalPrice, err := item.TotalPrice()
+		if err != nil {
+			return nil, err
+		}
+
+		unitPrice, err := g.CurrencyConverter.Convert(ctx, item.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		totalPrice, err := g.CurrencyConverter.Convert(ctx, itemTotalPrice, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, GetCustomerCartConvertedItemOutput{
+			ProductId:  item.ProductId,
+			UnitPrice:  unitPrice.Value,
+			TotalPrice: totalPrice.Value,
+		})
+	}
+
+	subtotal, err := g.CurrencyConverter.Convert(ctx, breakdown.Subtotal, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := g.CurrencyConverter.Convert(ctx, breakdown.Discount, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := g.CurrencyConverter.Convert(ctx, breakdown.Total, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetCustomerCartConvertedOutput{
+		Currency: subtotal.Currency.Code,
+		Items:    items,
+		Subtotal: subtotal.Value,
+		Discount: discount.Value,
+		Total:    total.Value,
+	}, nil
+}
@@ -0,0 +1,422 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCustomerCartSuite struct {
+	suite.Suite
+	getCustomerCart         usecases.GetCustomerCart
+	cartRepositoryMock      CartRepositoryMock
+	cartTokenGatewayMock    CartTokenGatewayMock
+	promotionRepositoryMock PromotionRepositoryMock
+	productGatewayMock      ProductGatewayMock
+	taxRuleGatewayMock      TaxRuleGatewayMock
+	clockGateway            *infragateways.FakeClockGateway
+}
+
+func (g *GetCustomerCartSuite) SetupTest() {
+	g.cartRepositoryMock = CartRepositoryMock{}
+	g.cartTokenGatewayMock = CartTokenGatewayMock{}
+	g.promotionRepositoryMock = PromotionRepositoryMock{}
+	g.productGatewayMock = ProductGatewayMock{}
+	g.taxRuleGatewayMock = TaxRuleGatewayMock{}
+	g.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+
+	g.getCustomerCart = usecases.GetCustomerCart{
+		ClockGateway:        g.clockGateway,
+		ProductGateway:      &g.productGatewayMock,
+		CartRepository:      &g.cartRepositoryMock,
+		PromotionRepository: &g.promotionRepositoryMock,
+		CurrencyConverter:   newStaticCurrencyConverter(),
+		TaxCalculator:       &usecases.TaxCalculator{TaxRuleGateway: &g.taxRuleGatewayMock},
+		ShippingQuoter: &usecases.ShippingQuoter{
+			ShippingRateGateway: &infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods},
+		},
+		CartTokenGateway: &g.cartTokenGatewayMock,
+	}
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnExistingCart_ReturnsItemsAndTotals() {
+	product1 := uuid.New()
+	product2 := uuid.New()
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: product1,
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 3550},
+			},
+			{
+				Id:        uuid.New(),
+				ProductId: product2,
+				Quantity:  models.Quantity{Value: 3},
+				Price:     models.Money{Value: 1000},
+			},
+		},
+	}
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Equal(usecases.GetCustomerCartOutput{
+		Items: []usecases.GetCustomerCartItemOutput{
+			{ProductId: product1, Quantity: 2, UnitPrice: 3550, TotalPrice: 7100},
+			{ProductId: product2, Quantity: 3, UnitPrice: 1000, TotalPrice: 3000},
+		},
+		TotalQuantity: 5,
+		TotalPrice:    10100,
+		Subtotal:      10100,
+		Total:         10100,
+	}, sut)
+}
+
+func (g *GetCustomerCartSuite) newCouponCart(couponCode string) cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		CouponCode: couponCode,
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 5000},
+			},
+		},
+	}
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnValidCoupon_ReturnsDiscountedBreakdown() {
+	customerCart := g.newCouponCart("TAKE15")
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "TAKE15").Return(&promotion.Promotion{
+		Code:      "TAKE15",
+		Type:      promotion.FixedAmountOff,
+		AmountOff: models.Money{Value: 1500},
+		StartsAt:  time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:    time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Equal("TAKE15", sut.CouponCode)
+	g.Equal(int64(10000), sut.Subtotal)
+	g.Equal(int64(1500), sut.Discount)
+	g.Equal(int64(8500), sut.Total)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnExpiredCoupon_ReturnsUndiscountedBreakdown() {
+	customerCart := g.newCouponCart("TAKE15")
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "TAKE15").Return(&promotion.Promotion{
+		Code:      "TAKE15",
+		Type:      promotion.FixedAmountOff,
+		AmountOff: models.Money{Value: 1500},
+		StartsAt:  time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:    time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Equal("TAKE15", sut.CouponCode)
+	g.Equal(int64(0), sut.Discount)
+	g.Equal(int64(10000), sut.Total)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnCartNotFound_ReturnsEmptyCart() {
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: uuid.New(),
+	})
+
+	g.NoError(err)
+	g.Equal(usecases.GetCustomerCartOutput{
+		Items:         []usecases.GetCustomerCartItemOutput{},
+		TotalQuantity: 0,
+		TotalPrice:    0,
+	}, sut)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnGuestCart_ReturnsItemsAndTotals() {
+	productId := uuid.New()
+	guestCart := cart.NewGuestCart()
+	guestCart.Items = []cart.CartItem{
+		{
+			Id:        uuid.New(),
+			ProductId: productId,
+			Quantity:  models.Quantity{Value: 2},
+			Price:     models.Money{Value: 3550},
+		},
+	}
+	g.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	g.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CartToken: "cart-token",
+	})
+
+	g.NoError(err)
+	g.Equal(int32(2), sut.TotalQuantity)
+	g.Equal(int64(7100), sut.Total)
+	g.cartRepositoryMock.AssertNotCalled(g.T(), "FindOneByCustomerId", mock.Anything, mock.Anything)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnGuestWithoutCartToken_ReturnsEmptyCart() {
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{})
+
+	g.NoError(err)
+	g.Equal(usecases.GetCustomerCartOutput{
+		Items:         []usecases.GetCustomerCartItemOutput{},
+		TotalQuantity: 0,
+		TotalPrice:    0,
+	}, sut)
+	g.cartTokenGatewayMock.AssertNotCalled(g.T(), "Verify", mock.Anything, mock.Anything)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnCurrency_ReturnsOriginalAndConvertedAmounts() {
+	productId := uuid.New()
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 3},
+				Price:     models.Money{Value: 3550, Currency: models.BRL},
+			},
+		},
+	}
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Currency:   "USD",
+	})
+
+	g.NoError(err)
+	g.Equal(int64(10650), sut.Total)
+	g.Equal("BRL", sut.Currency)
+	g.Equal(&usecases.GetCustomerCartConvertedOutput{
+		Currency: "USD",
+		Items: []usecases.GetCustomerCartConvertedItemOutput{
+			{ProductId: productId, UnitPrice: 639, TotalPrice: 1917},
+		},
+		Subtotal: 1917,
+		Discount: 0,
+		Total:    1917,
+	}, sut.Converted)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnCurrencyAndCartNotFound_ReturnsEmptyConvertedCart() {
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: uuid.New(),
+		Currency:   "usd",
+	})
+
+	g.NoError(err)
+	g.Equal(&usecases.GetCustomerCartConvertedOutput{
+		Currency: "USD",
+		Items:    []usecases.GetCustomerCartConvertedItemOutput{},
+	}, sut.Converted)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnUnsupportedCurrency_ReturnsError() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+
+	_, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Currency:   "JPY",
+	})
+
+	g.EqualError(err, "currency is not supported")
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnRateNotFound_ReturnsError() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+
+	_, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Currency:   "EUR",
+	})
+
+	g.EqualError(err, "exchange rate not found")
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnRegion_ReturnsTaxPerLineAndTotals() {
+	book := uuid.New()
+	keyboard := uuid.New()
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{Id: uuid.New(), ProductId: book, Quantity: models.Quantity{Value: 1}, Price: models.Money{Value: 5000, Currency: models.BRL}},
+			{Id: uuid.New(), ProductId: keyboard, Quantity: models.Quantity{Value: 1}, Price: models.Money{Value: 11800, Currency: models.BRL}},
+		},
+	}
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, book).Return(&gateways.ProductDTO{Id: book, TaxClass: "books"}, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, keyboard).Return(&gateways.ProductDTO{Id: keyboard, TaxClass: "standard"}, nil)
+	g.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "books", Rate: "0", Inclusive: true},
+		{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "18", Inclusive: true},
+	}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Country:    "br",
+		Region:     "sp",
+	})
+
+	g.NoError(err)
+	g.Equal(&usecases.GetCustomerCartTaxOutput{
+		Country: "BR",
+		Region:  "SP",
+		Lines: []usecases.GetCustomerCartTaxLineOutput{
+			{ProductId: book, TaxClass: "books", RateBasisPoints: 0, Inclusive: true, Net: 5000, Tax: 0, Gross: 5000},
+			{ProductId: keyboard, TaxClass: "standard", RateBasisPoints: 1800, Inclusive: true, Net: 10000, Tax: 1800, Gross: 11800},
+		},
+		Net:   15000,
+		Tax:   1800,
+		Gross: 16800,
+	}, sut.Tax)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnRegionWithoutTaxRule_ReturnsError() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+	g.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{}, nil)
+
+	_, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Country:    "BR",
+		Region:     "AM",
+	})
+
+	g.EqualError(err, "tax rule not found")
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnRegionWithoutCountry_ReturnsError() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+		Region:     "SP",
+	})
+
+	g.EqualError(err, "tax country cannot be empty")
+	g.taxRuleGatewayMock.AssertNumberOfCalls(g.T(), "FindByCountry", 0)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnSelectedShippingMethod_ReturnsShippingQuote() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	customerCart.ShippingMethodCode = "STANDARD"
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, customerCart.Items[0].ProductId).
+		Return(&gateways.ProductDTO{Id: customerCart.Items[0].ProductId, WeightGrams: 600}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Equal(&usecases.GetCustomerCartShippingOutput{
+		MethodCode: "STANDARD",
+		MethodName: "Standard delivery",
+		Cost:       2990,
+	}, sut.Shipping)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnFreeShippingCoupon_ReturnsZeroShippingCost() {
+	customerCart := g.newCouponCart("SHIPFREE")
+	customerCart.Items[0].Price.Currency = models.BRL
+	customerCart.ShippingMethodCode = "EXPRESS"
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 600}, nil)
+	g.promotionRepositoryMock.On("FindOneByCode", mock.Anything, "SHIPFREE").Return(&promotion.Promotion{
+		Code:     "SHIPFREE",
+		Type:     promotion.FreeShipping,
+		StartsAt: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Equal(true, sut.FreeShipping)
+	g.Equal(&usecases.GetCustomerCartShippingOutput{
+		MethodCode: "EXPRESS",
+		MethodName: "Express delivery",
+		Cost:       0,
+	}, sut.Shipping)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnShippingMethodThatCannotShipCart_ReturnsNoShipping() {
+	customerCart := g.newCouponCart("")
+	customerCart.Items[0].Price.Currency = models.BRL
+	customerCart.ShippingMethodCode = "STANDARD"
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	g.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 20000}, nil)
+
+	sut, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	g.NoError(err)
+	g.Nil(sut.Shipping)
+}
+
+func (g *GetCustomerCartSuite) TestGetCustomerCart_Execute_OnRepositoryError_ReturnsError() {
+	g.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := g.getCustomerCart.Execute(context.Background(), usecases.GetCustomerCartInput{
+		CustomerId: uuid.New(),
+	})
+
+	g.EqualError(err, "connection refused")
+}
+
+func TestGetCustomerCart(t *testing.T) {
+	suite.Run(t, new(GetCustomerCartSuite))
+}
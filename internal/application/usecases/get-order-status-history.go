@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type GetOrderStatusHistoryInput struct {
+	OrderId uuid.UUID
+}
+
+type GetOrderStatusHistoryEntryOutput struct {
+	From      string
+	To        string
+	Actor     string
+	ChangedAt time.Time
+}
+
+type GetOrderStatusHistoryOutput struct {
+	OrderId uuid.UUID
+	Status  string
+	History []GetOrderStatusHistoryEntryOutput
+}
+
+type IGetOrderStatusHistory interface {
+	Execute(ctx context.Context, input GetOrderStatusHistoryInput) (GetOrderStatusHistoryOutput, error)
+}
+
+type GetOrderStatusHistory struct {
+	OrderRepository repositories.IOrderRepository
+}
+
+func (g *GetOrderStatusHistory) Execute(ctx context.Context, input GetOrderStatusHistoryInput) (GetOrderStatusHistoryOutput, error) {
+	existingOrder, err := g.OrderRepository.FindOneById(ctx, input.OrderId)
+	if err != nil {
+		return GetOrderStatusHistoryOutput{}, err
+	}
+
+	if existingOrder == nil {
+		return GetOrderStatusHistoryOutput{}, ErrOrderNotFound
+	}
+
+	history := []GetOrderStatusHistoryEntryOutput{}
+	for _, statusChange := range existingOrder.StatusHistory {
+		history = append(history, GetOrderStatusHistoryEntryOutput{
+			From:      string(statusChange.From),
+			To:        string(statusChange.To),
+			Actor:     statusChange.Actor,
+			ChangedAt: statusChange.ChangedAt,
+		})
+	}
+
+	return GetOrderStatusHistoryOutput{
+		OrderId: existingOrder.Id,
+		Status:  string(existingOrder.Status),
+		History: history,
+	}, nil
+}
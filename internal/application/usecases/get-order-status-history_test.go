@@ -0,0 +1,73 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetOrderStatusHistorySuite struct {
+	suite.Suite
+	getOrderStatusHistory usecases.GetOrderStatusHistory
+	orderRepositoryMock   OrderRepositoryMock
+}
+
+func (g *GetOrderStatusHistorySuite) SetupTest() {
+	g.orderRepositoryMock = OrderRepositoryMock{}
+
+	g.getOrderStatusHistory = usecases.GetOrderStatusHistory{
+		OrderRepository: &g.orderRepositoryMock,
+	}
+}
+
+func (g *GetOrderStatusHistorySuite) TestGetOrderStatusHistory_Execute_OnOrderExists_ReturnsHistory() {
+	paidAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	existingOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Status:     order.Paid,
+		StatusHistory: []order.OrderStatusChange{
+			{
+				Id:        uuid.New(),
+				From:      order.PendingPayment,
+				To:        order.Paid,
+				Actor:     "payment-provider",
+				ChangedAt: paidAt,
+			},
+		},
+	}
+	g.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+
+	sut, err := g.getOrderStatusHistory.Execute(context.Background(), usecases.GetOrderStatusHistoryInput{
+		OrderId: existingOrder.Id,
+	})
+
+	g.NoError(err)
+	g.Equal(usecases.GetOrderStatusHistoryOutput{
+		OrderId: existingOrder.Id,
+		Status:  "PAID",
+		History: []usecases.GetOrderStatusHistoryEntryOutput{
+			{From: "PENDING_PAYMENT", To: "PAID", Actor: "payment-provider", ChangedAt: paidAt},
+		},
+	}, sut)
+}
+
+func (g *GetOrderStatusHistorySuite) TestGetOrderStatusHistory_Execute_OnOrderNotFound_ReturnsError() {
+	g.orderRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := g.getOrderStatusHistory.Execute(context.Background(), usecases.GetOrderStatusHistoryInput{
+		OrderId: uuid.New(),
+	})
+
+	g.EqualError(err, "order not found")
+}
+
+func TestGetOrderStatusHistory(t *testing.T) {
+	suite.Run(t, new(GetOrderStatusHistorySuite))
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type ListAddressesInput struct {
+	CustomerId uuid.UUID
+}
+
+type ListAddressesItemOutput struct {
+	AddressId       uuid.UUID
+	RecipientName   string
+	Line1           string
+	Line2           string
+	City            string
+	Region          string
+	PostalCode      string
+	Country         string
+	DefaultShipping bool
+	DefaultBilling  bool
+}
+
+type ListAddressesOutput struct {
+	Addresses []ListAddressesItemOutput
+}
+
+type IListAddresses interface {
+	Execute(ctx context.Context, input ListAddressesInput) (ListAddressesOutput, error)
+}
+
+type ListAddresses struct {
+	CustomerGateway       gateways.ICustomerGateway
+	AddressBookRepository repositories.IAddressBookRepository
+}
+
+func (l *ListAddresses) Execute(ctx context.Context, input ListAddressesInput) (ListAddressesOutput, error) {
+	customerExists, err := l.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return ListAddressesOutput{}, err
+	}
+
+	if !customerExists {
+		return ListAddressesOutput{}, ErrCustomerNotFound
+	}
+
+	addressBook, err := l.AddressBookRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return ListAddressesOutput{}, err
+	}
+
+	addresses := []ListAddressesItemOutput{}
+	if addressBook == nil {
+		return ListAddressesOutput{Addresses: addresses}, nil
+	}
+
+	for _, customerAddress := range addressBook.Addresses {
+		addresses = append(addresses, ListAddressesItemOutput{
+			AddressId:       customerAddress.Id,
+			RecipientName:   customerAddress.RecipientName,
+			Line1:           customerAddress.Line1,
+			Line2:           customerAddress.Line2,
+			City:            customerAddress.City,
+			Region:          customerAddress.Region,
+			PostalCode:      customerAddress.PostalCode,
+			Country:         customerAddress.Country,
+			DefaultShipping: customerAddress.DefaultShipping,
+			DefaultBilling:  customerAddress.DefaultBilling,
+		})
+	}
+
+	return ListAddressesOutput{
+		Addresses: addresses,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListAddressesSuite struct {
+	suite.Suite
+	listAddresses             usecases.ListAddresses
+	customerGatewayMock       CustomerGatewayMock
+	addressBookRepositoryMock AddressBookRepositoryMock
+}
+
+func (l *ListAddressesSuite) SetupTest() {
+	l.customerGatewayMock = CustomerGatewayMock{}
+	l.addressBookRepositoryMock = AddressBookRepositoryMock{}
+
+	l.listAddresses = usecases.ListAddresses{
+		CustomerGateway:       &l.customerGatewayMock,
+		AddressBookRepository: &l.addressBookRepositoryMock,
+	}
+}
+
+func (l *ListAddressesSuite) TestListAddresses_Execute_OnAddressBook_ReturnsAddresses() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "Apt 12", "São Paulo", "SP", "01310-100", "BR")
+	addressBook.Add(home)
+	l.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	l.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	output, err := l.listAddresses.Execute(context.Background(), usecases.ListAddressesInput{
+		CustomerId: customerId,
+	})
+
+	l.NoError(err)
+	l.Equal(usecases.ListAddressesOutput{
+		Addresses: []usecases.ListAddressesItemOutput{
+			{
+				AddressId:       home.Id,
+				RecipientName:   "John Doe",
+				Line1:           "Av. Paulista, 1000",
+				Line2:           "Apt 12",
+				City:            "São Paulo",
+				Region:          "SP",
+				PostalCode:      "01310-100",
+				Country:         "BR",
+				DefaultShipping: true,
+				DefaultBilling:  true,
+			},
+		},
+	}, output)
+}
+
+func (l *ListAddressesSuite) TestListAddresses_Execute_OnEmptyAddressBook_ReturnsEmptyList() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	l.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	l.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	output, err := l.listAddresses.Execute(context.Background(), usecases.ListAddressesInput{
+		CustomerId: customerId,
+	})
+
+	l.NoError(err)
+	l.Equal([]usecases.ListAddressesItemOutput{}, output.Addresses)
+}
+
+func (l *ListAddressesSuite) TestListAddresses_Execute_OnCustomerNotFound_ReturnsError() {
+	l.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := l.listAddresses.Execute(context.Background(), usecases.ListAddressesInput{
+		CustomerId: uuid.New(),
+	})
+
+	l.EqualError(err, "customer not found")
+}
+
+func TestListAddresses(t *testing.T) {
+	suite.Run(t, new(ListAddressesSuite))
+}
@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+type ListProductsInput struct {
+	Currency string
+}
+
+type ListProductsConvertedPriceOutput struct {
+	Currency string
+	Price    int64
+}
+
+type ListProductsItemOutput struct {
+	Id          uuid.UUID
+	Name        string
+	Description string
+	Sku         string
+	Price       int64
+	Currency    string
+	Active      bool
+	Converted   *ListProductsConvertedPriceOutput
+}
+
+type ListProductsOutput struct {
+	Products []ListProductsItemOutput
+}
+
+type IListProducts interface {
+	Execute(ctx context.Context, input ListProductsInput) (ListProductsOutput, error)
+}
+
+type ListProducts struct {
+	ProductRepository repositories.IProductRepository
+	CurrencyConverter ICurrencyConverter
+}
+
+func (l *ListProducts) Execute(ctx context.Context, input ListProductsInput) (ListProductsOutput, error) {
+	if input.Currency != "" {
+		if _, err := models.NewCurrency(input.Currency); err != nil {
+			return ListProductsOutput{}, err
+		}
+	}
+
+	products, err := l.ProductRepository.FindAll(ctx)
+	if err != nil {
+		return ListProductsOutput{}, err
+	}
+
+	items := []ListProductsItemOutput{}
+	for _, product := range products {
+		var converted *ListProductsConvertedPriceOutput
+		if input.Currency != "" {
+			price, err := l.CurrencyConverter.Convert(ctx, product.Price, input.Currency)
+			if err != nil {
+				return ListProductsOutput{}, err
+			}
+
+			converted = &ListProductsConvertedPriceOutput{
+				Currency: price.Currency.Code,
+				Price:    price.Value,
+			}
+		}
+
+		items = append(items, ListProductsItemOutput{
+			Id:          product.Id,
+			Name:        product.Name,
+			Description: product.Description,
+			Sku:         product.Sku,
+			Price:       product.Price.Value,
+			Currency:    product.Price.Currency.Code,
+			Active:      product.Active,
+			Converted:   converted,
+		})
+	}
+
+	return ListProductsOutput{
+		Products: items,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListProductsSuite struct {
+	suite.Suite
+	listProducts          usecases.ListProducts
+	productRepositoryMock ProductRepositoryMock
+}
+
+func newStaticCurrencyConverter() *usecases.CurrencyConverter {
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	exchangeRateGateway, _ := infragateways.NewStaticExchangeRateGateway("BRL", now, map[string]string{"USD": "0.18"})
+
+	return &usecases.CurrencyConverter{
+		ExchangeRateGateway: exchangeRateGateway,
+		ClockGateway:        infragateways.NewFakeClockGateway(now),
+		Ttl:                 time.Hour,
+		MaxAge:              48 * time.Hour,
+	}
+}
+
+func (l *ListProductsSuite) SetupTest() {
+	l.productRepositoryMock = ProductRepositoryMock{}
+
+	l.listProducts = usecases.ListProducts{
+		ProductRepository: &l.productRepositoryMock,
+		CurrencyConverter: newStaticCurrencyConverter(),
+	}
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnProductsExist_ReturnsProducts() {
+	keyboard := product.Product{Id: uuid.New(), Name: "Keyboard", Description: "Mechanical", Sku: "KB-001", Price: models.Money{Value: 45990}, Active: true}
+	mouse := product.Product{Id: uuid.New(), Name: "Mouse", Sku: "MS-001", Price: models.Money{Value: 12990}, Active: false}
+	l.productRepositoryMock.On("FindAll", mock.Anything).Return([]product.Product{keyboard, mouse}, nil)
+
+	sut, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{})
+
+	l.NoError(err)
+	l.Equal(usecases.ListProductsOutput{
+		Products: []usecases.ListProductsItemOutput{
+			{Id: keyboard.Id, Name: "Keyboard", Description: "Mechanical", Sku: "KB-001", Price: 45990, Active: true},
+			{Id: mouse.Id, Name: "Mouse", Description: "", Sku: "MS-001", Price: 12990, Active: false},
+		},
+	}, sut)
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnNoProducts_ReturnsEmptyList() {
+	l.productRepositoryMock.On("FindAll", mock.Anything).Return([]product.Product{}, nil)
+
+	sut, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{})
+
+	l.NoError(err)
+	l.Equal(usecases.ListProductsOutput{Products: []usecases.ListProductsItemOutput{}}, sut)
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnCurrency_ReturnsOriginalAndConvertedPrices() {
+	keyboard := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990, Currency: models.BRL}, Active: true}
+	l.productRepositoryMock.On("FindAll", mock.Anything).Return([]product.Product{keyboard}, nil)
+
+	sut, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{Currency: "usd"})
+
+	l.NoError(err)
+	l.Equal(usecases.ListProductsOutput{
+		Products: []usecases.ListProductsItemOutput{
+			{
+				Id:        keyboard.Id,
+				Name:      "Keyboard",
+				Sku:       "KB-001",
+				Price:     45990,
+				Currency:  "BRL",
+				Active:    true,
+				Converted: &usecases.ListProductsConvertedPriceOutput{Currency: "USD", Price: 8278},
+			},
+		},
+	}, sut)
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnUnsupportedCurrency_ReturnsError() {
+	_, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{Currency: "JPY"})
+
+	l.EqualError(err, "currency is not supported")
+	l.productRepositoryMock.AssertNotCalled(l.T(), "FindAll", mock.Anything)
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnRateNotFound_ReturnsError() {
+	keyboard := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990, Currency: models.BRL}, Active: true}
+	l.productRepositoryMock.On("FindAll", mock.Anything).Return([]product.Product{keyboard}, nil)
+
+	_, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{Currency: "EUR"})
+
+	l.EqualError(err, "exchange rate not found")
+}
+
+func (l *ListProductsSuite) TestListProducts_Execute_OnRepositoryError_ReturnsError() {
+	l.productRepositoryMock.On("FindAll", mock.Anything).Return([]product.Product{}, errors.New("connection refused"))
+
+	_, err := l.listProducts.Execute(context.Background(), usecases.ListProductsInput{})
+
+	l.EqualError(err, "connection refused")
+}
+
+func TestListProducts(t *testing.T) {
+	suite.Run(t, new(ListProductsSuite))
+}
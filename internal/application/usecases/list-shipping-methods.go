@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+)
+
+type ListShippingMethodsInput struct {
+	CustomerId uuid.UUID
+}
+
+type ListShippingMethodsItemOutput struct {
+	MethodCode string
+	MethodName string
+	Currency   string
+	Cost       int64
+	Selected   bool
+}
+
+type ListShippingMethodsOutput struct {
+	Methods []ListShippingMethodsItemOutput
+}
+
+type IListShippingMethods interface {
+	Execute(ctx context.Context, input ListShippingMethodsInput) (ListShippingMethodsOutput, error)
+}
+
+type ListShippingMethods struct {
+	ProductGateway      gateways.IProductGateway
+	ClockGateway        gateways.IClockGateway
+	CartRepository      repositories.ICartRepository
+	PromotionRepository repositories.IPromotionRepository
+	ShippingQuoter      IShippingQuoter
+}
+
+func (l *ListShippingMethods) Execute(ctx context.Context, input ListShippingMethodsInput) (ListShippingMethodsOutput, error) {
+	customerCart, err := l.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return ListShippingMethodsOutput{}, err
+	}
+
+	if customerCart == nil {
+		return ListShippingMethodsOutput{}, ErrCartNotFound
+	}
+
+	if len(customerCart.Items) == 0 {
+		return ListShippingMethodsOutput{}, cart.ErrCartEmpty
+	}
+
+	breakdown, err := cartBreakdown(ctx, *customerCart, l.PromotionRepository, l.ClockGateway)
+	if err != nil {
+		return ListShippingMethodsOutput{}, err
+	}
+
+	parcel, err := cartParcel(ctx, l.ProductGateway, customerCart.Items)
+	if err != nil {
+		return ListShippingMethodsOutput{}, err
+	}
+
+	quotes, err := l.ShippingQuoter.QuoteAll(ctx, parcel, breakdown.Total, breakdown.FreeShipping)
+	if err != nil {
+		return ListShippingMethodsOutput{}, err
+	}
+
+	methods := []ListShippingMethodsItemOutput{}
+	for _, quote := range quotes {
+		methods = append(methods, ListShippingMethodsItemOutput{
+			MethodCode: quote.MethodCode,
+			MethodName: quote.MethodName,
+			Currency:   quote.Cost.Currency.Code,
+			Cost:       quote.Cost.Value,
+			Selected:   quote.MethodCode == customerCart.ShippingMethodCode,
+		})
+	}
+
+	return ListShippingMethodsOutput{
+		Methods: methods,
+	}, nil
+}
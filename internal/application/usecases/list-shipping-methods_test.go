@@ -0,0 +1,118 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListShippingMethodsSuite struct {
+	suite.Suite
+	listShippingMethods     usecases.ListShippingMethods
+	productGatewayMock      ProductGatewayMock
+	cartRepositoryMock      CartRepositoryMock
+	promotionRepositoryMock PromotionRepositoryMock
+}
+
+func (l *ListShippingMethodsSuite) SetupTest() {
+	l.productGatewayMock = ProductGatewayMock{}
+	l.cartRepositoryMock = CartRepositoryMock{}
+	l.promotionRepositoryMock = PromotionRepositoryMock{}
+
+	l.listShippingMethods = usecases.ListShippingMethods{
+		ProductGateway:      &l.productGatewayMock,
+		ClockGateway:        infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)),
+		CartRepository:      &l.cartRepositoryMock,
+		PromotionRepository: &l.promotionRepositoryMock,
+		ShippingQuoter: &usecases.ShippingQuoter{
+			ShippingRateGateway: &infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods},
+		},
+	}
+}
+
+func (l *ListShippingMethodsSuite) newCustomerCart(unitPrice int64) cart.Cart {
+	return cart.Cart{
+		Id:                 uuid.New(),
+		CustomerId:         uuid.New(),
+		ShippingMethodCode: "EXPRESS",
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: unitPrice, Currency: models.BRL},
+			},
+		},
+	}
+}
+
+func (l *ListShippingMethodsSuite) TestListShippingMethods_Execute_OnCart_ReturnsQuoteForEveryMethod() {
+	customerCart := l.newCustomerCart(5000)
+	l.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	l.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 400}, nil)
+
+	sut, err := l.listShippingMethods.Execute(context.Background(), usecases.ListShippingMethodsInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	l.NoError(err)
+	l.Equal(usecases.ListShippingMethodsOutput{
+		Methods: []usecases.ListShippingMethodsItemOutput{
+			{MethodCode: "STANDARD", MethodName: "Standard delivery", Currency: "BRL", Cost: 1990, Selected: false},
+			{MethodCode: "EXPRESS", MethodName: "Express delivery", Currency: "BRL", Cost: 4990, Selected: true},
+			{MethodCode: "ECONOMY", MethodName: "Economy delivery", Currency: "BRL", Cost: 1490, Selected: false},
+		},
+	}, sut)
+}
+
+func (l *ListShippingMethodsSuite) TestListShippingMethods_Execute_OnCartAboveThresholdAndTooHeavy_SkipsMethodsThatCannotShip() {
+	customerCart := l.newCustomerCart(15000)
+	l.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	l.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 16000}, nil)
+
+	sut, err := l.listShippingMethods.Execute(context.Background(), usecases.ListShippingMethodsInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	l.NoError(err)
+	l.Equal(usecases.ListShippingMethodsOutput{
+		Methods: []usecases.ListShippingMethodsItemOutput{
+			{MethodCode: "EXPRESS", MethodName: "Express delivery", Currency: "BRL", Cost: 4990, Selected: true},
+			{MethodCode: "ECONOMY", MethodName: "Economy delivery", Currency: "BRL", Cost: 0, Selected: false},
+		},
+	}, sut)
+}
+
+func (l *ListShippingMethodsSuite) TestListShippingMethods_Execute_OnCartNotFound_ReturnsError() {
+	l.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := l.listShippingMethods.Execute(context.Background(), usecases.ListShippingMethodsInput{
+		CustomerId: uuid.New(),
+	})
+
+	l.EqualError(err, "cart not found")
+}
+
+func (l *ListShippingMethodsSuite) TestListShippingMethods_Execute_OnEmptyCart_ReturnsError() {
+	customerCart := cart.Cart{Id: uuid.New(), CustomerId: uuid.New(), Items: []cart.CartItem{}}
+	l.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := l.listShippingMethods.Execute(context.Background(), usecases.ListShippingMethodsInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	l.EqualError(err, "cart is empty")
+}
+
+func TestListShippingMethods(t *testing.T) {
+	suite.Run(t, new(ListShippingMethodsSuite))
+}
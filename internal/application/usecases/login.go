@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+const (
+	AccessTokenTtl  = 15 * time.Minute
+	RefreshTokenTtl = 30 * 24 * time.Hour
+)
+
+const dummyPasswordHash = "$2a$10$rjC.3a1cIKOOAi/mBNjWi.Q0HWe9ZqZ290h7S8yWleiga.zoTuKby"
+
+type LoginInput struct {
+	Email     string
+	Password  string
+	CartToken string
+}
+
+type LoginOutput struct {
+	CustomerId            uuid.UUID
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	GuestCartMerged       bool
+	GuestCartMergeError   error
+}
+
+type ILogin interface {
+	Execute(ctx context.Context, input LoginInput) (LoginOutput, error)
+}
+
+type Login struct {
+	PasswordHasherGateway  gateways.IPasswordHasherGateway
+	AccessTokenGateway     gateways.IAccessTokenGateway
+	ClockGateway           gateways.IClockGateway
+	CustomerRepository     repositories.ICustomerRepository
+	RefreshTokenRepository repositories.IRefreshTokenRepository
+	MergeCarts             IMergeCarts
+}
+
+func (l *Login) Execute(ctx context.Context, input LoginInput) (LoginOutput, error) {
+	email, err := customer.NormalizeEmail(input.Email)
+	if err != nil {
+		return LoginOutput{}, ErrInvalidCredentials
+	}
+
+	existingCustomer, err := l.CustomerRepository.FindOneByEmail(ctx, email)
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	if existingCustomer == nil {
+		_, err := l.PasswordHasherGateway.Compare(dummyPasswordHash, input.Password)
+		if err != nil {
+			return LoginOutput{}, err
+		}
+
+		return LoginOutput{}, ErrInvalidCredentials
+	}
+
+	passwordMatches, err := l.PasswordHasherGateway.Compare(existingCustomer.PasswordHash, input.Password)
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	if !passwordMatches {
+		return LoginOutput{}, ErrInvalidCredentials
+	}
+
+	now := l.ClockGateway.Now()
+	accessTokenExpiresAt := now.Add(AccessTokenTtl)
+	accessToken, err := l.AccessTokenGateway.Issue(ctx, gateways.AccessTokenClaimsDTO{
+		CustomerId: existingCustomer.Id,
+		Roles:      []string{existingCustomer.Role},
+		Scopes:     existingCustomer.Scopes(),
+		IssuedAt:   now,
+		ExpiresAt:  accessTokenExpiresAt,
+	})
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	refreshToken, rawRefreshToken, err := customer.NewRefreshToken(existingCustomer.Id, uuid.New(), now.Add(RefreshTokenTtl))
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	err = l.RefreshTokenRepository.Create(ctx, refreshToken)
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	mergeCartsOutput, err := l.MergeCarts.Execute(ctx, MergeCartsInput{
+		CustomerId: existingCustomer.Id,
+		CartToken:  input.CartToken,
+	})
+	var guestCartMergeError error
+	if err != nil && !errors.Is(err, cart.ErrCartCannotMixCurrencies) {
+		guestCartMergeError = err
+	}
+
+	return LoginOutput{
+		CustomerId:            existingCustomer.Id,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          rawRefreshToken,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+		GuestCartMerged:       mergeCartsOutput.Merged,
+		GuestCartMergeError:   guestCartMergeError,
+	}, nil
+}
@@ -0,0 +1,249 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccessTokenGatewayMock struct {
+	mock.Mock
+}
+
+func (a *AccessTokenGatewayMock) Issue(ctx context.Context, claims gateways.AccessTokenClaimsDTO) (string, error) {
+	args := a.Called(ctx, claims)
+	return args.String(0), args.Error(1)
+}
+
+type RefreshTokenRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RefreshTokenRepositoryMock) Create(ctx context.Context, refreshToken customer.RefreshToken) error {
+	args := r.Called(ctx, refreshToken)
+	return args.Error(0)
+}
+
+func (r *RefreshTokenRepositoryMock) Rotate(ctx context.Context, current customer.RefreshToken, next customer.RefreshToken) error {
+	args := r.Called(ctx, current, next)
+	return args.Error(0)
+}
+
+func (r *RefreshTokenRepositoryMock) RevokeFamily(ctx context.Context, familyId uuid.UUID, revokedAt time.Time) error {
+	args := r.Called(ctx, familyId, revokedAt)
+	return args.Error(0)
+}
+
+func (r *RefreshTokenRepositoryMock) FindOneByTokenHash(ctx context.Context, tokenHash string) (*customer.RefreshToken, error) {
+	args := r.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*customer.RefreshToken), args.Error(1)
+}
+
+type MergeCartsMock struct {
+	mock.Mock
+}
+
+func (m *MergeCartsMock) Execute(ctx context.Context, input usecases.MergeCartsInput) (usecases.MergeCartsOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(usecases.MergeCartsOutput), args.Error(1)
+}
+
+type LoginSuite struct {
+	suite.Suite
+	login                      usecases.Login
+	clockGateway               *infragateways.FakeClockGateway
+	passwordHasherGatewayMock  PasswordHasherGatewayMock
+	accessTokenGatewayMock     AccessTokenGatewayMock
+	customerRepositoryMock     CustomerRepositoryMock
+	refreshTokenRepositoryMock RefreshTokenRepositoryMock
+	mergeCartsMock             MergeCartsMock
+}
+
+func (l *LoginSuite) SetupTest() {
+	l.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	l.passwordHasherGatewayMock = PasswordHasherGatewayMock{}
+	l.accessTokenGatewayMock = AccessTokenGatewayMock{}
+	l.customerRepositoryMock = CustomerRepositoryMock{}
+	l.refreshTokenRepositoryMock = RefreshTokenRepositoryMock{}
+	l.mergeCartsMock = MergeCartsMock{}
+
+	l.login = usecases.Login{
+		PasswordHasherGateway:  &l.passwordHasherGatewayMock,
+		AccessTokenGateway:     &l.accessTokenGatewayMock,
+		ClockGateway:           l.clockGateway,
+		CustomerRepository:     &l.customerRepositoryMock,
+		RefreshTokenRepository: &l.refreshTokenRepositoryMock,
+		MergeCarts:             &l.mergeCartsMock,
+	}
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnValidCredentials_IssuesAccessAndRefreshTokens() {
+	now := l.clockGateway.Now()
+	existingCustomer, _ := customer.NewCustomer("john.doe@example.com", "hashed-password")
+	l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, "john.doe@example.com").Return(&existingCustomer, nil)
+	l.passwordHasherGatewayMock.On("Compare", "hashed-password", "s3cret-password").Return(true, nil)
+	l.accessTokenGatewayMock.On("Issue", mock.Anything, gateways.AccessTokenClaimsDTO{
+		CustomerId: existingCustomer.Id,
+		Roles:      []string{"customer"},
+		Scopes:     []string{"cart", "addresses", "checkout"},
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(usecases.AccessTokenTtl),
+	}).Return("access-token", nil)
+	l.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	l.mergeCartsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.MergeCartsOutput{Merged: false}, nil)
+
+	output, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:    "John.Doe@example.com",
+		Password: "s3cret-password",
+	})
+
+	l.NoError(err)
+	l.Equal(existingCustomer.Id, output.CustomerId)
+	l.Equal("access-token", output.AccessToken)
+	l.Equal(now.Add(usecases.AccessTokenTtl), output.AccessTokenExpiresAt)
+	l.NotEmpty(output.RefreshToken)
+	l.Equal(now.Add(usecases.RefreshTokenTtl), output.RefreshTokenExpiresAt)
+	l.False(output.GuestCartMerged)
+	l.refreshTokenRepositoryMock.AssertCalled(l.T(), "Create", mock.Anything, mock.MatchedBy(func(r customer.RefreshToken) bool {
+		return r.CustomerId == existingCustomer.Id &&
+			r.FamilyId != uuid.Nil &&
+			r.TokenHash == customer.HashRefreshToken(output.RefreshToken) &&
+			r.RevokedAt == nil
+	}))
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnAdmin_IssuesAccessTokenWithAdminRoleAndScopes() {
+	existingCustomer, _ := customer.NewCustomer("admin@example.com", "hashed-password")
+	existingCustomer.Role = customer.AdminRole
+	l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(&existingCustomer, nil)
+	l.passwordHasherGatewayMock.On("Compare", mock.Anything, mock.Anything).Return(true, nil)
+	l.accessTokenGatewayMock.On("Issue", mock.Anything, mock.Anything).Return("access-token", nil)
+	l.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	l.mergeCartsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.MergeCartsOutput{Merged: false}, nil)
+
+	_, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:    "admin@example.com",
+		Password: "s3cret-password",
+	})
+
+	l.NoError(err)
+	l.accessTokenGatewayMock.AssertCalled(l.T(), "Issue", mock.Anything, mock.MatchedBy(func(claims gateways.AccessTokenClaimsDTO) bool {
+		return len(claims.Roles) == 1 && claims.Roles[0] == "admin" &&
+			len(claims.Scopes) == 3 && claims.Scopes[0] == "catalog"
+	}))
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnCartToken_MergesGuestCartIntoCustomerCart() {
+	existingCustomer, _ := customer.NewCustomer("john.doe@example.com", "hashed-password")
+	l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(&existingCustomer, nil)
+	l.passwordHasherGatewayMock.On("Compare", mock.Anything, mock.Anything).Return(true, nil)
+	l.accessTokenGatewayMock.On("Issue", mock.Anything, mock.Anything).Return("access-token", nil)
+	l.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	l.mergeCartsMock.On("Execute", mock.Anything, usecases.MergeCartsInput{
+		CustomerId: existingCustomer.Id,
+		CartToken:  "cart-token",
+	}).Return(usecases.MergeCartsOutput{Merged: true}, nil)
+
+	output, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:     "john.doe@example.com",
+		Password:  "s3cret-password",
+		CartToken: "cart-token",
+	})
+
+	l.NoError(err)
+	l.True(output.GuestCartMerged)
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnMergeErrors_StillReturnsTokens() {
+	mergeErrors := []error{
+		cart.ErrCartCannotMixCurrencies,
+		repositories.ErrCartVersionConflict,
+		errors.New("connection refused"),
+	}
+
+	for _, mergeError := range mergeErrors {
+		l.SetupTest()
+		existingCustomer, _ := customer.NewCustomer("john.doe@example.com", "hashed-password")
+		l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(&existingCustomer, nil)
+		l.passwordHasherGatewayMock.On("Compare", mock.Anything, mock.Anything).Return(true, nil)
+		l.accessTokenGatewayMock.On("Issue", mock.Anything, mock.Anything).Return("access-token", nil)
+		l.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+		l.mergeCartsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.MergeCartsOutput{}, mergeError)
+
+		output, err := l.login.Execute(context.Background(), usecases.LoginInput{
+			Email:     "john.doe@example.com",
+			Password:  "s3cret-password",
+			CartToken: "cart-token",
+		})
+
+		l.NoError(err, mergeError.Error())
+		l.Equal("access-token", output.AccessToken)
+		l.NotEmpty(output.RefreshToken)
+		l.False(output.GuestCartMerged)
+		if errors.Is(mergeError, cart.ErrCartCannotMixCurrencies) {
+			l.NoError(output.GuestCartMergeError)
+		} else {
+			l.ErrorIs(output.GuestCartMergeError, mergeError)
+		}
+	}
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnUnknownEmail_ReturnsInvalidCredentials() {
+	l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	l.passwordHasherGatewayMock.On("Compare", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:    "john.doe@example.com",
+		Password: "s3cret-password",
+	})
+
+	l.EqualError(err, "invalid credentials")
+	l.passwordHasherGatewayMock.AssertCalled(l.T(), "Compare", mock.MatchedBy(func(passwordHash string) bool {
+		return passwordHash != ""
+	}), "s3cret-password")
+	l.refreshTokenRepositoryMock.AssertNotCalled(l.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnWrongPassword_ReturnsInvalidCredentials() {
+	existingCustomer, _ := customer.NewCustomer("john.doe@example.com", "hashed-password")
+	l.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(&existingCustomer, nil)
+	l.passwordHasherGatewayMock.On("Compare", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:    "john.doe@example.com",
+		Password: "wrong-password",
+	})
+
+	l.EqualError(err, "invalid credentials")
+	l.accessTokenGatewayMock.AssertNotCalled(l.T(), "Issue", mock.Anything, mock.Anything)
+	l.refreshTokenRepositoryMock.AssertNotCalled(l.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (l *LoginSuite) TestLogin_Execute_OnMalformedEmail_ReturnsInvalidCredentials() {
+	_, err := l.login.Execute(context.Background(), usecases.LoginInput{
+		Email:    "john.doe",
+		Password: "s3cret-password",
+	})
+
+	l.EqualError(err, "invalid credentials")
+	l.customerRepositoryMock.AssertNotCalled(l.T(), "FindOneByEmail", mock.Anything, mock.Anything)
+}
+
+func TestLogin(t *testing.T) {
+	suite.Run(t, new(LoginSuite))
+}
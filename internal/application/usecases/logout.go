@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+type LogoutInput struct {
+	RefreshToken string
+}
+
+type ILogout interface {
+	Execute(ctx context.Context, input LogoutInput) error
+}
+
+type Logout struct {
+	ClockGateway           gateways.IClockGateway
+	RefreshTokenRepository repositories.IRefreshTokenRepository
+}
+
+func (l *Logout) Execute(ctx context.Context, input LogoutInput) error {
+	refreshToken, err := l.RefreshTokenRepository.FindOneByTokenHash(ctx, customer.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return err
+	}
+
+	if refreshToken == nil {
+		return ErrRefreshTokenInvalid
+	}
+
+	err = l.RefreshTokenRepository.RevokeFamily(ctx, refreshToken.FamilyId, l.ClockGateway.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
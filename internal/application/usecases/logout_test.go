@@ -0,0 +1,61 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogoutSuite struct {
+	suite.Suite
+	logout                     usecases.Logout
+	clockGateway               *infragateways.FakeClockGateway
+	refreshTokenRepositoryMock RefreshTokenRepositoryMock
+}
+
+func (l *LogoutSuite) SetupTest() {
+	l.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	l.refreshTokenRepositoryMock = RefreshTokenRepositoryMock{}
+
+	l.logout = usecases.Logout{
+		ClockGateway:           l.clockGateway,
+		RefreshTokenRepository: &l.refreshTokenRepositoryMock,
+	}
+}
+
+func (l *LogoutSuite) TestLogout_Execute_OnKnownToken_RevokesItsFamily() {
+	now := l.clockGateway.Now()
+	familyId := uuid.New()
+	refreshToken, rawToken, _ := customer.NewRefreshToken(uuid.New(), familyId, now.Add(time.Hour))
+	l.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, customer.HashRefreshToken(rawToken)).Return(&refreshToken, nil)
+	l.refreshTokenRepositoryMock.On("RevokeFamily", mock.Anything, familyId, now).Return(nil)
+
+	err := l.logout.Execute(context.Background(), usecases.LogoutInput{
+		RefreshToken: rawToken,
+	})
+
+	l.NoError(err)
+	l.refreshTokenRepositoryMock.AssertCalled(l.T(), "RevokeFamily", mock.Anything, familyId, now)
+}
+
+func (l *LogoutSuite) TestLogout_Execute_OnUnknownToken_ReturnsError() {
+	l.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := l.logout.Execute(context.Background(), usecases.LogoutInput{
+		RefreshToken: "unknown",
+	})
+
+	l.EqualError(err, "refresh token is invalid")
+	l.refreshTokenRepositoryMock.AssertNotCalled(l.T(), "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLogout(t *testing.T) {
+	suite.Run(t, new(LogoutSuite))
+}
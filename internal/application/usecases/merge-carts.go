@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+)
+
+type MergeCartsInput struct {
+	CustomerId uuid.UUID
+	CartToken  string
+}
+
+type MergeCartsOutput struct {
+	Merged bool
+}
+
+type IMergeCarts interface {
+	Execute(ctx context.Context, input MergeCartsInput) (MergeCartsOutput, error)
+}
+
+type MergeCarts struct {
+	CartRepository   repositories.ICartRepository
+	CartTokenGateway gateways.ICartTokenGateway
+	UnitOfWork       repositories.IUnitOfWork
+}
+
+func (m *MergeCarts) Execute(ctx context.Context, input MergeCartsInput) (MergeCartsOutput, error) {
+	var output MergeCartsOutput
+	err := retryOnCartVersionConflict(func() error {
+		var err error
+		output, err = m.execute(ctx, input)
+		return err
+	})
+
+	return output, err
+}
+
+func (m *MergeCarts) execute(ctx context.Context, input MergeCartsInput) (MergeCartsOutput, error) {
+	if input.CartToken == "" {
+		return MergeCartsOutput{Merged: false}, nil
+	}
+
+	guestCart, err := findCart(ctx, m.CartRepository, m.CartTokenGateway, uuid.Nil, input.CartToken)
+	if err != nil {
+		return MergeCartsOutput{}, err
+	}
+
+	if guestCart == nil {
+		return MergeCartsOutput{Merged: false}, nil
+	}
+
+	customerCart, err := m.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return MergeCartsOutput{}, err
+	}
+
+	isNewCart := customerCart == nil
+	if isNewCart {
+		newCart, err := cart.NewCart(input.CustomerId)
+		if err != nil {
+			return MergeCartsOutput{}, err
+		}
+
+		customerCart = &newCart
+	}
+
+	err = customerCart.Merge(*guestCart)
+	if err != nil {
+		return MergeCartsOutput{}, err
+	}
+
+	err = m.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+		var err error
+		if isNewCart {
+			err = transaction.CartRepository.Create(ctx, *customerCart)
+		} else {
+			err = transaction.CartRepository.Update(ctx, *customerCart)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return transaction.CartRepository.Delete(ctx, guestCart.Id)
+	})
+
+	if err != nil {
+		return MergeCartsOutput{}, err
+	}
+
+	return MergeCartsOutput{Merged: true}, nil
+}
+
+func findCart(ctx context.Context, cartRepository repositories.ICartRepository, cartTokenGateway gateways.ICartTokenGateway,
+	customerId uuid.UUID, cartToken string) (*cart.Cart, error) {
+	if customerId != uuid.Nil {
+		return cartRepository.FindOneByCustomerId(ctx, customerId)
+	}
+
+	if cartToken == "" {
+		return nil, nil
+	}
+
+	cartId, err := cartTokenGateway.Verify(ctx, cartToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if cartId == nil {
+		return nil, nil
+	}
+
+	guestCart, err := cartRepository.FindOneById(ctx, *cartId)
+	if err != nil {
+		return nil, err
+	}
+
+	if guestCart == nil || !guestCart.IsGuest() {
+		return nil, nil
+	}
+
+	return guestCart, nil
+}
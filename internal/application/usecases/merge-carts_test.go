@@ -0,0 +1,205 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartTokenGatewayMock struct {
+	mock.Mock
+}
+
+func (c *CartTokenGatewayMock) Issue(ctx context.Context, cartId uuid.UUID) (string, error) {
+	args := c.Called(ctx, cartId)
+	return args.String(0), args.Error(1)
+}
+
+func (c *CartTokenGatewayMock) Verify(ctx context.Context, cartToken string) (*uuid.UUID, error) {
+	args := c.Called(ctx, cartToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*uuid.UUID), args.Error(1)
+}
+
+type MergeCartsSuite struct {
+	suite.Suite
+	mergeCarts           usecases.MergeCarts
+	cartRepositoryMock   CartRepositoryMock
+	cartTokenGatewayMock CartTokenGatewayMock
+	unitOfWork           *UnitOfWorkFake
+}
+
+func (m *MergeCartsSuite) SetupTest() {
+	m.cartRepositoryMock = CartRepositoryMock{}
+	m.cartTokenGatewayMock = CartTokenGatewayMock{}
+	m.unitOfWork = &UnitOfWorkFake{
+		Repositories: repositories.UnitOfWorkRepositories{
+			CartRepository: &m.cartRepositoryMock,
+		},
+	}
+
+	m.mergeCarts = usecases.MergeCarts{
+		CartRepository:   &m.cartRepositoryMock,
+		CartTokenGateway: &m.cartTokenGatewayMock,
+		UnitOfWork:       m.unitOfWork,
+	}
+}
+
+func (m *MergeCartsSuite) newGuestCart(productId uuid.UUID, quantity int32, currency models.Currency) cart.Cart {
+	guestCart := cart.NewGuestCart()
+	guestCart.CouponCode = "SUMMER10"
+	guestCart.Items = []cart.CartItem{
+		{
+			Id:        uuid.New(),
+			ProductId: productId,
+			Quantity:  models.Quantity{Value: quantity},
+			Price:     models.Money{Value: 3550, Currency: currency},
+		},
+	}
+	return guestCart
+}
+
+func (m *MergeCartsSuite) TestMergeCarts_Execute_OnExistingCustomerCart_MergesItemsAndDeletesGuestCart() {
+	productId := uuid.New()
+	guestCart := m.newGuestCart(productId, 2, models.BRL)
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 1},
+				Price:     models.Money{Value: 3550, Currency: models.BRL},
+			},
+		},
+	}
+	m.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	m.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	m.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	m.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	m.cartRepositoryMock.On("Delete", mock.Anything, guestCart.Id).Return(nil)
+
+	output, err := m.mergeCarts.Execute(context.Background(), usecases.MergeCartsInput{
+		CustomerId: customerCart.CustomerId,
+		CartToken:  "cart-token",
+	})
+
+	m.NoError(err)
+	m.True(output.Merged)
+	m.cartRepositoryMock.AssertCalled(m.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.Id == customerCart.Id && len(c.Items) == 1 && c.Items[0].Quantity.Value == 3 && c.CouponCode == "SUMMER10"
+	}))
+	m.Equal([]string{"CartRepository.Update", "CartRepository.Delete"}, m.unitOfWork.Writes)
+}
+
+func (m *MergeCartsSuite) TestMergeCarts_Execute_OnCustomerWithoutCart_CreatesCustomerCartAndDeletesGuestCart() {
+	customerId := uuid.New()
+	guestCart := m.newGuestCart(uuid.New(), 2, models.BRL)
+	m.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	m.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	m.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(nil, nil)
+	m.cartRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	m.cartRepositoryMock.On("Delete", mock.Anything, guestCart.Id).Return(nil)
+
+	output, err := m.mergeCarts.Execute(context.Background(), usecases.MergeCartsInput{
+		CustomerId: customerId,
+		CartToken:  "cart-token",
+	})
+
+	m.NoError(err)
+	m.True(output.Merged)
+	m.cartRepositoryMock.AssertCalled(m.T(), "Create", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.Id != guestCart.Id && c.CustomerId == customerId && c.TotalQuantity().Value == 2
+	}))
+	m.Equal([]string{"CartRepository.Create", "CartRepository.Delete"}, m.unitOfWork.Writes)
+}
+
+func (m *MergeCartsSuite) TestMergeCarts_Execute_OnNothingToMerge_ReturnsNotMerged() {
+	templates := []map[string]string{
+		{"cartToken": ""},
+		{"cartToken": "tampered-token"},
+		{"cartToken": "unknown-cart-token"},
+	}
+
+	for _, template := range templates {
+		m.SetupTest()
+		unknownCartId := uuid.New()
+		m.cartTokenGatewayMock.On("Verify", mock.Anything, "tampered-token").Return(nil, nil)
+		m.cartTokenGatewayMock.On("Verify", mock.Anything, "unknown-cart-token").Return(&unknownCartId, nil)
+		m.cartRepositoryMock.On("FindOneById", mock.Anything, unknownCartId).Return(nil, nil)
+
+		output, err := m.mergeCarts.Execute(context.Background(), usecases.MergeCartsInput{
+			CustomerId: uuid.New(),
+			CartToken:  template["cartToken"],
+		})
+
+		m.NoError(err)
+		m.False(output.Merged)
+		m.cartRepositoryMock.AssertNotCalled(m.T(), "FindOneByCustomerId", mock.Anything, mock.Anything)
+		m.cartRepositoryMock.AssertNotCalled(m.T(), "Delete", mock.Anything, mock.Anything)
+	}
+}
+
+func (m *MergeCartsSuite) TestMergeCarts_Execute_OnCurrencyConflict_KeepsBothCartsAndReturnsError() {
+	productId := uuid.New()
+	guestCart := m.newGuestCart(uuid.New(), 1, models.USD)
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 1},
+				Price:     models.Money{Value: 3550, Currency: models.BRL},
+			},
+		},
+	}
+	m.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	m.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	m.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+
+	_, err := m.mergeCarts.Execute(context.Background(), usecases.MergeCartsInput{
+		CustomerId: customerCart.CustomerId,
+		CartToken:  "cart-token",
+	})
+
+	m.EqualError(err, "cart cannot mix currencies")
+	m.cartRepositoryMock.AssertNotCalled(m.T(), "Update", mock.Anything, mock.Anything)
+	m.cartRepositoryMock.AssertNotCalled(m.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (m *MergeCartsSuite) TestMergeCarts_Execute_OnDeleteFailure_RollsBackCustomerCartAndReturnsError() {
+	customerId := uuid.New()
+	guestCart := m.newGuestCart(uuid.New(), 1, models.BRL)
+	m.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	m.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	m.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(nil, nil)
+	m.cartRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+	m.cartRepositoryMock.On("Delete", mock.Anything, guestCart.Id).Return(errors.New("connection refused"))
+
+	_, err := m.mergeCarts.Execute(context.Background(), usecases.MergeCartsInput{
+		CustomerId: customerId,
+		CartToken:  "cart-token",
+	})
+
+	m.EqualError(err, "connection refused")
+	m.Equal(1, m.unitOfWork.RolledBack)
+	m.Empty(m.unitOfWork.Writes)
+}
+
+func TestMergeCarts(t *testing.T) {
+	suite.Run(t, new(MergeCartsSuite))
+}
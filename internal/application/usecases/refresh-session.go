@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+type IRefreshSession interface {
+	Execute(ctx context.Context, input RefreshSessionInput) (RefreshSessionOutput, error)
+}
+
+type RefreshSession struct {
+	AccessTokenGateway     gateways.IAccessTokenGateway
+	ClockGateway           gateways.IClockGateway
+	CustomerRepository     repositories.ICustomerRepository
+	RefreshTokenRepository repositories.IRefreshTokenRepository
+}
+
+func (r *RefreshSession) Execute(ctx context.Context, input RefreshSessionInput) (RefreshSessionOutput, error) {
+	currentToken, err := r.RefreshTokenRepository.FindOneByTokenHash(ctx, customer.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	if currentToken == nil {
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+
+	now := r.ClockGateway.Now()
+	if currentToken.IsRevoked() {
+		err = r.RefreshTokenRepository.RevokeFamily(ctx, currentToken.FamilyId, now)
+		if err != nil {
+			return RefreshSessionOutput{}, err
+		}
+
+		return RefreshSessionOutput{}, repositories.ErrRefreshTokenReused
+	}
+
+	if currentToken.IsExpired(now) {
+		return RefreshSessionOutput{}, ErrRefreshTokenExpired
+	}
+
+	existingCustomer, err := r.CustomerRepository.FindOneById(ctx, currentToken.CustomerId)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	if existingCustomer == nil {
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+
+	nextToken, rawNextToken, err := customer.NewRefreshToken(currentToken.CustomerId, currentToken.FamilyId, now.Add(RefreshTokenTtl))
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	currentToken.Revoke(now)
+	err = r.RefreshTokenRepository.Rotate(ctx, *currentToken, nextToken)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRefreshTokenReused) {
+			r.RefreshTokenRepository.RevokeFamily(ctx, currentToken.FamilyId, now)
+		}
+
+		return RefreshSessionOutput{}, err
+	}
+
+	accessTokenExpiresAt := now.Add(AccessTokenTtl)
+	accessToken, err := r.AccessTokenGateway.Issue(ctx, gateways.AccessTokenClaimsDTO{
+		CustomerId: existingCustomer.Id,
+		Roles:      []string{existingCustomer.Role},
+		Scopes:     existingCustomer.Scopes(),
+		IssuedAt:   now,
+		ExpiresAt:  accessTokenExpiresAt,
+	})
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	return RefreshSessionOutput{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          rawNextToken,
+		RefreshTokenExpiresAt: nextToken.ExpiresAt,
+	}, nil
+}
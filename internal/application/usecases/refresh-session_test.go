@@ -0,0 +1,154 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshSessionSuite struct {
+	suite.Suite
+	refreshSession             usecases.RefreshSession
+	clockGateway               *infragateways.FakeClockGateway
+	accessTokenGatewayMock     AccessTokenGatewayMock
+	customerRepositoryMock     CustomerRepositoryMock
+	refreshTokenRepositoryMock RefreshTokenRepositoryMock
+}
+
+func (r *RefreshSessionSuite) SetupTest() {
+	r.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	r.accessTokenGatewayMock = AccessTokenGatewayMock{}
+	r.customerRepositoryMock = CustomerRepositoryMock{}
+	r.refreshTokenRepositoryMock = RefreshTokenRepositoryMock{}
+
+	r.refreshSession = usecases.RefreshSession{
+		AccessTokenGateway:     &r.accessTokenGatewayMock,
+		ClockGateway:           r.clockGateway,
+		CustomerRepository:     &r.customerRepositoryMock,
+		RefreshTokenRepository: &r.refreshTokenRepositoryMock,
+	}
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnValidToken_RotatesItWithinTheSameFamily() {
+	now := r.clockGateway.Now()
+	existingCustomer, _ := customer.NewCustomer("admin@example.com", "hashed-password")
+	existingCustomer.Role = customer.AdminRole
+	customerId := existingCustomer.Id
+	familyId := uuid.New()
+	currentToken, rawToken, _ := customer.NewRefreshToken(customerId, familyId, now.Add(time.Hour))
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, customer.HashRefreshToken(rawToken)).Return(&currentToken, nil)
+	r.customerRepositoryMock.On("FindOneById", mock.Anything, customerId).Return(&existingCustomer, nil)
+	r.refreshTokenRepositoryMock.On("Rotate", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	r.accessTokenGatewayMock.On("Issue", mock.Anything, gateways.AccessTokenClaimsDTO{
+		CustomerId: customerId,
+		Roles:      []string{"admin"},
+		Scopes:     []string{"catalog", "orders", "tokens"},
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(usecases.AccessTokenTtl),
+	}).Return("access-token", nil)
+
+	output, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: rawToken,
+	})
+
+	r.NoError(err)
+	r.Equal("access-token", output.AccessToken)
+	r.NotEqual(rawToken, output.RefreshToken)
+	r.Equal(now.Add(usecases.RefreshTokenTtl), output.RefreshTokenExpiresAt)
+	r.refreshTokenRepositoryMock.AssertCalled(r.T(), "Rotate", mock.Anything,
+		mock.MatchedBy(func(current customer.RefreshToken) bool {
+			return current.Id == currentToken.Id && current.RevokedAt != nil && current.RevokedAt.Equal(now)
+		}),
+		mock.MatchedBy(func(next customer.RefreshToken) bool {
+			return next.FamilyId == familyId &&
+				next.CustomerId == customerId &&
+				next.TokenHash == customer.HashRefreshToken(output.RefreshToken)
+		}),
+	)
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnUnknownToken_ReturnsError() {
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: "unknown",
+	})
+
+	r.EqualError(err, "refresh token is invalid")
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnRevokedToken_RevokesTheWholeFamily() {
+	now := r.clockGateway.Now()
+	familyId := uuid.New()
+	currentToken, rawToken, _ := customer.NewRefreshToken(uuid.New(), familyId, now.Add(time.Hour))
+	currentToken.Revoke(now.Add(-time.Minute))
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(&currentToken, nil)
+	r.refreshTokenRepositoryMock.On("RevokeFamily", mock.Anything, familyId, now).Return(nil)
+
+	_, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: rawToken,
+	})
+
+	r.EqualError(err, "refresh token was reused")
+	r.refreshTokenRepositoryMock.AssertCalled(r.T(), "RevokeFamily", mock.Anything, familyId, now)
+	r.refreshTokenRepositoryMock.AssertNotCalled(r.T(), "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	r.accessTokenGatewayMock.AssertNotCalled(r.T(), "Issue", mock.Anything, mock.Anything)
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnConcurrentReuse_RevokesTheWholeFamily() {
+	now := r.clockGateway.Now()
+	familyId := uuid.New()
+	currentToken, rawToken, _ := customer.NewRefreshToken(uuid.New(), familyId, now.Add(time.Hour))
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(&currentToken, nil)
+	r.customerRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(&customer.Customer{Role: customer.CustomerRole}, nil)
+	r.refreshTokenRepositoryMock.On("Rotate", mock.Anything, mock.Anything, mock.Anything).Return(repositories.ErrRefreshTokenReused)
+	r.refreshTokenRepositoryMock.On("RevokeFamily", mock.Anything, familyId, now).Return(nil)
+
+	_, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: rawToken,
+	})
+
+	r.EqualError(err, "refresh token was reused")
+	r.refreshTokenRepositoryMock.AssertCalled(r.T(), "RevokeFamily", mock.Anything, familyId, now)
+	r.accessTokenGatewayMock.AssertNotCalled(r.T(), "Issue", mock.Anything, mock.Anything)
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnDeletedCustomer_ReturnsError() {
+	now := r.clockGateway.Now()
+	currentToken, rawToken, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), now.Add(time.Hour))
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(&currentToken, nil)
+	r.customerRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: rawToken,
+	})
+
+	r.EqualError(err, "refresh token is invalid")
+	r.refreshTokenRepositoryMock.AssertNotCalled(r.T(), "Rotate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (r *RefreshSessionSuite) TestRefreshSession_Execute_OnExpiredToken_ReturnsError() {
+	now := r.clockGateway.Now()
+	currentToken, rawToken, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), now)
+	r.refreshTokenRepositoryMock.On("FindOneByTokenHash", mock.Anything, mock.Anything).Return(&currentToken, nil)
+
+	_, err := r.refreshSession.Execute(context.Background(), usecases.RefreshSessionInput{
+		RefreshToken: rawToken,
+	})
+
+	r.EqualError(err, "refresh token has expired")
+	r.refreshTokenRepositoryMock.AssertNotCalled(r.T(), "Rotate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefreshSession(t *testing.T) {
+	suite.Run(t, new(RefreshSessionSuite))
+}
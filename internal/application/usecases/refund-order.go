@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+)
+
+type RefundOrderInput struct {
+	OrderId uuid.UUID
+	Actor   string
+}
+
+type IRefundOrder interface {
+	Execute(ctx context.Context, input RefundOrderInput) error
+}
+
+type RefundOrder struct {
+	ClockGateway    gateways.IClockGateway
+	PaymentGateway  gateways.IPaymentGateway
+	OrderRepository repositories.IOrderRepository
+	UnitOfWork      repositories.IUnitOfWork
+}
+
+func (r *RefundOrder) Execute(ctx context.Context, input RefundOrderInput) error {
+	existingOrder, err := r.OrderRepository.FindOneById(ctx, input.OrderId)
+	if err != nil {
+		return err
+	}
+
+	if existingOrder == nil {
+		return ErrOrderNotFound
+	}
+
+	if existingOrder.PaymentId == "" {
+		return ErrOrderHasNoPayment
+	}
+
+	previousStatus := existingOrder.Status
+	err = existingOrder.ChangeStatus(order.Refunded, input.Actor, r.ClockGateway.Now())
+	if err != nil {
+		return err
+	}
+
+	amountDue, err := existingOrder.AmountDue()
+	if err != nil {
+		return err
+	}
+
+	err = r.UnitOfWork.Execute(ctx, func(transaction repositories.UnitOfWorkRepositories) error {
+		err := transaction.OrderRepository.Update(ctx, *existingOrder, previousStatus)
+		if err != nil {
+			return err
+		}
+
+		return r.PaymentGateway.Refund(ctx, existingOrder.PaymentId, amountDue.Value)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefundOrderSuite struct {
+	suite.Suite
+	refundOrder         usecases.RefundOrder
+	clockGateway        *infragateways.FakeClockGateway
+	paymentGatewayMock  PaymentGatewayMock
+	orderRepositoryMock OrderRepositoryMock
+	unitOfWork          *UnitOfWorkFake
+}
+
+func (r *RefundOrderSuite) SetupTest() {
+	r.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	r.paymentGatewayMock = PaymentGatewayMock{}
+	r.orderRepositoryMock = OrderRepositoryMock{}
+	r.unitOfWork = &UnitOfWorkFake{
+		Repositories: repositories.UnitOfWorkRepositories{
+			OrderRepository: &r.orderRepositoryMock,
+		},
+	}
+
+	r.refundOrder = usecases.RefundOrder{
+		ClockGateway:    r.clockGateway,
+		PaymentGateway:  &r.paymentGatewayMock,
+		OrderRepository: &r.orderRepositoryMock,
+		UnitOfWork:      r.unitOfWork,
+	}
+}
+
+func (r *RefundOrderSuite) newOrder(status order.OrderStatus, paymentId string) order.Order {
+	return order.Order{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Status:     status,
+		Lines: []order.OrderLine{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				UnitPrice: models.Money{Value: 2550},
+			},
+		},
+		StatusHistory: []order.OrderStatusChange{},
+		PaymentId:     paymentId,
+	}
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnPaidOrder_RefundsPaymentAndUpdatesOrder() {
+	existingOrder := r.newOrder(order.Paid, "auth_1")
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+	r.paymentGatewayMock.On("Refund", mock.Anything, "auth_1", int64(5100)).Return(nil)
+	r.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.Paid).Return(nil)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: existingOrder.Id,
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.NoError(err)
+	r.orderRepositoryMock.AssertCalled(r.T(), "Update", mock.Anything, mock.MatchedBy(func(o order.Order) bool {
+		return o.Status == order.Refunded && len(o.StatusHistory) == 1 &&
+			o.StatusHistory[0].ChangedAt.Equal(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	}), order.Paid)
+	r.Equal([]string{"OrderRepository.Update"}, r.unitOfWork.Writes)
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnIllegalTransition_ReturnsErrorWithoutRefunding() {
+	existingOrder := r.newOrder(order.Shipped, "auth_1")
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: existingOrder.Id,
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.EqualError(err, "order status transition is not allowed")
+	r.paymentGatewayMock.AssertNumberOfCalls(r.T(), "Refund", 0)
+	r.orderRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 0)
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnPaymentGatewayError_ReturnsErrorAndRollsBackStatusChange() {
+	existingOrder := r.newOrder(order.Delivered, "auth_1")
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+	r.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.Delivered).Return(nil)
+	r.paymentGatewayMock.On("Refund", mock.Anything, mock.Anything, mock.Anything).Return(gateways.ErrPaymentProviderTimeout)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: existingOrder.Id,
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.EqualError(err, "payment provider timeout")
+	r.Empty(r.unitOfWork.Writes)
+	r.Equal(1, r.unitOfWork.RolledBack)
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnConcurrentStatusChange_ReturnsErrorWithoutRefunding() {
+	existingOrder := r.newOrder(order.Paid, "auth_1")
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+	r.orderRepositoryMock.On("Update", mock.Anything, mock.Anything, order.Paid).Return(repositories.ErrOrderStatusConflict)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: existingOrder.Id,
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.ErrorIs(err, repositories.ErrOrderStatusConflict)
+	r.paymentGatewayMock.AssertNumberOfCalls(r.T(), "Refund", 0)
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnOrderWithoutPayment_ReturnsError() {
+	existingOrder := r.newOrder(order.Paid, "")
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, existingOrder.Id).Return(&existingOrder, nil)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: existingOrder.Id,
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.EqualError(err, "order has no payment")
+}
+
+func (r *RefundOrderSuite) TestRefundOrder_Execute_OnOrderNotFound_ReturnsError() {
+	r.orderRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := r.refundOrder.Execute(context.Background(), usecases.RefundOrderInput{
+		OrderId: uuid.New(),
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	})
+
+	r.EqualError(err, "order not found")
+}
+
+func TestRefundOrder(t *testing.T) {
+	suite.Run(t, new(RefundOrderSuite))
+}
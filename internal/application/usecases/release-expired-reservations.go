@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type ReleaseExpiredReservationsOutput struct {
+	ReleasedReservations int
+}
+
+type IReleaseExpiredReservations interface {
+	Execute(ctx context.Context) (ReleaseExpiredReservationsOutput, error)
+}
+
+type ReleaseExpiredReservations struct {
+	InventoryGateway gateways.IInventoryGateway
+	ClockGateway     gateways.IClockGateway
+}
+
+func (r *ReleaseExpiredReservations) Execute(ctx context.Context) (ReleaseExpiredReservationsOutput, error) {
+	releasedReservations, err := r.InventoryGateway.ReleaseExpired(ctx, r.ClockGateway.Now())
+	if err != nil {
+		return ReleaseExpiredReservationsOutput{}, err
+	}
+
+	return ReleaseExpiredReservationsOutput{
+		ReleasedReservations: releasedReservations,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReleaseExpiredReservationsSuite struct {
+	suite.Suite
+	releaseExpiredReservations usecases.ReleaseExpiredReservations
+	inventoryGatewayMock       InventoryGatewayMock
+	clockGateway               *infragateways.FakeClockGateway
+}
+
+func (r *ReleaseExpiredReservationsSuite) SetupTest() {
+	r.inventoryGatewayMock = InventoryGatewayMock{}
+	r.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+
+	r.releaseExpiredReservations = usecases.ReleaseExpiredReservations{
+		InventoryGateway: &r.inventoryGatewayMock,
+		ClockGateway:     r.clockGateway,
+	}
+}
+
+func (r *ReleaseExpiredReservationsSuite) TestReleaseExpiredReservations_Execute_OnClockAdvanced_ReleasesReservationsExpiredAtCurrentTime() {
+	r.clockGateway.Advance(usecases.CheckoutReservationTtl + time.Minute)
+	r.inventoryGatewayMock.On("ReleaseExpired", mock.Anything, time.Date(2024, 11, 20, 10, 16, 0, 0, time.UTC)).Return(2, nil)
+
+	sut, err := r.releaseExpiredReservations.Execute(context.Background())
+
+	r.NoError(err)
+	r.Equal(usecases.ReleaseExpiredReservationsOutput{ReleasedReservations: 2}, sut)
+}
+
+func (r *ReleaseExpiredReservationsSuite) TestReleaseExpiredReservations_Execute_OnGatewayError_ReturnsError() {
+	r.inventoryGatewayMock.On("ReleaseExpired", mock.Anything, r.clockGateway.Now()).Return(0, errors.New("connection refused"))
+
+	_, err := r.releaseExpiredReservations.Execute(context.Background())
+
+	r.EqualError(err, "connection refused")
+}
+
+func TestReleaseExpiredReservations(t *testing.T) {
+	suite.Run(t, new(ReleaseExpiredReservationsSuite))
+}
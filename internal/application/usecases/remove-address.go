@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+)
+
+type RemoveAddressInput struct {
+	CustomerId uuid.UUID
+	AddressId  uuid.UUID
+}
+
+type IRemoveAddress interface {
+	Execute(ctx context.Context, input RemoveAddressInput) error
+}
+
+type RemoveAddress struct {
+	CustomerGateway       gateways.ICustomerGateway
+	AddressBookRepository repositories.IAddressBookRepository
+}
+
+func (r *RemoveAddress) Execute(ctx context.Context, input RemoveAddressInput) error {
+	customerExists, err := r.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if !customerExists {
+		return ErrCustomerNotFound
+	}
+
+	addressBook, err := r.AddressBookRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if addressBook == nil {
+		return address.ErrAddressNotFound
+	}
+
+	err = addressBook.Remove(input.AddressId)
+	if err != nil {
+		return err
+	}
+
+	err = r.AddressBookRepository.Save(ctx, *addressBook)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
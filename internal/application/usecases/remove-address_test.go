@@ -0,0 +1,81 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveAddressSuite struct {
+	suite.Suite
+	removeAddress             usecases.RemoveAddress
+	customerGatewayMock       CustomerGatewayMock
+	addressBookRepositoryMock AddressBookRepositoryMock
+}
+
+func (r *RemoveAddressSuite) SetupTest() {
+	r.customerGatewayMock = CustomerGatewayMock{}
+	r.addressBookRepositoryMock = AddressBookRepositoryMock{}
+
+	r.removeAddress = usecases.RemoveAddress{
+		CustomerGateway:       &r.customerGatewayMock,
+		AddressBookRepository: &r.addressBookRepositoryMock,
+	}
+}
+
+func (r *RemoveAddressSuite) TestRemoveAddress_Execute_OnDefaultAddress_PromotesNextAddressAndSaves() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	work, _ := address.NewAddress("John Doe", "Rua Augusta, 500", "", "São Paulo", "SP", "01305-000", "BR")
+	addressBook.Add(home)
+	addressBook.Add(work)
+	r.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	r.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+	r.addressBookRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	err := r.removeAddress.Execute(context.Background(), usecases.RemoveAddressInput{
+		CustomerId: customerId,
+		AddressId:  home.Id,
+	})
+
+	r.NoError(err)
+	r.addressBookRepositoryMock.AssertCalled(r.T(), "Save", mock.Anything, mock.MatchedBy(func(b address.AddressBook) bool {
+		return len(b.Addresses) == 1 && b.Addresses[0].Id == work.Id && b.Addresses[0].DefaultShipping
+	}))
+}
+
+func (r *RemoveAddressSuite) TestRemoveAddress_Execute_OnCustomerNotFound_ReturnsError() {
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	err := r.removeAddress.Execute(context.Background(), usecases.RemoveAddressInput{
+		CustomerId: uuid.New(),
+		AddressId:  uuid.New(),
+	})
+
+	r.EqualError(err, "customer not found")
+}
+
+func (r *RemoveAddressSuite) TestRemoveAddress_Execute_OnAddressNotFound_ReturnsError() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	r.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	r.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	err := r.removeAddress.Execute(context.Background(), usecases.RemoveAddressInput{
+		CustomerId: customerId,
+		AddressId:  uuid.New(),
+	})
+
+	r.EqualError(err, "address not found")
+	r.addressBookRepositoryMock.AssertNumberOfCalls(r.T(), "Save", 0)
+}
+
+func TestRemoveAddress(t *testing.T) {
+	suite.Run(t, new(RemoveAddressSuite))
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type RemoveCouponFromCartInput struct {
+	CustomerId uuid.UUID
+}
+
+type IRemoveCouponFromCart interface {
+	Execute(ctx context.Context, input RemoveCouponFromCartInput) error
+}
+
+type RemoveCouponFromCart struct {
+	CustomerGateway gateways.ICustomerGateway
+	CartRepository  repositories.ICartRepository
+}
+
+func (r *RemoveCouponFromCart) Execute(ctx context.Context, input RemoveCouponFromCartInput) error {
+	return retryOnCartVersionConflict(func() error {
+		return r.execute(ctx, input)
+	})
+}
+
+func (r *RemoveCouponFromCart) execute(ctx context.Context, input RemoveCouponFromCartInput) error {
+	customerExists, err := r.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if !customerExists {
+		return ErrCustomerNotFound
+	}
+
+	customerCart, err := r.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if customerCart == nil {
+		return ErrCartNotFound
+	}
+
+	err = customerCart.RemoveCoupon()
+	if err != nil {
+		return err
+	}
+
+	err = r.CartRepository.Update(ctx, *customerCart)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveCouponFromCartSuite struct {
+	suite.Suite
+	removeCouponFromCart usecases.RemoveCouponFromCart
+	customerGatewayMock  CustomerGatewayMock
+	cartRepositoryMock   CartRepositoryMock
+}
+
+func (r *RemoveCouponFromCartSuite) SetupTest() {
+	r.customerGatewayMock = CustomerGatewayMock{}
+	r.cartRepositoryMock = CartRepositoryMock{}
+
+	r.removeCouponFromCart = usecases.RemoveCouponFromCart{
+		CustomerGateway: &r.customerGatewayMock,
+		CartRepository:  &r.cartRepositoryMock,
+	}
+}
+
+func (r *RemoveCouponFromCartSuite) TestRemoveCouponFromCart_Execute_OnCartWithCoupon_UpdatesCartAndReturnsNil() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		CouponCode: "SAVE20",
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 1},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	r.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := r.removeCouponFromCart.Execute(context.Background(), usecases.RemoveCouponFromCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	r.NoError(err)
+	r.cartRepositoryMock.AssertCalled(r.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.CouponCode == ""
+	}))
+}
+
+func (r *RemoveCouponFromCartSuite) TestRemoveCouponFromCart_Execute_OnCustomerNotFound_ReturnsError() {
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	err := r.removeCouponFromCart.Execute(context.Background(), usecases.RemoveCouponFromCartInput{
+		CustomerId: uuid.New(),
+	})
+
+	r.EqualError(err, "customer not found")
+}
+
+func (r *RemoveCouponFromCartSuite) TestRemoveCouponFromCart_Execute_OnCartNotFound_ReturnsError() {
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := r.removeCouponFromCart.Execute(context.Background(), usecases.RemoveCouponFromCartInput{
+		CustomerId: uuid.New(),
+	})
+
+	r.EqualError(err, "cart not found")
+}
+
+func (r *RemoveCouponFromCartSuite) TestRemoveCouponFromCart_Execute_OnCartWithoutCoupon_ReturnsError() {
+	customerCart := cart.Cart{Id: uuid.New(), CustomerId: uuid.New(), Items: []cart.CartItem{}}
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	err := r.removeCouponFromCart.Execute(context.Background(), usecases.RemoveCouponFromCartInput{
+		CustomerId: customerCart.CustomerId,
+	})
+
+	r.EqualError(err, "cart has no coupon")
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 0)
+}
+
+func TestRemoveCouponFromCart(t *testing.T) {
+	suite.Run(t, new(RemoveCouponFromCartSuite))
+}
@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type RemoveProductFromCartInput struct {
+	CustomerId uuid.UUID
+	ProductId  uuid.UUID
+	CartToken  string
+}
+
+type IRemoveProductFromCart interface {
+	Execute(ctx context.Context, input RemoveProductFromCartInput) error
+}
+
+type RemoveProductFromCart struct {
+	CustomerGateway  gateways.ICustomerGateway
+	CartRepository   repositories.ICartRepository
+	CartTokenGateway gateways.ICartTokenGateway
+}
+
+func (r *RemoveProductFromCart) Execute(ctx context.Context, input RemoveProductFromCartInput) error {
+	return retryOnCartVersionConflict(func() error {
+		return r.execute(ctx, input)
+	})
+}
+
+func (r *RemoveProductFromCart) execute(ctx context.Context, input RemoveProductFromCartInput) error {
+	if input.CustomerId != uuid.Nil {
+		customerExists, err := r.CustomerGateway.ExistsById(ctx, input.CustomerId)
+		if err != nil {
+			return err
+		}
+
+		if !customerExists {
+			return ErrCustomerNotFound
+		}
+	}
+
+	customerCart, err := findCart(ctx, r.CartRepository, r.CartTokenGateway, input.CustomerId, input.CartToken)
+	if err != nil {
+		return err
+	}
+
+	if customerCart == nil {
+		return ErrCartNotFound
+	}
+
+	err = customerCart.RemoveItem(input.ProductId)
+	if err != nil {
+		return err
+	}
+
+	err = r.CartRepository.Update(ctx, *customerCart)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveProductFromCartSuite struct {
+	suite.Suite
+	removeProductFromCart usecases.RemoveProductFromCart
+	customerGatewayMock   CustomerGatewayMock
+	cartRepositoryMock    CartRepositoryMock
+	cartTokenGatewayMock  CartTokenGatewayMock
+}
+
+func (r *RemoveProductFromCartSuite) SetupTest() {
+	r.customerGatewayMock = CustomerGatewayMock{}
+	r.cartRepositoryMock = CartRepositoryMock{}
+	r.cartTokenGatewayMock = CartTokenGatewayMock{}
+
+	r.removeProductFromCart = usecases.RemoveProductFromCart{
+		CustomerGateway:  &r.customerGatewayMock,
+		CartRepository:   &r.cartRepositoryMock,
+		CartTokenGateway: &r.cartTokenGatewayMock,
+	}
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnProductInCart_UpdatesCartAndReturnsNil() {
+	productId := uuid.New()
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	r.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	input := usecases.RemoveProductFromCartInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+	}
+
+	err := r.removeProductFromCart.Execute(context.Background(), input)
+
+	r.Equal(nil, err)
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 1)
+	r.cartRepositoryMock.AssertCalled(r.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return len(c.Items) == 0
+	}))
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnCustomerNotFound_ReturnsError() {
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+	input := usecases.RemoveProductFromCartInput{
+		CustomerId: uuid.New(),
+		ProductId:  uuid.New(),
+	}
+
+	err := r.removeProductFromCart.Execute(context.Background(), input)
+
+	r.EqualError(err, "customer not found")
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnCartNotFound_ReturnsError() {
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+	input := usecases.RemoveProductFromCartInput{
+		CustomerId: uuid.New(),
+		ProductId:  uuid.New(),
+	}
+
+	err := r.removeProductFromCart.Execute(context.Background(), input)
+
+	r.EqualError(err, "cart not found")
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 0)
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnProductNotInCart_ReturnsError() {
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	input := usecases.RemoveProductFromCartInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  uuid.New(),
+	}
+
+	err := r.removeProductFromCart.Execute(context.Background(), input)
+
+	r.EqualError(err, "product not found in cart")
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 0)
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnGuestCart_UpdatesCartAndReturnsNil() {
+	productId := uuid.New()
+	guestCart := cart.NewGuestCart()
+	guestCart.Items = []cart.CartItem{
+		{
+			Id:        uuid.New(),
+			ProductId: productId,
+			Quantity:  models.Quantity{Value: 1},
+			Price:     models.Money{Value: 3550},
+		},
+	}
+	r.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	r.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	r.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := r.removeProductFromCart.Execute(context.Background(), usecases.RemoveProductFromCartInput{
+		ProductId: productId,
+		CartToken: "cart-token",
+	})
+
+	r.NoError(err)
+	r.customerGatewayMock.AssertNotCalled(r.T(), "ExistsById", mock.Anything, mock.Anything)
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 1)
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnInvalidCartToken_ReturnsError() {
+	r.cartTokenGatewayMock.On("Verify", mock.Anything, "tampered-token").Return(nil, nil)
+
+	err := r.removeProductFromCart.Execute(context.Background(), usecases.RemoveProductFromCartInput{
+		ProductId: uuid.New(),
+		CartToken: "tampered-token",
+	})
+
+	r.EqualError(err, "cart not found")
+	r.cartRepositoryMock.AssertNotCalled(r.T(), "FindOneById", mock.Anything, mock.Anything)
+}
+
+func (r *RemoveProductFromCartSuite) TestRemoveProductFromCart_Execute_OnNonConflictError_DoesNotRetry() {
+	productId := uuid.New()
+	customerCart := cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{Id: uuid.New(), ProductId: productId, Quantity: models.Quantity{Value: 1}, Price: models.Money{Value: 3550}},
+		},
+	}
+	r.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	r.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	r.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	err := r.removeProductFromCart.Execute(context.Background(), usecases.RemoveProductFromCartInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+	})
+
+	r.EqualError(err, "connection refused")
+	r.cartRepositoryMock.AssertNumberOfCalls(r.T(), "Update", 1)
+}
+
+func TestRemoveProductFromCart(t *testing.T) {
+	suite.Run(t, new(RemoveProductFromCartSuite))
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type RevokeAccessTokenInput struct {
+	TokenId string
+}
+
+type IRevokeAccessToken interface {
+	Execute(ctx context.Context, input RevokeAccessTokenInput) error
+}
+
+type RevokeAccessToken struct {
+	AccessTokenDenylistGateway gateways.IAccessTokenDenylistGateway
+	ClockGateway               gateways.IClockGateway
+}
+
+func (r *RevokeAccessToken) Execute(ctx context.Context, input RevokeAccessTokenInput) error {
+	return r.AccessTokenDenylistGateway.Revoke(ctx, input.TokenId, r.ClockGateway.Now().Add(AccessTokenTtl))
+}
@@ -0,0 +1,70 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccessTokenDenylistGatewayMock struct {
+	mock.Mock
+}
+
+func (a *AccessTokenDenylistGatewayMock) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	args := a.Called(ctx, tokenId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (a *AccessTokenDenylistGatewayMock) Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error {
+	args := a.Called(ctx, tokenId, expiresAt)
+	return args.Error(0)
+}
+
+type RevokeAccessTokenSuite struct {
+	suite.Suite
+	revokeAccessToken              usecases.RevokeAccessToken
+	clockGateway                   *infragateways.FakeClockGateway
+	accessTokenDenylistGatewayMock AccessTokenDenylistGatewayMock
+}
+
+func (r *RevokeAccessTokenSuite) SetupTest() {
+	r.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	r.accessTokenDenylistGatewayMock = AccessTokenDenylistGatewayMock{}
+
+	r.revokeAccessToken = usecases.RevokeAccessToken{
+		AccessTokenDenylistGateway: &r.accessTokenDenylistGatewayMock,
+		ClockGateway:               r.clockGateway,
+	}
+}
+
+func (r *RevokeAccessTokenSuite) TestRevokeAccessToken_Execute_OnTokenId_DeniesItUntilEveryTokenIssuedNowHasExpired() {
+	expiresAt := r.clockGateway.Now().Add(usecases.AccessTokenTtl)
+	r.accessTokenDenylistGatewayMock.On("Revoke", mock.Anything, "token-id", expiresAt).Return(nil)
+
+	err := r.revokeAccessToken.Execute(context.Background(), usecases.RevokeAccessTokenInput{
+		TokenId: "token-id",
+	})
+
+	r.NoError(err)
+	r.accessTokenDenylistGatewayMock.AssertCalled(r.T(), "Revoke", mock.Anything, "token-id", expiresAt)
+}
+
+func (r *RevokeAccessTokenSuite) TestRevokeAccessToken_Execute_OnGatewayFailure_ReturnsError() {
+	r.accessTokenDenylistGatewayMock.On("Revoke", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	err := r.revokeAccessToken.Execute(context.Background(), usecases.RevokeAccessTokenInput{
+		TokenId: "token-id",
+	})
+
+	r.EqualError(err, "connection refused")
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	suite.Run(t, new(RevokeAccessTokenSuite))
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type SelectShippingMethodInput struct {
+	CustomerId         uuid.UUID
+	ShippingMethodCode string
+}
+
+type SelectShippingMethodOutput struct {
+	MethodCode string
+	MethodName string
+	Currency   string
+	Cost       int64
+	Total      int64
+}
+
+type ISelectShippingMethod interface {
+	Execute(ctx context.Context, input SelectShippingMethodInput) (SelectShippingMethodOutput, error)
+}
+
+type SelectShippingMethod struct {
+	CustomerGateway     gateways.ICustomerGateway
+	ProductGateway      gateways.IProductGateway
+	ClockGateway        gateways.IClockGateway
+	CartRepository      repositories.ICartRepository
+	PromotionRepository repositories.IPromotionRepository
+	ShippingQuoter      IShippingQuoter
+}
+
+func (s *SelectShippingMethod) Execute(ctx context.Context, input SelectShippingMethodInput) (SelectShippingMethodOutput, error) {
+	var output SelectShippingMethodOutput
+	err := retryOnCartVersionConflict(func() error {
+		var err error
+		output, err = s.execute(ctx, input)
+		return err
+	})
+
+	return output, err
+}
+
+func (s *SelectShippingMethod) execute(ctx context.Context, input SelectShippingMethodInput) (SelectShippingMethodOutput, error) {
+	customerExists, err := s.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	if !customerExists {
+		return SelectShippingMethodOutput{}, ErrCustomerNotFound
+	}
+
+	customerCart, err := s.CartRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	if customerCart == nil {
+		return SelectShippingMethodOutput{}, ErrCartNotFound
+	}
+
+	err = customerCart.SelectShippingMethod(input.ShippingMethodCode)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	breakdown, err := cartBreakdown(ctx, *customerCart, s.PromotionRepository, s.ClockGateway)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	parcel, err := cartParcel(ctx, s.ProductGateway, customerCart.Items)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	quote, err := s.ShippingQuoter.Quote(ctx, customerCart.ShippingMethodCode, parcel, breakdown.Total, breakdown.FreeShipping)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	total, err := breakdown.Total.Add(quote.Cost)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	err = s.CartRepository.Update(ctx, *customerCart)
+	if err != nil {
+		return SelectShippingMethodOutput{}, err
+	}
+
+	return SelectShippingMethodOutput{
+		MethodCode: quote.MethodCode,
+		MethodName: quote.MethodName,
+		Currency:   quote.Cost.Currency.Code,
+		Cost:       quote.Cost.Value,
+		Total:      total.Value,
+	}, nil
+}
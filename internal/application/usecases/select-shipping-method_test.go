@@ -0,0 +1,167 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SelectShippingMethodSuite struct {
+	suite.Suite
+	selectShippingMethod    usecases.SelectShippingMethod
+	customerGatewayMock     CustomerGatewayMock
+	productGatewayMock      ProductGatewayMock
+	cartRepositoryMock      CartRepositoryMock
+	promotionRepositoryMock PromotionRepositoryMock
+}
+
+func (s *SelectShippingMethodSuite) SetupTest() {
+	s.customerGatewayMock = CustomerGatewayMock{}
+	s.productGatewayMock = ProductGatewayMock{}
+	s.cartRepositoryMock = CartRepositoryMock{}
+	s.promotionRepositoryMock = PromotionRepositoryMock{}
+
+	s.selectShippingMethod = usecases.SelectShippingMethod{
+		CustomerGateway:     &s.customerGatewayMock,
+		ProductGateway:      &s.productGatewayMock,
+		ClockGateway:        infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)),
+		CartRepository:      &s.cartRepositoryMock,
+		PromotionRepository: &s.promotionRepositoryMock,
+		ShippingQuoter: &usecases.ShippingQuoter{
+			ShippingRateGateway: &infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods},
+		},
+	}
+}
+
+func (s *SelectShippingMethodSuite) newCustomerCart() cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: uuid.New(),
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 5000, Currency: models.BRL},
+			},
+		},
+	}
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnValidMethod_UpdatesCartAndReturnsQuote() {
+	customerCart := s.newCustomerCart()
+	s.customerGatewayMock.On("ExistsById", mock.Anything, customerCart.CustomerId).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerCart.CustomerId).Return(&customerCart, nil)
+	s.productGatewayMock.On("FindOneById", mock.Anything, customerCart.Items[0].ProductId).
+		Return(&gateways.ProductDTO{Id: customerCart.Items[0].ProductId, WeightGrams: 400, LengthCm: 30, WidthCm: 20, HeightCm: 10}, nil)
+	s.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	sut, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         customerCart.CustomerId,
+		ShippingMethodCode: " standard ",
+	})
+
+	s.NoError(err)
+	s.Equal(usecases.SelectShippingMethodOutput{
+		MethodCode: "STANDARD",
+		MethodName: "Standard delivery",
+		Currency:   "BRL",
+		Cost:       2990,
+		Total:      12990,
+	}, sut)
+	s.cartRepositoryMock.AssertCalled(s.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.ShippingMethodCode == "STANDARD"
+	}))
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnCustomerNotFound_ReturnsError() {
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         uuid.New(),
+		ShippingMethodCode: "STANDARD",
+	})
+
+	s.EqualError(err, "customer not found")
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnCartNotFound_ReturnsError() {
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         uuid.New(),
+		ShippingMethodCode: "STANDARD",
+	})
+
+	s.EqualError(err, "cart not found")
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnEmptyCart_ReturnsError() {
+	customerCart := cart.Cart{Id: uuid.New(), CustomerId: uuid.New(), Items: []cart.CartItem{}}
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         customerCart.CustomerId,
+		ShippingMethodCode: "STANDARD",
+	})
+
+	s.EqualError(err, "cart is empty")
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnUnknownMethod_ReturnsErrorWithoutUpdatingCart() {
+	customerCart := s.newCustomerCart()
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	s.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 400}, nil)
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         customerCart.CustomerId,
+		ShippingMethodCode: "DRONE",
+	})
+
+	s.EqualError(err, "shipping method not found")
+	s.cartRepositoryMock.AssertNumberOfCalls(s.T(), "Update", 0)
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnCartTooHeavyForMethod_ReturnsErrorWithoutUpdatingCart() {
+	customerCart := s.newCustomerCart()
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	s.productGatewayMock.On("FindOneById", mock.Anything, mock.Anything).Return(&gateways.ProductDTO{WeightGrams: 16000}, nil)
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         customerCart.CustomerId,
+		ShippingMethodCode: "STANDARD",
+	})
+
+	s.EqualError(err, "shipping method cannot ship this weight")
+	s.cartRepositoryMock.AssertNumberOfCalls(s.T(), "Update", 0)
+}
+
+func (s *SelectShippingMethodSuite) TestSelectShippingMethod_Execute_OnRepositoryError_ReturnsError() {
+	s.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	s.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := s.selectShippingMethod.Execute(context.Background(), usecases.SelectShippingMethodInput{
+		CustomerId:         uuid.New(),
+		ShippingMethodCode: "STANDARD",
+	})
+
+	s.EqualError(err, "connection refused")
+}
+
+func TestSelectShippingMethod(t *testing.T) {
+	suite.Run(t, new(SelectShippingMethodSuite))
+}
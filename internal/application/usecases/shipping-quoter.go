@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+)
+
+type IShippingQuoter interface {
+	Quote(ctx context.Context, methodCode string, parcel shipping.Parcel, subtotal models.Money, freeShipping bool) (shipping.ShippingQuote, error)
+	QuoteAll(ctx context.Context, parcel shipping.Parcel, subtotal models.Money, freeShipping bool) ([]shipping.ShippingQuote, error)
+}
+
+type ShippingQuoter struct {
+	ShippingRateGateway gateways.IShippingRateGateway
+}
+
+func (s *ShippingQuoter) Quote(ctx context.Context, methodCode string, parcel shipping.Parcel, subtotal models.Money, freeShipping bool) (shipping.ShippingQuote, error) {
+	methodDTO, err := s.ShippingRateGateway.FindOneByCode(ctx, methodCode)
+	if err != nil {
+		return shipping.ShippingQuote{}, err
+	}
+
+	if methodDTO == nil {
+		return shipping.ShippingQuote{}, ErrShippingMethodNotFound
+	}
+
+	method, err := toShippingMethod(*methodDTO)
+	if err != nil {
+		return shipping.ShippingQuote{}, err
+	}
+
+	return method.Quote(parcel, subtotal, freeShipping)
+}
+
+func (s *ShippingQuoter) QuoteAll(ctx context.Context, parcel shipping.Parcel, subtotal models.Money, freeShipping bool) ([]shipping.ShippingQuote, error) {
+	methodDTOs, err := s.ShippingRateGateway.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := []shipping.ShippingQuote{}
+	for _, methodDTO := range methodDTOs {
+		method, err := toShippingMethod(methodDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		quote, err := method.Quote(parcel, subtotal, freeShipping)
+		if err != nil {
+			switch {
+			case errors.Is(err, shipping.ErrShippingWeightNotSupported), errors.Is(err, shipping.ErrShippingMethodCurrencyMismatch):
+				continue
+			}
+
+			return nil, err
+		}
+
+		quotes = append(quotes, quote)
+	}
+
+	return quotes, nil
+}
+
+func toShippingMethod(methodDTO gateways.ShippingMethodDTO) (shipping.ShippingMethod, error) {
+	weightBands := []shipping.WeightBand{}
+	for _, bandDTO := range methodDTO.WeightBands {
+		rate, err := models.NewMoney(bandDTO.Rate, methodDTO.Currency)
+		if err != nil {
+			return shipping.ShippingMethod{}, err
+		}
+
+		weightBands = append(weightBands, shipping.WeightBand{
+			MaxWeightGrams: bandDTO.MaxWeightGrams,
+			Rate:           rate,
+		})
+	}
+
+	return shipping.NewShippingMethod(methodDTO.Code, methodDTO.Name, methodDTO.RateType, methodDTO.Currency, methodDTO.Rate, weightBands,
+		methodDTO.FreeOverThreshold, methodDTO.VolumetricDivisor)
+}
+
+func cartParcel(ctx context.Context, productGateway gateways.IProductGateway, items []cart.CartItem) (shipping.Parcel, error) {
+	parcel := shipping.Parcel{}
+	for _, item := range items {
+		productDTO, err := productGateway.FindOneById(ctx, item.ProductId)
+		if err != nil {
+			return shipping.Parcel{}, err
+		}
+
+		if productDTO == nil {
+			return shipping.Parcel{}, ErrProductNotFound
+		}
+
+		parcel = parcel.Add(int64(productDTO.WeightGrams), int64(productDTO.LengthCm), int64(productDTO.WidthCm), int64(productDTO.HeightCm),
+			item.Quantity.Value)
+	}
+
+	return parcel, nil
+}
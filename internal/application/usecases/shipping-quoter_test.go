@@ -0,0 +1,122 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShippingRateGatewayMock struct {
+	mock.Mock
+}
+
+func (s *ShippingRateGatewayMock) FindAll(ctx context.Context) ([]gateways.ShippingMethodDTO, error) {
+	args := s.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]gateways.ShippingMethodDTO), args.Error(1)
+}
+
+func (s *ShippingRateGatewayMock) FindOneByCode(ctx context.Context, code string) (*gateways.ShippingMethodDTO, error) {
+	args := s.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.ShippingMethodDTO), args.Error(1)
+}
+
+type ShippingQuoterSuite struct {
+	suite.Suite
+	shippingQuoter          usecases.ShippingQuoter
+	shippingRateGatewayMock ShippingRateGatewayMock
+}
+
+func (s *ShippingQuoterSuite) SetupTest() {
+	s.shippingRateGatewayMock = ShippingRateGatewayMock{}
+
+	s.shippingQuoter = usecases.ShippingQuoter{
+		ShippingRateGateway: &s.shippingRateGatewayMock,
+	}
+}
+
+func (s *ShippingQuoterSuite) newWeightBandMethod() gateways.ShippingMethodDTO {
+	return gateways.ShippingMethodDTO{
+		Code:     "STANDARD",
+		Name:     "Standard delivery",
+		RateType: "WEIGHT_BAND",
+		Currency: "BRL",
+		WeightBands: []gateways.ShippingWeightBandDTO{
+			{MaxWeightGrams: 1000, Rate: 1990},
+			{MaxWeightGrams: 5000, Rate: 2990},
+		},
+	}
+}
+
+func (s *ShippingQuoterSuite) TestShippingQuoter_Quote_OnKnownMethod_ReturnsQuote() {
+	method := s.newWeightBandMethod()
+	s.shippingRateGatewayMock.On("FindOneByCode", mock.Anything, "STANDARD").Return(&method, nil)
+
+	sut, err := s.shippingQuoter.Quote(context.Background(), "STANDARD", shipping.Parcel{WeightGrams: 1500}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	s.NoError(err)
+	s.Equal(shipping.ShippingQuote{
+		MethodCode: "STANDARD",
+		MethodName: "Standard delivery",
+		Cost:       models.Money{Value: 2990, Currency: models.BRL},
+	}, sut)
+}
+
+func (s *ShippingQuoterSuite) TestShippingQuoter_Quote_OnUnknownMethod_ReturnsError() {
+	s.shippingRateGatewayMock.On("FindOneByCode", mock.Anything, "DRONE").Return(nil, nil)
+
+	_, err := s.shippingQuoter.Quote(context.Background(), "DRONE", shipping.Parcel{WeightGrams: 1500}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	s.EqualError(err, "shipping method not found")
+}
+
+func (s *ShippingQuoterSuite) TestShippingQuoter_Quote_OnInvalidTableEntry_ReturnsError() {
+	method := s.newWeightBandMethod()
+	method.RateType = "BY_DISTANCE"
+	s.shippingRateGatewayMock.On("FindOneByCode", mock.Anything, "STANDARD").Return(&method, nil)
+
+	_, err := s.shippingQuoter.Quote(context.Background(), "STANDARD", shipping.Parcel{WeightGrams: 1500}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	s.EqualError(err, "shipping rate type is not supported")
+}
+
+func (s *ShippingQuoterSuite) TestShippingQuoter_QuoteAll_OnMixedMethods_SkipsMethodsThatCannotShip() {
+	s.shippingRateGatewayMock.On("FindAll", mock.Anything).Return([]gateways.ShippingMethodDTO{
+		s.newWeightBandMethod(),
+		{Code: "EXPRESS", Name: "Express delivery", RateType: "FLAT", Currency: "BRL", Rate: 4990},
+		{Code: "INTERNATIONAL", Name: "International", RateType: "FLAT", Currency: "USD", Rate: 2500},
+	}, nil)
+
+	sut, err := s.shippingQuoter.QuoteAll(context.Background(), shipping.Parcel{WeightGrams: 8000}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	s.NoError(err)
+	s.Equal([]shipping.ShippingQuote{
+		{MethodCode: "EXPRESS", MethodName: "Express delivery", Cost: models.Money{Value: 4990, Currency: models.BRL}},
+	}, sut)
+}
+
+func (s *ShippingQuoterSuite) TestShippingQuoter_QuoteAll_OnGatewayError_ReturnsError() {
+	s.shippingRateGatewayMock.On("FindAll", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := s.shippingQuoter.QuoteAll(context.Background(), shipping.Parcel{}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	s.EqualError(err, "connection refused")
+}
+
+func TestShippingQuoter(t *testing.T) {
+	suite.Run(t, new(ShippingQuoterSuite))
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+)
+
+type SignUpInput struct {
+	Email    string
+	Password string
+}
+
+type SignUpOutput struct {
+	CustomerId uuid.UUID
+}
+
+type ISignUp interface {
+	Execute(ctx context.Context, input SignUpInput) (SignUpOutput, error)
+}
+
+type SignUp struct {
+	PasswordHasherGateway gateways.IPasswordHasherGateway
+	CustomerRepository    repositories.ICustomerRepository
+}
+
+func (s *SignUp) Execute(ctx context.Context, input SignUpInput) (SignUpOutput, error) {
+	email, err := customer.NormalizeEmail(input.Email)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	err = customer.ValidatePassword(input.Password)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	existingCustomer, err := s.CustomerRepository.FindOneByEmail(ctx, email)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	if existingCustomer != nil {
+		return SignUpOutput{}, repositories.ErrCustomerEmailAlreadyInUse
+	}
+
+	passwordHash, err := s.PasswordHasherGateway.Hash(input.Password)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	newCustomer, err := customer.NewCustomer(email, passwordHash)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	err = s.CustomerRepository.Create(ctx, newCustomer)
+	if err != nil {
+		return SignUpOutput{}, err
+	}
+
+	return SignUpOutput{
+		CustomerId: newCustomer.Id,
+	}, nil
+}
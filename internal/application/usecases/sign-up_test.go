@@ -0,0 +1,140 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerRepositoryMock struct {
+	mock.Mock
+}
+
+func (c *CustomerRepositoryMock) Create(ctx context.Context, customer customer.Customer) error {
+	args := c.Called(ctx, customer)
+	return args.Error(0)
+}
+
+func (c *CustomerRepositoryMock) FindOneById(ctx context.Context, id uuid.UUID) (*customer.Customer, error) {
+	args := c.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*customer.Customer), args.Error(1)
+}
+
+func (c *CustomerRepositoryMock) FindOneByEmail(ctx context.Context, email string) (*customer.Customer, error) {
+	args := c.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*customer.Customer), args.Error(1)
+}
+
+type PasswordHasherGatewayMock struct {
+	mock.Mock
+}
+
+func (p *PasswordHasherGatewayMock) Hash(password string) (string, error) {
+	args := p.Called(password)
+	return args.String(0), args.Error(1)
+}
+
+func (p *PasswordHasherGatewayMock) Compare(passwordHash string, password string) (bool, error) {
+	args := p.Called(passwordHash, password)
+	return args.Bool(0), args.Error(1)
+}
+
+type SignUpSuite struct {
+	suite.Suite
+	signUp                    usecases.SignUp
+	passwordHasherGatewayMock PasswordHasherGatewayMock
+	customerRepositoryMock    CustomerRepositoryMock
+}
+
+func (s *SignUpSuite) SetupTest() {
+	s.passwordHasherGatewayMock = PasswordHasherGatewayMock{}
+	s.customerRepositoryMock = CustomerRepositoryMock{}
+
+	s.signUp = usecases.SignUp{
+		PasswordHasherGateway: &s.passwordHasherGatewayMock,
+		CustomerRepository:    &s.customerRepositoryMock,
+	}
+}
+
+func (s *SignUpSuite) TestSignUp_Execute_OnValidInput_CreatesCustomerWithNormalizedEmailAndHashedPassword() {
+	s.customerRepositoryMock.On("FindOneByEmail", mock.Anything, "john.doe@example.com").Return(nil, nil)
+	s.passwordHasherGatewayMock.On("Hash", "s3cret-password").Return("hashed-password", nil)
+	s.customerRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	output, err := s.signUp.Execute(context.Background(), usecases.SignUpInput{
+		Email:    "  John.Doe@Example.com ",
+		Password: "s3cret-password",
+	})
+
+	s.NoError(err)
+	s.customerRepositoryMock.AssertCalled(s.T(), "Create", mock.Anything, mock.MatchedBy(func(c customer.Customer) bool {
+		return c.Id == output.CustomerId &&
+			c.Email == "john.doe@example.com" &&
+			c.PasswordHash == "hashed-password"
+	}))
+}
+
+func (s *SignUpSuite) TestSignUp_Execute_OnInvalidEmail_ReturnsError() {
+	_, err := s.signUp.Execute(context.Background(), usecases.SignUpInput{
+		Email:    "john.doe",
+		Password: "s3cret-password",
+	})
+
+	s.EqualError(err, "customer email is invalid")
+	s.customerRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SignUpSuite) TestSignUp_Execute_OnShortPassword_ReturnsError() {
+	_, err := s.signUp.Execute(context.Background(), usecases.SignUpInput{
+		Email:    "john.doe@example.com",
+		Password: "short",
+	})
+
+	s.EqualError(err, "customer password is too short")
+	s.customerRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SignUpSuite) TestSignUp_Execute_OnEmailAlreadyInUse_ReturnsError() {
+	existingCustomer, _ := customer.NewCustomer("john.doe@example.com", "hashed-password")
+	s.customerRepositoryMock.On("FindOneByEmail", mock.Anything, "john.doe@example.com").Return(&existingCustomer, nil)
+
+	_, err := s.signUp.Execute(context.Background(), usecases.SignUpInput{
+		Email:    "john.doe@example.com",
+		Password: "s3cret-password",
+	})
+
+	s.EqualError(err, "customer email already in use")
+	s.passwordHasherGatewayMock.AssertNotCalled(s.T(), "Hash", mock.Anything)
+	s.customerRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SignUpSuite) TestSignUp_Execute_OnHasherFailure_ReturnsError() {
+	s.customerRepositoryMock.On("FindOneByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	s.passwordHasherGatewayMock.On("Hash", mock.Anything).Return("", errors.New("any_error"))
+
+	_, err := s.signUp.Execute(context.Background(), usecases.SignUpInput{
+		Email:    "john.doe@example.com",
+		Password: "s3cret-password",
+	})
+
+	s.EqualError(err, "any_error")
+	s.customerRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func TestSignUp(t *testing.T) {
+	suite.Run(t, new(SignUpSuite))
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+)
+
+type ITaxCalculator interface {
+	Calculate(ctx context.Context, country string, region string, lines []tax.TaxableLine, discount models.Money) (tax.TaxCalculation, error)
+}
+
+type TaxCalculator struct {
+	TaxRuleGateway gateways.ITaxRuleGateway
+}
+
+func (t *TaxCalculator) Calculate(ctx context.Context, country string, region string, lines []tax.TaxableLine, discount models.Money) (tax.TaxCalculation, error) {
+	if tax.NormalizeCountry(country) == "" {
+		return tax.TaxCalculation{}, tax.ErrTaxCountryEmpty
+	}
+
+	ruleDTOs, err := t.TaxRuleGateway.FindByCountry(ctx, tax.NormalizeCountry(country))
+	if err != nil {
+		return tax.TaxCalculation{}, err
+	}
+
+	rules := []tax.TaxRule{}
+	for _, ruleDTO := range ruleDTOs {
+		rule, err := tax.NewTaxRule(ruleDTO.Country, ruleDTO.Region, ruleDTO.TaxClass, ruleDTO.Rate, ruleDTO.Inclusive)
+		if err != nil {
+			return tax.TaxCalculation{}, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return tax.Calculate(country, region, rules, lines, discount)
+}
@@ -0,0 +1,91 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxRuleGatewayMock struct {
+	mock.Mock
+}
+
+func (t *TaxRuleGatewayMock) FindByCountry(ctx context.Context, country string) ([]gateways.TaxRuleDTO, error) {
+	args := t.Called(ctx, country)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]gateways.TaxRuleDTO), args.Error(1)
+}
+
+type TaxCalculatorSuite struct {
+	suite.Suite
+	taxCalculator      usecases.TaxCalculator
+	taxRuleGatewayMock TaxRuleGatewayMock
+}
+
+func (t *TaxCalculatorSuite) SetupTest() {
+	t.taxRuleGatewayMock = TaxRuleGatewayMock{}
+
+	t.taxCalculator = usecases.TaxCalculator{
+		TaxRuleGateway: &t.taxRuleGatewayMock,
+	}
+}
+
+func (t *TaxCalculatorSuite) TestTaxCalculator_Calculate_OnRegionRules_ReturnsCalculation() {
+	productId := uuid.New()
+	t.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "18", Inclusive: true},
+	}, nil)
+
+	sut, err := t.taxCalculator.Calculate(context.Background(), "br", "sp", []tax.TaxableLine{
+		{ProductId: productId, TaxClass: "standard", Amount: models.Money{Value: 11800, Currency: models.BRL}},
+	}, models.Money{})
+
+	t.NoError(err)
+	t.Equal("BR", sut.Country)
+	t.Equal("SP", sut.Region)
+	t.Equal(models.Money{Value: 1800, Currency: models.BRL}, sut.Tax)
+	t.Equal(models.Money{Value: 11800, Currency: models.BRL}, sut.Gross)
+}
+
+func (t *TaxCalculatorSuite) TestTaxCalculator_Calculate_OnNoRegionRules_ReturnsError() {
+	t.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{}, nil)
+
+	_, err := t.taxCalculator.Calculate(context.Background(), "BR", "RJ", []tax.TaxableLine{
+		{ProductId: uuid.New(), TaxClass: "standard", Amount: models.Money{Value: 1000, Currency: models.BRL}},
+	}, models.Money{})
+
+	t.EqualError(err, "tax rule not found")
+}
+
+func (t *TaxCalculatorSuite) TestTaxCalculator_Calculate_OnInvalidRule_ReturnsError() {
+	t.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return([]gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "eighteen", Inclusive: true},
+	}, nil)
+
+	_, err := t.taxCalculator.Calculate(context.Background(), "BR", "SP", []tax.TaxableLine{}, models.Money{})
+
+	t.EqualError(err, "tax rate must be a percentage between 0 and 100")
+}
+
+func (t *TaxCalculatorSuite) TestTaxCalculator_Calculate_OnGatewayError_ReturnsError() {
+	t.taxRuleGatewayMock.On("FindByCountry", mock.Anything, "BR").Return(nil, errors.New("permission denied"))
+
+	_, err := t.taxCalculator.Calculate(context.Background(), "BR", "SP", []tax.TaxableLine{}, models.Money{})
+
+	t.EqualError(err, "permission denied")
+}
+
+func TestTaxCalculator(t *testing.T) {
+	suite.Run(t, new(TaxCalculatorSuite))
+}
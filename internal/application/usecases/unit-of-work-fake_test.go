@@ -0,0 +1,146 @@
+package usecases_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+)
+
+type UnitOfWorkFake struct {
+	Repositories repositories.UnitOfWorkRepositories
+	Writes       []string
+	RolledBack   int
+}
+
+func (u *UnitOfWorkFake) Execute(ctx context.Context, work func(repositories repositories.UnitOfWorkRepositories) error) error {
+	pending := &[]string{}
+	transaction := repositories.UnitOfWorkRepositories{
+		PromotionRepository: u.Repositories.PromotionRepository,
+	}
+
+	if u.Repositories.CartRepository != nil {
+		transaction.CartRepository = &cartRepositoryJournal{ICartRepository: u.Repositories.CartRepository, pending: pending}
+	}
+
+	if u.Repositories.OrderRepository != nil {
+		transaction.OrderRepository = &orderRepositoryJournal{IOrderRepository: u.Repositories.OrderRepository, pending: pending}
+	}
+
+	if u.Repositories.ProductRepository != nil {
+		transaction.ProductRepository = &productRepositoryJournal{IProductRepository: u.Repositories.ProductRepository, pending: pending}
+	}
+
+	if u.Repositories.AddressBookRepository != nil {
+		transaction.AddressBookRepository = &addressBookRepositoryJournal{IAddressBookRepository: u.Repositories.AddressBookRepository, pending: pending}
+	}
+
+	if u.Repositories.InventoryGateway != nil {
+		transaction.InventoryGateway = &inventoryGatewayJournal{IInventoryGateway: u.Repositories.InventoryGateway, pending: pending}
+	}
+
+	err := work(transaction)
+	if err != nil {
+		u.RolledBack++
+		return err
+	}
+
+	u.Writes = append(u.Writes, *pending...)
+	return nil
+}
+
+func record(pending *[]string, write string, err error) error {
+	if err == nil {
+		*pending = append(*pending, write)
+	}
+
+	return err
+}
+
+type cartRepositoryJournal struct {
+	repositories.ICartRepository
+	pending *[]string
+}
+
+func (c *cartRepositoryJournal) Create(ctx context.Context, cart cart.Cart) error {
+	return record(c.pending, "CartRepository.Create", c.ICartRepository.Create(ctx, cart))
+}
+
+func (c *cartRepositoryJournal) Update(ctx context.Context, cart cart.Cart) error {
+	return record(c.pending, "CartRepository.Update", c.ICartRepository.Update(ctx, cart))
+}
+
+func (c *cartRepositoryJournal) Delete(ctx context.Context, id uuid.UUID) error {
+	return record(c.pending, "CartRepository.Delete", c.ICartRepository.Delete(ctx, id))
+}
+
+type orderRepositoryJournal struct {
+	repositories.IOrderRepository
+	pending *[]string
+}
+
+func (o *orderRepositoryJournal) CreateFromCart(ctx context.Context, order order.Order, checkedOutCart cart.Cart) error {
+	return record(o.pending, "OrderRepository.CreateFromCart", o.IOrderRepository.CreateFromCart(ctx, order, checkedOutCart))
+}
+
+func (o *orderRepositoryJournal) Update(ctx context.Context, order order.Order, previousStatus order.OrderStatus) error {
+	return record(o.pending, "OrderRepository.Update", o.IOrderRepository.Update(ctx, order, previousStatus))
+}
+
+type productRepositoryJournal struct {
+	repositories.IProductRepository
+	pending *[]string
+}
+
+func (p *productRepositoryJournal) Create(ctx context.Context, product product.Product) error {
+	return record(p.pending, "ProductRepository.Create", p.IProductRepository.Create(ctx, product))
+}
+
+func (p *productRepositoryJournal) Update(ctx context.Context, product product.Product) error {
+	return record(p.pending, "ProductRepository.Update", p.IProductRepository.Update(ctx, product))
+}
+
+type addressBookRepositoryJournal struct {
+	repositories.IAddressBookRepository
+	pending *[]string
+}
+
+func (a *addressBookRepositoryJournal) Save(ctx context.Context, addressBook address.AddressBook) error {
+	return record(a.pending, "AddressBookRepository.Save", a.IAddressBookRepository.Save(ctx, addressBook))
+}
+
+type inventoryGatewayJournal struct {
+	gateways.IInventoryGateway
+	pending *[]string
+}
+
+func (i *inventoryGatewayJournal) Create(ctx context.Context, inventory gateways.InventoryDTO) error {
+	return record(i.pending, "InventoryGateway.Create", i.IInventoryGateway.Create(ctx, inventory))
+}
+
+func (i *inventoryGatewayJournal) Reserve(ctx context.Context, reservationId uuid.UUID, items []gateways.StockReservationItemDTO, expiresAt time.Time) error {
+	return record(i.pending, "InventoryGateway.Reserve", i.IInventoryGateway.Reserve(ctx, reservationId, items, expiresAt))
+}
+
+func (i *inventoryGatewayJournal) Release(ctx context.Context, reservationId uuid.UUID) error {
+	return record(i.pending, "InventoryGateway.Release", i.IInventoryGateway.Release(ctx, reservationId))
+}
+
+func (i *inventoryGatewayJournal) Commit(ctx context.Context, reservationId uuid.UUID, now time.Time) error {
+	return record(i.pending, "InventoryGateway.Commit", i.IInventoryGateway.Commit(ctx, reservationId, now))
+}
+
+func (i *inventoryGatewayJournal) ReleaseExpired(ctx context.Context, now time.Time) (int, error) {
+	released, err := i.IInventoryGateway.ReleaseExpired(ctx, now)
+	return released, record(i.pending, "InventoryGateway.ReleaseExpired", err)
+}
+
+func (i *inventoryGatewayJournal) Restock(ctx context.Context, items []gateways.StockReservationItemDTO) error {
+	return record(i.pending, "InventoryGateway.Restock", i.IInventoryGateway.Restock(ctx, items))
+}
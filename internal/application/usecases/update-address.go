@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+)
+
+type UpdateAddressInput struct {
+	CustomerId      uuid.UUID
+	AddressId       uuid.UUID
+	RecipientName   string
+	Line1           string
+	Line2           string
+	City            string
+	Region          string
+	PostalCode      string
+	Country         string
+	DefaultShipping bool
+	DefaultBilling  bool
+}
+
+type IUpdateAddress interface {
+	Execute(ctx context.Context, input UpdateAddressInput) error
+}
+
+type UpdateAddress struct {
+	CustomerGateway       gateways.ICustomerGateway
+	AddressBookRepository repositories.IAddressBookRepository
+}
+
+func (u *UpdateAddress) Execute(ctx context.Context, input UpdateAddressInput) error {
+	customerExists, err := u.CustomerGateway.ExistsById(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if !customerExists {
+		return ErrCustomerNotFound
+	}
+
+	addressBook, err := u.AddressBookRepository.FindOneByCustomerId(ctx, input.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	if addressBook == nil {
+		return address.ErrAddressNotFound
+	}
+
+	customerAddress := addressBook.Find(input.AddressId)
+	if customerAddress == nil {
+		return address.ErrAddressNotFound
+	}
+
+	err = customerAddress.Update(input.RecipientName, input.Line1, input.Line2, input.City, input.Region, input.PostalCode,
+		input.Country)
+	if err != nil {
+		return err
+	}
+
+	if input.DefaultShipping {
+		err = addressBook.SetDefaultShipping(input.AddressId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if input.DefaultBilling {
+		err = addressBook.SetDefaultBilling(input.AddressId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = u.AddressBookRepository.Save(ctx, *addressBook)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
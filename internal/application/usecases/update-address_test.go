@@ -0,0 +1,115 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateAddressSuite struct {
+	suite.Suite
+	updateAddress             usecases.UpdateAddress
+	customerGatewayMock       CustomerGatewayMock
+	addressBookRepositoryMock AddressBookRepositoryMock
+}
+
+func (u *UpdateAddressSuite) SetupTest() {
+	u.customerGatewayMock = CustomerGatewayMock{}
+	u.addressBookRepositoryMock = AddressBookRepositoryMock{}
+
+	u.updateAddress = usecases.UpdateAddress{
+		CustomerGateway:       &u.customerGatewayMock,
+		AddressBookRepository: &u.addressBookRepositoryMock,
+	}
+}
+
+func (u *UpdateAddressSuite) TestUpdateAddress_Execute_OnExistingAddress_SavesUpdatedAddress() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	work, _ := address.NewAddress("John Doe", "Rua Augusta, 500", "", "São Paulo", "SP", "01305-000", "BR")
+	addressBook.Add(home)
+	addressBook.Add(work)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	u.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+	u.addressBookRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateAddress.Execute(context.Background(), usecases.UpdateAddressInput{
+		CustomerId:     customerId,
+		AddressId:      work.Id,
+		RecipientName:  "Jane Doe",
+		Line1:          "Rua Augusta, 700",
+		City:           "São Paulo",
+		Region:         "SP",
+		PostalCode:     "01305100",
+		Country:        "BR",
+		DefaultBilling: true,
+	})
+
+	u.NoError(err)
+	u.addressBookRepositoryMock.AssertCalled(u.T(), "Save", mock.Anything, mock.MatchedBy(func(b address.AddressBook) bool {
+		return b.Addresses[1].RecipientName == "Jane Doe" &&
+			b.Addresses[1].Line1 == "Rua Augusta, 700" &&
+			b.Addresses[1].PostalCode == "01305-100" &&
+			b.Addresses[1].DefaultBilling &&
+			!b.Addresses[0].DefaultBilling &&
+			b.Addresses[0].DefaultShipping
+	}))
+}
+
+func (u *UpdateAddressSuite) TestUpdateAddress_Execute_OnCustomerNotFound_ReturnsError() {
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+
+	err := u.updateAddress.Execute(context.Background(), usecases.UpdateAddressInput{
+		CustomerId: uuid.New(),
+		AddressId:  uuid.New(),
+	})
+
+	u.EqualError(err, "customer not found")
+}
+
+func (u *UpdateAddressSuite) TestUpdateAddress_Execute_OnAddressOfAnotherCustomer_ReturnsError() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	u.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	err := u.updateAddress.Execute(context.Background(), usecases.UpdateAddressInput{
+		CustomerId: customerId,
+		AddressId:  uuid.New(),
+	})
+
+	u.EqualError(err, "address not found")
+	u.addressBookRepositoryMock.AssertNumberOfCalls(u.T(), "Save", 0)
+}
+
+func (u *UpdateAddressSuite) TestUpdateAddress_Execute_OnUnsupportedCountry_ReturnsError() {
+	customerId := uuid.New()
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	addressBook.Add(home)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, customerId).Return(true, nil)
+	u.addressBookRepositoryMock.On("FindOneByCustomerId", mock.Anything, customerId).Return(&addressBook, nil)
+
+	err := u.updateAddress.Execute(context.Background(), usecases.UpdateAddressInput{
+		CustomerId:    customerId,
+		AddressId:     home.Id,
+		RecipientName: "John Doe",
+		Line1:         "1-1 Chiyoda",
+		City:          "Tokyo",
+		PostalCode:    "100-0001",
+		Country:       "JP",
+	})
+
+	u.EqualError(err, "address country is not supported")
+	u.addressBookRepositoryMock.AssertNumberOfCalls(u.T(), "Save", 0)
+}
+
+func TestUpdateAddress(t *testing.T) {
+	suite.Run(t, new(UpdateAddressSuite))
+}
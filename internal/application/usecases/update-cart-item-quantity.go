@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+)
+
+type UpdateCartItemQuantityInput struct {
+	CustomerId uuid.UUID
+	ProductId  uuid.UUID
+	Quantity   int32
+	CartToken  string
+}
+
+type IUpdateCartItemQuantity interface {
+	Execute(ctx context.Context, input UpdateCartItemQuantityInput) error
+}
+
+type UpdateCartItemQuantity struct {
+	CustomerGateway  gateways.ICustomerGateway
+	InventoryGateway gateways.IInventoryGateway
+	CartRepository   repositories.ICartRepository
+	CartTokenGateway gateways.ICartTokenGateway
+}
+
+func (u *UpdateCartItemQuantity) Execute(ctx context.Context, input UpdateCartItemQuantityInput) error {
+	return retryOnCartVersionConflict(func() error {
+		return u.execute(ctx, input)
+	})
+}
+
+func (u *UpdateCartItemQuantity) execute(ctx context.Context, input UpdateCartItemQuantityInput) error {
+	if input.CustomerId != uuid.Nil {
+		customerExists, err := u.CustomerGateway.ExistsById(ctx, input.CustomerId)
+		if err != nil {
+			return err
+		}
+
+		if !customerExists {
+			return ErrCustomerNotFound
+		}
+	}
+
+	customerCart, err := findCart(ctx, u.CartRepository, u.CartTokenGateway, input.CustomerId, input.CartToken)
+	if err != nil {
+		return err
+	}
+
+	if customerCart == nil {
+		return ErrCartNotFound
+	}
+
+	stock, err := u.InventoryGateway.FindOneByProductId(ctx, input.ProductId)
+	if err != nil {
+		return err
+	}
+
+	available := int32(0)
+	if stock != nil {
+		productInventory, err := inventory.NewInventory(stock.ProductId, stock.OnHand, stock.Reserved)
+		if err != nil {
+			return err
+		}
+
+		available = productInventory.Available().Value
+	}
+
+	err = customerCart.SetItemQuantityWithinStock(input.ProductId, input.Quantity, available)
+	if err != nil {
+		return err
+	}
+
+	err = u.CartRepository.Update(ctx, *customerCart)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateCartItemQuantitySuite struct {
+	suite.Suite
+	updateCartItemQuantity usecases.UpdateCartItemQuantity
+	customerGatewayMock    CustomerGatewayMock
+	inventoryGatewayMock   InventoryGatewayMock
+	cartRepositoryMock     CartRepositoryMock
+	cartTokenGatewayMock   CartTokenGatewayMock
+}
+
+func (u *UpdateCartItemQuantitySuite) SetupTest() {
+	u.customerGatewayMock = CustomerGatewayMock{}
+	u.inventoryGatewayMock = InventoryGatewayMock{}
+	u.cartRepositoryMock = CartRepositoryMock{}
+	u.cartTokenGatewayMock = CartTokenGatewayMock{}
+
+	u.updateCartItemQuantity = usecases.UpdateCartItemQuantity{
+		CustomerGateway:  &u.customerGatewayMock,
+		InventoryGateway: &u.inventoryGatewayMock,
+		CartRepository:   &u.cartRepositoryMock,
+		CartTokenGateway: &u.cartTokenGatewayMock,
+	}
+}
+
+func (u *UpdateCartItemQuantitySuite) newCustomerCart(productId uuid.UUID, quantity int32) cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: quantity},
+				Price:     models.Money{Value: 3550},
+			},
+		},
+	}
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnLowerQuantity_UpdatesCartAndReturnsNil() {
+	productId := uuid.New()
+	customerCart := u.newCustomerCart(productId, 5)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, mock.Anything).Return(&gateways.InventoryDTO{OnHand: 10}, nil)
+	u.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+		Quantity:   int32(2),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.Equal(nil, err)
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 1)
+	u.cartRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return len(c.Items) == 1 && c.Items[0].Quantity.Value == 2 && c.Items[0].Price.Value == 3550
+	}))
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnZeroQuantity_RemovesItemAndReturnsNil() {
+	productId := uuid.New()
+	customerCart := u.newCustomerCart(productId, 5)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, mock.Anything).Return(&gateways.InventoryDTO{OnHand: 10}, nil)
+	u.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+		Quantity:   int32(0),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.Equal(nil, err)
+	u.cartRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return len(c.Items) == 0
+	}))
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnQuantityExceedingStock_ReturnsError() {
+	productId := uuid.New()
+	customerCart := u.newCustomerCart(productId, 2)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, productId).
+		Return(&gateways.InventoryDTO{ProductId: productId, OnHand: 6, Reserved: 2}, nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+		Quantity:   int32(5),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.EqualError(err, "insufficient stock")
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnProductWithoutInventory_ReturnsError() {
+	productId := uuid.New()
+	customerCart := u.newCustomerCart(productId, 2)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, productId).Return(nil, nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  productId,
+		Quantity:   int32(3),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.EqualError(err, "insufficient stock")
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnCustomerNotFound_ReturnsError() {
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(false, nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: uuid.New(),
+		ProductId:  uuid.New(),
+		Quantity:   int32(2),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.EqualError(err, "customer not found")
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnCartNotFound_ReturnsError() {
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(nil, nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: uuid.New(),
+		ProductId:  uuid.New(),
+		Quantity:   int32(2),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.EqualError(err, "cart not found")
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnProductNotInCart_ReturnsError() {
+	customerCart := u.newCustomerCart(uuid.New(), 5)
+	u.customerGatewayMock.On("ExistsById", mock.Anything, mock.Anything).Return(true, nil)
+	u.cartRepositoryMock.On("FindOneByCustomerId", mock.Anything, mock.Anything).Return(&customerCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, mock.Anything).Return(&gateways.InventoryDTO{OnHand: 10}, nil)
+	input := usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerCart.CustomerId,
+		ProductId:  uuid.New(),
+		Quantity:   int32(2),
+	}
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), input)
+
+	u.EqualError(err, "product not found in cart")
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnGuestCart_UpdatesCartAndReturnsNil() {
+	productId := uuid.New()
+	guestCart := u.newCustomerCart(productId, 5)
+	guestCart.CustomerId = uuid.Nil
+	u.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&guestCart.Id, nil)
+	u.cartRepositoryMock.On("FindOneById", mock.Anything, guestCart.Id).Return(&guestCart, nil)
+	u.inventoryGatewayMock.On("FindOneByProductId", mock.Anything, mock.Anything).Return(&gateways.InventoryDTO{OnHand: 10}, nil)
+	u.cartRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), usecases.UpdateCartItemQuantityInput{
+		ProductId: productId,
+		Quantity:  int32(2),
+		CartToken: "cart-token",
+	})
+
+	u.NoError(err)
+	u.customerGatewayMock.AssertNotCalled(u.T(), "ExistsById", mock.Anything, mock.Anything)
+	u.cartRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(c cart.Cart) bool {
+		return c.TotalQuantity().Value == 2
+	}))
+}
+
+func (u *UpdateCartItemQuantitySuite) TestUpdateCartItemQuantity_Execute_OnCartTokenOfCustomerCart_ReturnsError() {
+	customerCart := u.newCustomerCart(uuid.New(), 5)
+	u.cartTokenGatewayMock.On("Verify", mock.Anything, "cart-token").Return(&customerCart.Id, nil)
+	u.cartRepositoryMock.On("FindOneById", mock.Anything, customerCart.Id).Return(&customerCart, nil)
+
+	err := u.updateCartItemQuantity.Execute(context.Background(), usecases.UpdateCartItemQuantityInput{
+		ProductId: customerCart.Items[0].ProductId,
+		Quantity:  int32(2),
+		CartToken: "cart-token",
+	})
+
+	u.EqualError(err, "cart not found")
+	u.cartRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func TestUpdateCartItemQuantity(t *testing.T) {
+	suite.Run(t, new(UpdateCartItemQuantitySuite))
+}
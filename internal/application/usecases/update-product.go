@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+)
+
+type UpdateProductInput struct {
+	ProductId   uuid.UUID
+	Name        string
+	Description string
+	Sku         string
+	Price       int64
+	Currency    string
+	TaxClass    string
+	Dimensions  *ProductDimensionsInput
+}
+
+type IUpdateProduct interface {
+	Execute(ctx context.Context, input UpdateProductInput) error
+}
+
+type UpdateProduct struct {
+	ProductRepository repositories.IProductRepository
+}
+
+func (u *UpdateProduct) Execute(ctx context.Context, input UpdateProductInput) error {
+	existingProduct, err := u.ProductRepository.FindOneById(ctx, input.ProductId)
+	if err != nil {
+		return err
+	}
+
+	if existingProduct == nil {
+		return ErrProductNotFound
+	}
+
+	currency := input.Currency
+	if currency == "" {
+		currency = existingProduct.Price.Currency.Code
+	}
+
+	taxClass := input.TaxClass
+	if taxClass == "" {
+		taxClass = existingProduct.TaxClass
+	}
+
+	err = existingProduct.Update(input.Name, input.Description, input.Sku, input.Price, currency, taxClass)
+	if err != nil {
+		return err
+	}
+
+	if input.Dimensions != nil {
+		err = existingProduct.SetDimensions(input.Dimensions.WeightGrams, input.Dimensions.LengthCm, input.Dimensions.WidthCm,
+			input.Dimensions.HeightCm)
+		if err != nil {
+			return err
+		}
+	}
+
+	productWithSku, err := u.ProductRepository.FindOneBySku(ctx, existingProduct.Sku)
+	if err != nil {
+		return err
+	}
+
+	if productWithSku != nil && productWithSku.Id != existingProduct.Id {
+		return ErrProductSkuAlreadyExists
+	}
+
+	err = u.ProductRepository.Update(ctx, *existingProduct)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateProductSuite struct {
+	suite.Suite
+	updateProduct         usecases.UpdateProduct
+	productRepositoryMock ProductRepositoryMock
+}
+
+func (u *UpdateProductSuite) SetupTest() {
+	u.productRepositoryMock = ProductRepositoryMock{}
+
+	u.updateProduct = usecases.UpdateProduct{
+		ProductRepository: &u.productRepositoryMock,
+	}
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnExistingProduct_UpdatesProduct() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, TaxClass: "standard", Active: true}
+	u.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	u.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(&existingProduct, nil)
+	u.productRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId:   existingProduct.Id,
+		Name:        "Wireless Keyboard",
+		Description: "Bluetooth",
+		Sku:         "KB-001",
+		Price:       39990,
+		Currency:    "BRL",
+	})
+
+	u.NoError(err)
+	u.productRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.Id == existingProduct.Id && p.Name == "Wireless Keyboard" && p.Price.Value == 39990
+	}))
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnTaxClass_ChangesTaxClass() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, TaxClass: "standard", Active: true}
+	u.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	u.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(&existingProduct, nil)
+	u.productRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId: existingProduct.Id,
+		Name:      "Keyboard",
+		Sku:       "KB-001",
+		Price:     45990,
+		Currency:  "BRL",
+		TaxClass:  "electronics",
+	})
+
+	u.NoError(err)
+	u.productRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.TaxClass == "electronics"
+	}))
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnNoDimensions_KeepsDimensions() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, TaxClass: "standard",
+		WeightGrams: 1200, LengthCm: 45, WidthCm: 15, HeightCm: 4, Active: true}
+	u.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	u.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(&existingProduct, nil)
+	u.productRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId: existingProduct.Id,
+		Name:      "Keyboard",
+		Sku:       "KB-001",
+		Price:     45990,
+		Currency:  "BRL",
+	})
+
+	u.NoError(err)
+	u.productRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.WeightGrams == 1200 && p.LengthCm == 45 && p.WidthCm == 15 && p.HeightCm == 4
+	}))
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnDimensions_ChangesDimensions() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, TaxClass: "standard", Active: true}
+	u.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	u.productRepositoryMock.On("FindOneBySku", mock.Anything, "KB-001").Return(&existingProduct, nil)
+	u.productRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId:  existingProduct.Id,
+		Name:       "Keyboard",
+		Sku:        "KB-001",
+		Price:      45990,
+		Currency:   "BRL",
+		Dimensions: &usecases.ProductDimensionsInput{WeightGrams: 900, LengthCm: 40, WidthCm: 14, HeightCm: 3},
+	})
+
+	u.NoError(err)
+	u.productRepositoryMock.AssertCalled(u.T(), "Update", mock.Anything, mock.MatchedBy(func(p product.Product) bool {
+		return p.WeightGrams == 900 && p.LengthCm == 40 && p.WidthCm == 14 && p.HeightCm == 3
+	}))
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnSkuOwnedByAnotherProduct_ReturnsError() {
+	existingProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, TaxClass: "standard", Active: true}
+	otherProduct := product.Product{Id: uuid.New(), Name: "Mouse", Sku: "MS-001", Price: models.Money{Value: 12990}, TaxClass: "standard", Active: true}
+	u.productRepositoryMock.On("FindOneById", mock.Anything, existingProduct.Id).Return(&existingProduct, nil)
+	u.productRepositoryMock.On("FindOneBySku", mock.Anything, "MS-001").Return(&otherProduct, nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId: existingProduct.Id,
+		Name:      "Keyboard",
+		Sku:       "MS-001",
+		Price:     45990,
+		Currency:  "BRL",
+	})
+
+	u.EqualError(err, "product sku already exists")
+	u.productRepositoryMock.AssertNumberOfCalls(u.T(), "Update", 0)
+}
+
+func (u *UpdateProductSuite) TestUpdateProduct_Execute_OnProductNotFound_ReturnsError() {
+	u.productRepositoryMock.On("FindOneById", mock.Anything, mock.Anything).Return(nil, nil)
+
+	err := u.updateProduct.Execute(context.Background(), usecases.UpdateProductInput{
+		ProductId: uuid.New(),
+		Name:      "Keyboard",
+		Sku:       "KB-001",
+		Price:     45990,
+		Currency:  "BRL",
+	})
+
+	u.EqualError(err, "product not found")
+}
+
+func TestUpdateProduct(t *testing.T) {
+	suite.Run(t, new(UpdateProductSuite))
+}
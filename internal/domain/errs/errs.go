@@ -0,0 +1,68 @@
+package errs
+
+type Kind string
+
+const (
+	KindNotFound        Kind = "NOT_FOUND"
+	KindConflict        Kind = "CONFLICT"
+	KindValidation      Kind = "VALIDATION"
+	KindUnauthorized    Kind = "UNAUTHORIZED"
+	KindPaymentRequired Kind = "PAYMENT_REQUIRED"
+	KindUnavailable     Kind = "UNAVAILABLE"
+)
+
+var (
+	ErrNotFound        = &Error{Kind: KindNotFound}
+	ErrConflict        = &Error{Kind: KindConflict}
+	ErrValidation      = &Error{Kind: KindValidation}
+	ErrUnauthorized    = &Error{Kind: KindUnauthorized}
+	ErrPaymentRequired = &Error{Kind: KindPaymentRequired}
+	ErrUnavailable     = &Error{Kind: KindUnavailable}
+)
+
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	if t.Code == "" {
+		return e.Kind == t.Kind
+	}
+
+	return e.Kind == t.Kind && e.Code == t.Code
+}
+
+func NotFound(code string, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+func Conflict(code string, message string) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message}
+}
+
+func Validation(code string, message string) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message}
+}
+
+func Unauthorized(code string, message string) *Error {
+	return &Error{Kind: KindUnauthorized, Code: code, Message: message}
+}
+
+func PaymentRequired(code string, message string) *Error {
+	return &Error{Kind: KindPaymentRequired, Code: code, Message: message}
+}
+
+func Unavailable(code string, message string) *Error {
+	return &Error{Kind: KindUnavailable, Code: code, Message: message}
+}
@@ -0,0 +1,48 @@
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrs_Is_OnSameCode_ReturnsTrue(t *testing.T) {
+	sut := errs.NotFound("PRODUCT_NOT_FOUND", "product not found")
+
+	assert.True(t, errors.Is(sut, errs.NotFound("PRODUCT_NOT_FOUND", "product not found")))
+	assert.EqualError(t, sut, "product not found")
+}
+
+func TestErrs_Is_OnDifferentCode_ReturnsFalse(t *testing.T) {
+	sut := errs.NotFound("PRODUCT_NOT_FOUND", "product not found")
+
+	assert.False(t, errors.Is(sut, errs.NotFound("CART_NOT_FOUND", "cart not found")))
+}
+
+func TestErrs_Is_OnKindSentinel_MatchesByKind(t *testing.T) {
+	sut := errs.Conflict("CART_EMPTY", "cart is empty")
+
+	assert.True(t, errors.Is(sut, errs.ErrConflict))
+	assert.False(t, errors.Is(sut, errs.ErrNotFound))
+	assert.False(t, errors.Is(sut, errs.ErrValidation))
+}
+
+func TestErrs_As_OnWrappedError_ReturnsKindAndCode(t *testing.T) {
+	wrapped := fmt.Errorf("checkout: %w", errs.Unavailable("PAYMENT_PROVIDER_TIMEOUT", "payment provider timeout"))
+
+	var sut *errs.Error
+	assert.True(t, errors.As(wrapped, &sut))
+	assert.Equal(t, errs.KindUnavailable, sut.Kind)
+	assert.Equal(t, "PAYMENT_PROVIDER_TIMEOUT", sut.Code)
+	assert.True(t, errors.Is(wrapped, errs.ErrUnavailable))
+}
+
+func TestErrs_Is_OnPaymentRequired_MatchesPaymentRequiredKind(t *testing.T) {
+	sut := errs.PaymentRequired("PAYMENT_DECLINED", "payment declined")
+
+	assert.True(t, errors.Is(sut, errs.ErrPaymentRequired))
+	assert.False(t, errors.Is(sut, errs.ErrConflict))
+}
@@ -0,0 +1,101 @@
+package address
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrAddressBookFull = errs.Conflict("ADDRESS_BOOK_FULL", "address book is full")
+	ErrAddressNotFound = errs.NotFound("ADDRESS_NOT_FOUND", "address not found")
+)
+
+const MaxAddresses = 10
+
+type AddressBook struct {
+	CustomerId uuid.UUID
+	Addresses  []Address
+}
+
+func NewAddressBook(customerId uuid.UUID) AddressBook {
+	return AddressBook{
+		CustomerId: customerId,
+		Addresses:  []Address{},
+	}
+}
+
+func (b *AddressBook) Add(address Address) error {
+	if len(b.Addresses) >= MaxAddresses {
+		return ErrAddressBookFull
+	}
+
+	address.DefaultShipping = len(b.Addresses) == 0
+	address.DefaultBilling = len(b.Addresses) == 0
+	b.Addresses = append(b.Addresses, address)
+	return nil
+}
+
+func (b *AddressBook) Find(addressId uuid.UUID) *Address {
+	for i := range b.Addresses {
+		if b.Addresses[i].Id == addressId {
+			return &b.Addresses[i]
+		}
+	}
+
+	return nil
+}
+
+func (b *AddressBook) Remove(addressId uuid.UUID) error {
+	for i, address := range b.Addresses {
+		if address.Id != addressId {
+			continue
+		}
+
+		b.Addresses = append(b.Addresses[:i], b.Addresses[i+1:]...)
+		if len(b.Addresses) > 0 && address.DefaultShipping {
+			b.Addresses[0].DefaultShipping = true
+		}
+
+		if len(b.Addresses) > 0 && address.DefaultBilling {
+			b.Addresses[0].DefaultBilling = true
+		}
+
+		return nil
+	}
+
+	return ErrAddressNotFound
+}
+
+func (b *AddressBook) SetDefaultShipping(addressId uuid.UUID) error {
+	if b.Find(addressId) == nil {
+		return ErrAddressNotFound
+	}
+
+	for i := range b.Addresses {
+		b.Addresses[i].DefaultShipping = b.Addresses[i].Id == addressId
+	}
+
+	return nil
+}
+
+func (b *AddressBook) SetDefaultBilling(addressId uuid.UUID) error {
+	if b.Find(addressId) == nil {
+		return ErrAddressNotFound
+	}
+
+	for i := range b.Addresses {
+		b.Addresses[i].DefaultBilling = b.Addresses[i].Id == addressId
+	}
+
+	return nil
+}
+
+func (b *AddressBook) DefaultShipping() *Address {
+	for i := range b.Addresses {
+		if b.Addresses[i].DefaultShipping {
+			return &b.Addresses[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestAddress(t *testing.T, line1 string) address.Address {
+	newAddress, err := address.NewAddress("John Doe", line1, "", "São Paulo", "SP", "01310-100", "BR")
+	assert.NoError(t, err)
+	return newAddress
+}
+
+func TestAddressBook_Add_OnFirstAddress_MakesItTheDefault(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	first := newTestAddress(t, "Av. Paulista, 1000")
+	second := newTestAddress(t, "Rua Augusta, 500")
+
+	assert.NoError(t, sut.Add(first))
+	assert.NoError(t, sut.Add(second))
+
+	assert.Equal(t, true, sut.Addresses[0].DefaultShipping)
+	assert.Equal(t, true, sut.Addresses[0].DefaultBilling)
+	assert.Equal(t, false, sut.Addresses[1].DefaultShipping)
+	assert.Equal(t, false, sut.Addresses[1].DefaultBilling)
+}
+
+func TestAddressBook_Add_OnFullBook_ReturnsError(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	for i := 0; i < address.MaxAddresses; i++ {
+		assert.NoError(t, sut.Add(newTestAddress(t, "Av. Paulista, 1000")))
+	}
+
+	err := sut.Add(newTestAddress(t, "Rua Augusta, 500"))
+
+	assert.EqualError(t, err, "address book is full")
+	assert.Len(t, sut.Addresses, address.MaxAddresses)
+}
+
+func TestAddressBook_SetDefaultShipping_OnExistingAddress_MovesTheDefault(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	first := newTestAddress(t, "Av. Paulista, 1000")
+	second := newTestAddress(t, "Rua Augusta, 500")
+	sut.Add(first)
+	sut.Add(second)
+
+	err := sut.SetDefaultShipping(second.Id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, second.Id, sut.DefaultShipping().Id)
+	assert.Equal(t, false, sut.Addresses[0].DefaultShipping)
+	assert.Equal(t, true, sut.Addresses[0].DefaultBilling)
+}
+
+func TestAddressBook_SetDefaultBilling_OnExistingAddress_MovesTheDefault(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	first := newTestAddress(t, "Av. Paulista, 1000")
+	second := newTestAddress(t, "Rua Augusta, 500")
+	sut.Add(first)
+	sut.Add(second)
+
+	err := sut.SetDefaultBilling(second.Id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, false, sut.Addresses[0].DefaultBilling)
+	assert.Equal(t, true, sut.Addresses[1].DefaultBilling)
+	assert.Equal(t, first.Id, sut.DefaultShipping().Id)
+}
+
+func TestAddressBook_SetDefaultShipping_OnUnknownAddress_ReturnsError(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	sut.Add(newTestAddress(t, "Av. Paulista, 1000"))
+
+	err := sut.SetDefaultShipping(uuid.New())
+
+	assert.EqualError(t, err, "address not found")
+}
+
+func TestAddressBook_Remove_OnDefaultAddress_PromotesTheNextOne(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	first := newTestAddress(t, "Av. Paulista, 1000")
+	second := newTestAddress(t, "Rua Augusta, 500")
+	sut.Add(first)
+	sut.Add(second)
+
+	err := sut.Remove(first.Id)
+
+	assert.NoError(t, err)
+	assert.Len(t, sut.Addresses, 1)
+	assert.Equal(t, second.Id, sut.Addresses[0].Id)
+	assert.Equal(t, true, sut.Addresses[0].DefaultShipping)
+	assert.Equal(t, true, sut.Addresses[0].DefaultBilling)
+}
+
+func TestAddressBook_Remove_OnLastAddress_LeavesNoDefault(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+	first := newTestAddress(t, "Av. Paulista, 1000")
+	sut.Add(first)
+
+	err := sut.Remove(first.Id)
+
+	assert.NoError(t, err)
+	assert.Len(t, sut.Addresses, 0)
+	assert.Nil(t, sut.DefaultShipping())
+}
+
+func TestAddressBook_Remove_OnUnknownAddress_ReturnsError(t *testing.T) {
+	sut := address.NewAddressBook(uuid.New())
+
+	err := sut.Remove(uuid.New())
+
+	assert.EqualError(t, err, "address not found")
+}
@@ -0,0 +1,76 @@
+package address
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrAddressRecipientNameEmpty = errs.Validation("ADDRESS_RECIPIENT_NAME_EMPTY", "address recipient name cannot be empty")
+	ErrAddressLineEmpty          = errs.Validation("ADDRESS_LINE_EMPTY", "address line cannot be empty")
+	ErrAddressCityEmpty          = errs.Validation("ADDRESS_CITY_EMPTY", "address city cannot be empty")
+	ErrAddressRegionEmpty        = errs.Validation("ADDRESS_REGION_EMPTY", "address region cannot be empty")
+)
+
+type Address struct {
+	Id              uuid.UUID
+	RecipientName   string
+	Line1           string
+	Line2           string
+	City            string
+	Region          string
+	PostalCode      string
+	Country         string
+	DefaultShipping bool
+	DefaultBilling  bool
+}
+
+func NewAddress(recipientName string, line1 string, line2 string, city string, region string, postalCode string,
+	country string) (Address, error) {
+	address := Address{
+		Id: uuid.New(),
+	}
+
+	err := address.Update(recipientName, line1, line2, city, region, postalCode, country)
+	if err != nil {
+		return Address{}, err
+	}
+
+	return address, nil
+}
+
+func (a *Address) Update(recipientName string, line1 string, line2 string, city string, region string, postalCode string,
+	country string) error {
+	if strings.TrimSpace(recipientName) == "" {
+		return ErrAddressRecipientNameEmpty
+	}
+
+	if strings.TrimSpace(line1) == "" {
+		return ErrAddressLineEmpty
+	}
+
+	if strings.TrimSpace(city) == "" {
+		return ErrAddressCityEmpty
+	}
+
+	country = strings.ToUpper(strings.TrimSpace(country))
+	normalizedPostalCode, err := NormalizePostalCode(country, postalCode)
+	if err != nil {
+		return err
+	}
+
+	if countryFormats[country].regionRequired && strings.TrimSpace(region) == "" {
+		return ErrAddressRegionEmpty
+	}
+
+	a.RecipientName = strings.TrimSpace(recipientName)
+	a.Line1 = strings.TrimSpace(line1)
+	a.Line2 = strings.TrimSpace(line2)
+	a.City = strings.TrimSpace(city)
+	a.Region = strings.ToUpper(strings.TrimSpace(region))
+	a.PostalCode = normalizedPostalCode
+	a.Country = country
+	return nil
+}
@@ -0,0 +1,84 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_NewAddress_OnValidBrazilianAddress_ReturnsNormalizedAddress(t *testing.T) {
+	sut, err := address.NewAddress(" John Doe ", " Av. Paulista, 1000 ", " Apt 12 ", " São Paulo ", " sp ", "01310100", " br ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", sut.RecipientName)
+	assert.Equal(t, "Av. Paulista, 1000", sut.Line1)
+	assert.Equal(t, "Apt 12", sut.Line2)
+	assert.Equal(t, "São Paulo", sut.City)
+	assert.Equal(t, "SP", sut.Region)
+	assert.Equal(t, "01310-100", sut.PostalCode)
+	assert.Equal(t, "BR", sut.Country)
+	assert.Equal(t, false, sut.DefaultShipping)
+	assert.Equal(t, false, sut.DefaultBilling)
+}
+
+func TestAddress_NewAddress_OnCanadianPostalCodeWithoutSpace_ReturnsNormalizedPostalCode(t *testing.T) {
+	sut, err := address.NewAddress("John Doe", "100 Queen St W", "", "Toronto", "ON", "m5h2n2", "CA")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "M5H 2N2", sut.PostalCode)
+}
+
+func TestAddress_NewAddress_OnCountryWithoutRegion_ReturnsAddress(t *testing.T) {
+	sut, err := address.NewAddress("John Doe", "Unter den Linden 1", "", "Berlin", "", "10117", "DE")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", sut.Region)
+	assert.Equal(t, "10117", sut.PostalCode)
+}
+
+func TestAddress_NewAddress_OnEmptyRecipientName_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress(" ", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+
+	assert.EqualError(t, err, "address recipient name cannot be empty")
+}
+
+func TestAddress_NewAddress_OnEmptyLine_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress("John Doe", " ", "", "São Paulo", "SP", "01310-100", "BR")
+
+	assert.EqualError(t, err, "address line cannot be empty")
+}
+
+func TestAddress_NewAddress_OnEmptyCity_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "", "SP", "01310-100", "BR")
+
+	assert.EqualError(t, err, "address city cannot be empty")
+}
+
+func TestAddress_NewAddress_OnUnsupportedCountry_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress("John Doe", "1 Main St", "", "Tokyo", "13", "100-0001", "JP")
+
+	assert.EqualError(t, err, "address country is not supported")
+}
+
+func TestAddress_NewAddress_OnInvalidPostalCode_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress("John Doe", "1 Main St", "", "Springfield", "IL", "6270", "US")
+
+	assert.EqualError(t, err, "address postal code is invalid")
+}
+
+func TestAddress_NewAddress_OnMissingRequiredRegion_ReturnsError(t *testing.T) {
+	_, err := address.NewAddress("John Doe", "1 Main St", "", "Springfield", " ", "62701-1234", "US")
+
+	assert.EqualError(t, err, "address region cannot be empty")
+}
+
+func TestAddress_Update_OnInvalidValues_KeepsAddressUnchanged(t *testing.T) {
+	sut, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+
+	err := sut.Update("Jane Doe", "Rua Augusta, 500", "", "São Paulo", "SP", "ABC", "BR")
+
+	assert.EqualError(t, err, "address postal code is invalid")
+	assert.Equal(t, "John Doe", sut.RecipientName)
+	assert.Equal(t, "Av. Paulista, 1000", sut.Line1)
+}
@@ -0,0 +1,66 @@
+package address
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrAddressCountryNotSupported = errs.Validation("ADDRESS_COUNTRY_NOT_SUPPORTED", "address country is not supported")
+	ErrAddressPostalCodeInvalid   = errs.Validation("ADDRESS_POSTAL_CODE_INVALID", "address postal code is invalid")
+)
+
+type countryFormat struct {
+	postalCodePattern *regexp.Regexp
+	regionRequired    bool
+	normalize         func(postalCode string) string
+}
+
+var countryFormats = map[string]countryFormat{
+	"BR": {
+		postalCodePattern: regexp.MustCompile(`^\d{5}-?\d{3}$`),
+		regionRequired:    true,
+		normalize: func(postalCode string) string {
+			digits := strings.ReplaceAll(postalCode, "-", "")
+			return digits[:5] + "-" + digits[5:]
+		},
+	},
+	"US": {
+		postalCodePattern: regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		regionRequired:    true,
+	},
+	"CA": {
+		postalCodePattern: regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+		regionRequired:    true,
+		normalize: func(postalCode string) string {
+			compact := strings.ReplaceAll(postalCode, " ", "")
+			return compact[:3] + " " + compact[3:]
+		},
+	},
+	"PT": {
+		postalCodePattern: regexp.MustCompile(`^\d{4}-\d{3}$`),
+	},
+	"DE": {
+		postalCodePattern: regexp.MustCompile(`^\d{5}$`),
+	},
+}
+
+func NormalizePostalCode(country string, postalCode string) (string, error) {
+	format, ok := countryFormats[strings.ToUpper(strings.TrimSpace(country))]
+	if !ok {
+		return "", ErrAddressCountryNotSupported
+	}
+
+	postalCode = strings.ToUpper(strings.TrimSpace(postalCode))
+	if !format.postalCodePattern.MatchString(postalCode) {
+		return "", ErrAddressPostalCodeInvalid
+	}
+
+	if format.normalize != nil {
+		return format.normalize(postalCode), nil
+	}
+
+	return postalCode, nil
+}
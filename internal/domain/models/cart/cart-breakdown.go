@@ -0,0 +1,41 @@
+package cart
+
+import "github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+
+type CartBreakdown struct {
+	Subtotal     models.Money
+	Discount     models.Money
+	Total        models.Money
+	FreeShipping bool
+}
+
+func (c *Cart) Breakdown(discount models.Money, freeShipping bool) (CartBreakdown, error) {
+	subtotal, err := c.TotalPrice()
+	if err != nil {
+		return CartBreakdown{}, err
+	}
+
+	if discount.Value <= 0 {
+		discount = models.Money{Value: 0, Currency: subtotal.Currency}
+	}
+
+	if discount.Currency != subtotal.Currency {
+		return CartBreakdown{}, models.ErrMoneyCurrencyMismatch
+	}
+
+	if discount.Value > subtotal.Value {
+		discount = subtotal
+	}
+
+	total, err := subtotal.Subtract(discount)
+	if err != nil {
+		return CartBreakdown{}, err
+	}
+
+	return CartBreakdown{
+		Subtotal:     subtotal,
+		Discount:     discount,
+		Total:        total,
+		FreeShipping: freeShipping,
+	}, nil
+}
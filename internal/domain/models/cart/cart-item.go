@@ -1,12 +1,13 @@
 package cart
 
 import (
-	"errors"
-
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
 )
 
+var ErrCartItemQuantityBelowOne = errs.Validation("CART_ITEM_QUANTITY_BELOW_ONE", "cart item quantity cannot be less than one")
+
 type CartItem struct {
 	Id        uuid.UUID
 	ProductId uuid.UUID
@@ -14,25 +15,26 @@ type CartItem struct {
 	Price     models.Money
 }
 
-func NewCartItem(productId uuid.UUID, quantity int32, price int64) (CartItem, error) {
+func NewCartItem(productId uuid.UUID, quantity int32, price int64, currency string) (CartItem, error) {
 	if _, err := models.NewQuantity(quantity); err != nil {
 		return CartItem{}, err
 	}
 
-	if _, err := models.NewMoney(price); err != nil {
+	money, err := models.NewMoney(price, currency)
+	if err != nil {
 		return CartItem{}, err
 	}
 
 	MINIMUM_QUANTITY := int32(1)
 	if quantity < MINIMUM_QUANTITY {
-		return CartItem{}, errors.New("cart item quantity cannot be less than one")
+		return CartItem{}, ErrCartItemQuantityBelowOne
 	}
 
 	return CartItem{
 		Id:        uuid.New(),
 		ProductId: productId,
 		Quantity:  models.Quantity{Value: quantity},
-		Price:     models.Money{Value: price},
+		Price:     money,
 	}, nil
 }
 
@@ -43,7 +45,7 @@ func (c *CartItem) IncreaseQuantity(quantity int32) error {
 
 	MINIMUM_QUANTITY := int32(1)
 	if quantity < MINIMUM_QUANTITY {
-		return errors.New("cart item quantity cannot be less than one")
+		return ErrCartItemQuantityBelowOne
 	}
 
 	c.Quantity = models.Quantity{Value: c.Quantity.Value + quantity}
@@ -57,13 +59,13 @@ func (c *CartItem) DecreaseQuantity(quantity int32) error {
 
 	MINIMUM_QUANTITY := int32(1)
 	if quantity < MINIMUM_QUANTITY {
-		return errors.New("cart item quantity cannot be less than one")
+		return ErrCartItemQuantityBelowOne
 	}
 
 	difference := c.Quantity.Value - quantity
 	if difference < 0 {
 		c.Quantity = models.Quantity{Value: 0}
-		c.Price = models.Money{Value: 0}
+		c.Price = models.Money{Value: 0, Currency: c.Price.Currency}
 		return nil
 	}
 
@@ -71,8 +73,6 @@ func (c *CartItem) DecreaseQuantity(quantity int32) error {
 	return nil
 }
 
-func (c *CartItem) TotalPrice() models.Money {
-	return models.Money{
-		Value: c.Price.Value * int64(c.Quantity.Value),
-	}
+func (c *CartItem) TotalPrice() (models.Money, error) {
+	return c.Price.Multiply(int64(c.Quantity.Value))
 }
@@ -11,18 +11,19 @@ import (
 func TestCartItem_NewCartItem_OnValidValues_ReturnsCartItem(t *testing.T) {
 	productId := uuid.New()
 
-	sut, err := cart.NewCartItem(productId, 2, 2550)
+	sut, err := cart.NewCartItem(productId, 2, 2550, "BRL")
 
 	assert.NoError(t, err)
 	assert.Equal(t, productId, sut.ProductId)
 	assert.Equal(t, int64(2550), sut.Price.Value)
 	assert.Equal(t, int32(2), sut.Quantity.Value)
-	assert.Equal(t, int64(5100), sut.TotalPrice().Value)
+	totalPrice, _ := sut.TotalPrice()
+	assert.Equal(t, int64(5100), totalPrice.Value)
 }
 
 func TestCartItem_IncreaseQuantity_OnValidValues_UpdatesCartItem(t *testing.T) {
 	productId := uuid.New()
-	cart, _ := cart.NewCartItem(productId, 5, 2550)
+	cart, _ := cart.NewCartItem(productId, 5, 2550, "BRL")
 
 	cart.IncreaseQuantity(12)
 	cart.IncreaseQuantity(3)
@@ -30,12 +31,13 @@ func TestCartItem_IncreaseQuantity_OnValidValues_UpdatesCartItem(t *testing.T) {
 	assert.Equal(t, productId, cart.ProductId)
 	assert.Equal(t, int64(2550), cart.Price.Value)
 	assert.Equal(t, int32(20), cart.Quantity.Value)
-	assert.Equal(t, int64(51000), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(51000), totalPrice.Value)
 }
 
 func TestCartItem_DecreaseQuantity_OnValidValues_UpdatesCartItem(t *testing.T) {
 	productId := uuid.New()
-	cart, _ := cart.NewCartItem(productId, 5, 2550)
+	cart, _ := cart.NewCartItem(productId, 5, 2550, "BRL")
 
 	cart.IncreaseQuantity(12)
 	cart.IncreaseQuantity(3)
@@ -48,41 +50,43 @@ func TestCartItem_DecreaseQuantity_OnValidValues_UpdatesCartItem(t *testing.T) {
 	assert.Equal(t, productId, cart.ProductId)
 	assert.Equal(t, int64(2550), cart.Price.Value)
 	assert.Equal(t, int32(25), cart.Quantity.Value)
-	assert.Equal(t, int64(63750), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(63750), totalPrice.Value)
 }
 
 func TestCartItem_DecreaseQuantity_OnDecreaseMoreThanCurrentQuantity_UpdatesQuantityToZero(t *testing.T) {
 	productId := uuid.New()
-	cart, _ := cart.NewCartItem(productId, 5, 2500)
+	cart, _ := cart.NewCartItem(productId, 5, 2500, "BRL")
 
 	cart.DecreaseQuantity(10)
 
 	assert.Equal(t, productId, cart.ProductId)
 	assert.Equal(t, int64(0), cart.Price.Value)
 	assert.Equal(t, int32(0), cart.Quantity.Value)
-	assert.Equal(t, int64(0), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(0), totalPrice.Value)
 }
 
 func TestCartItem_NewCartItem_OnQuantityEqualsZero_ReturnsError(t *testing.T) {
-	_, err := cart.NewCartItem(uuid.New(), 0, 2550)
+	_, err := cart.NewCartItem(uuid.New(), 0, 2550, "BRL")
 
 	assert.EqualError(t, err, "cart item quantity cannot be less than one")
 }
 
 func TestCartItem_NewCartItem_OnNegativeQuantity_ReturnsError(t *testing.T) {
-	_, err := cart.NewCartItem(uuid.New(), -1, 2550)
+	_, err := cart.NewCartItem(uuid.New(), -1, 2550, "BRL")
 
 	assert.EqualError(t, err, "quantity value cannot be negative")
 }
 
 func TestCartItem_NewCartItem_OnNegativePrice_ReturnsError(t *testing.T) {
-	_, err := cart.NewCartItem(uuid.New(), 1, -500)
+	_, err := cart.NewCartItem(uuid.New(), 1, -500, "BRL")
 
 	assert.EqualError(t, err, "money value cannot be negative")
 }
 
 func TestCartItem_IncreaseQuantity_OnQuantityEqualsZero_ReturnsError(t *testing.T) {
-	cart, _ := cart.NewCartItem(uuid.New(), 0, 2500)
+	cart, _ := cart.NewCartItem(uuid.New(), 0, 2500, "BRL")
 
 	err := cart.IncreaseQuantity(0)
 
@@ -91,7 +95,7 @@ func TestCartItem_IncreaseQuantity_OnQuantityEqualsZero_ReturnsError(t *testing.
 
 func TestCartItem_IncreaseQuantity_OnNegativeQuantity_ReturnsError(t *testing.T) {
 	productId := uuid.New()
-	cart, _ := cart.NewCartItem(productId, 5, 2550)
+	cart, _ := cart.NewCartItem(productId, 5, 2550, "BRL")
 
 	err := cart.IncreaseQuantity(-4)
 
@@ -100,7 +104,7 @@ func TestCartItem_IncreaseQuantity_OnNegativeQuantity_ReturnsError(t *testing.T)
 
 func TestCartItem_DecreaseQuantity_OnNegativeQuantity_ReturnsError(t *testing.T) {
 	productId := uuid.New()
-	cart, _ := cart.NewCartItem(productId, 5, 2550)
+	cart, _ := cart.NewCartItem(productId, 5, 2550, "BRL")
 
 	err := cart.DecreaseQuantity(-4)
 
@@ -108,7 +112,7 @@ func TestCartItem_DecreaseQuantity_OnNegativeQuantity_ReturnsError(t *testing.T)
 }
 
 func TestCartItem_DecreaseQuantity_OnQuantityLessThanOne_ReturnsError(t *testing.T) {
-	cart, _ := cart.NewCartItem(uuid.New(), 0, 2500)
+	cart, _ := cart.NewCartItem(uuid.New(), 0, 2500, "BRL")
 
 	err := cart.DecreaseQuantity(0)
 
@@ -1,16 +1,30 @@
 package cart
 
 import (
-	"errors"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+)
+
+var (
+	ErrCartEmpty               = errs.Conflict("CART_EMPTY", "cart is empty")
+	ErrCartCannotMixCurrencies = errs.Conflict("CART_CANNOT_MIX_CURRENCIES", "cart cannot mix currencies")
+	ErrCartHasNoCoupon         = errs.Conflict("CART_HAS_NO_COUPON", "cart has no coupon")
+	ErrCouponCodeEmpty         = errs.Validation("COUPON_CODE_EMPTY", "coupon code cannot be empty")
+	ErrProductNotInCart        = errs.NotFound("PRODUCT_NOT_IN_CART", "product not found in cart")
 )
 
 type Cart struct {
-	Id         uuid.UUID
-	CustomerId uuid.UUID
-	Items      []CartItem
+	Id                 uuid.UUID
+	CustomerId         uuid.UUID
+	Items              []CartItem
+	CouponCode         string
+	ShippingMethodCode string
+	Version            int32
 }
 
 func NewCart(customerId uuid.UUID) (Cart, error) {
@@ -21,15 +35,33 @@ func NewCart(customerId uuid.UUID) (Cart, error) {
 	}, nil
 }
 
-func (c *Cart) AddItem(productId uuid.UUID, quantity int32, price int64) error {
+func NewGuestCart() Cart {
+	return Cart{
+		Id:    uuid.New(),
+		Items: []CartItem{},
+	}
+}
+
+func (c *Cart) IsGuest() bool {
+	return c.CustomerId == uuid.Nil
+}
+
+func (c *Cart) AddItem(productId uuid.UUID, quantity int32, price int64, currency string) error {
 	if _, err := models.NewQuantity(quantity); err != nil {
 		return err
 	}
 
-	if _, err := models.NewMoney(price); err != nil {
+	money, err := models.NewMoney(price, currency)
+	if err != nil {
 		return err
 	}
 
+	for _, item := range c.Items {
+		if item.Price.Currency != money.Currency {
+			return ErrCartCannotMixCurrencies
+		}
+	}
+
 	for i, item := range c.Items {
 		if item.ProductId == productId {
 			c.Items[i].IncreaseQuantity(quantity)
@@ -37,7 +69,7 @@ func (c *Cart) AddItem(productId uuid.UUID, quantity int32, price int64) error {
 		}
 	}
 
-	cartItem, err := NewCartItem(productId, quantity, price)
+	cartItem, err := NewCartItem(productId, quantity, price, currency)
 
 	if err != nil {
 		return err
@@ -47,9 +79,24 @@ func (c *Cart) AddItem(productId uuid.UUID, quantity int32, price int64) error {
 	return nil
 }
 
+func (c *Cart) AddItemWithinStock(productId uuid.UUID, quantity int32, price int64, currency string, available int32) error {
+	quantityInCart := int32(0)
+	for _, item := range c.Items {
+		if item.ProductId == productId {
+			quantityInCart = item.Quantity.Value
+		}
+	}
+
+	if quantityInCart+quantity > available {
+		return inventory.ErrInsufficientStock
+	}
+
+	return c.AddItem(productId, quantity, price, currency)
+}
+
 func (c *Cart) RemoveItem(productId uuid.UUID) error {
 	if len(c.Items) == 0 {
-		return errors.New("cart is empty")
+		return ErrCartEmpty
 	}
 
 	for i, item := range c.Items {
@@ -59,7 +106,114 @@ func (c *Cart) RemoveItem(productId uuid.UUID) error {
 		}
 	}
 
-	return errors.New("product not found in cart")
+	return ErrProductNotInCart
+}
+
+func (c *Cart) SetItemQuantity(productId uuid.UUID, quantity int32) error {
+	if _, err := models.NewQuantity(quantity); err != nil {
+		return err
+	}
+
+	if len(c.Items) == 0 {
+		return ErrCartEmpty
+	}
+
+	for i, item := range c.Items {
+		if item.ProductId == productId {
+			if quantity == 0 {
+				return c.RemoveItem(productId)
+			}
+
+			difference := quantity - item.Quantity.Value
+			if difference > 0 {
+				return c.Items[i].IncreaseQuantity(difference)
+			}
+
+			if difference < 0 {
+				return c.Items[i].DecreaseQuantity(-difference)
+			}
+
+			return nil
+		}
+	}
+
+	return ErrProductNotInCart
+}
+
+func (c *Cart) SetItemQuantityWithinStock(productId uuid.UUID, quantity int32, available int32) error {
+	for _, item := range c.Items {
+		if item.ProductId == productId && quantity > item.Quantity.Value && quantity > available {
+			return inventory.ErrInsufficientStock
+		}
+	}
+
+	return c.SetItemQuantity(productId, quantity)
+}
+
+func (c *Cart) ApplyCoupon(code string) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return ErrCouponCodeEmpty
+	}
+
+	if len(c.Items) == 0 {
+		return ErrCartEmpty
+	}
+
+	c.CouponCode = code
+	return nil
+}
+
+func (c *Cart) RemoveCoupon() error {
+	if c.CouponCode == "" {
+		return ErrCartHasNoCoupon
+	}
+
+	c.CouponCode = ""
+	return nil
+}
+
+func (c *Cart) SelectShippingMethod(code string) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return shipping.ErrShippingMethodCodeEmpty
+	}
+
+	if len(c.Items) == 0 {
+		return ErrCartEmpty
+	}
+
+	c.ShippingMethodCode = code
+	return nil
+}
+
+func (c *Cart) Merge(guestCart Cart) error {
+	merged := *c
+	merged.Items = append([]CartItem{}, c.Items...)
+
+	for _, item := range guestCart.Items {
+		if item.Quantity.Value == 0 {
+			continue
+		}
+
+		err := merged.AddItem(item.ProductId, item.Quantity.Value, item.Price.Value, item.Price.Currency.Code)
+		if err != nil {
+			return err
+		}
+	}
+
+	if merged.CouponCode == "" {
+		merged.CouponCode = guestCart.CouponCode
+	}
+
+	*c = merged
+	return nil
+}
+
+func (c *Cart) Clear() {
+	c.Items = []CartItem{}
+	c.CouponCode = ""
+	c.ShippingMethodCode = ""
 }
 
 func (c *Cart) TotalQuantity() models.Quantity {
@@ -74,14 +228,28 @@ func (c *Cart) TotalQuantity() models.Quantity {
 	}
 }
 
-func (c *Cart) TotalPrice() models.Money {
-	totalPrice := int64(0)
+func (c *Cart) Currency() models.Currency {
+	if len(c.Items) == 0 {
+		return models.Currency{}
+	}
+
+	return c.Items[0].Price.Currency
+}
+
+func (c *Cart) TotalPrice() (models.Money, error) {
+	totalPrice := models.Money{Value: 0, Currency: c.Currency()}
 
 	for _, item := range c.Items {
-		totalPrice += item.TotalPrice().Value
-	}
+		itemTotalPrice, err := item.TotalPrice()
+		if err != nil {
+			return models.Money{}, err
+		}
 
-	return models.Money{
-		Value: totalPrice,
+		totalPrice, err = totalPrice.Add(itemTotalPrice)
+		if err != nil {
+			return models.Money{}, err
+		}
 	}
+
+	return totalPrice, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,12 +24,13 @@ func TestCart_AddItem_OnAddingSameProduct_UpdatesCart(t *testing.T) {
 	product1 := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	cart.AddItem(product1, 2, 32000)
-	cart.AddItem(product1, 5, 32000)
+	cart.AddItem(product1, 2, 32000, "BRL")
+	cart.AddItem(product1, 5, 32000, "BRL")
 
 	assert.Equal(t, int(1), len(cart.Items))
 	assert.Equal(t, int32(7), cart.TotalQuantity().Value)
-	assert.Equal(t, int64(224000), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(224000), totalPrice.Value)
 }
 
 func TestCart_AddItem_OnAddingDifferentProducts_UpdatesCart(t *testing.T) {
@@ -38,15 +40,16 @@ func TestCart_AddItem_OnAddingDifferentProducts_UpdatesCart(t *testing.T) {
 	product3 := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	cart.AddItem(product1, 2, 32000)
-	cart.AddItem(product2, 5, 17340)
-	cart.AddItem(product2, 1, 17340)
-	cart.AddItem(product2, 4, 17340)
-	cart.AddItem(product3, 9, 1550)
+	cart.AddItem(product1, 2, 32000, "BRL")
+	cart.AddItem(product2, 5, 17340, "BRL")
+	cart.AddItem(product2, 1, 17340, "BRL")
+	cart.AddItem(product2, 4, 17340, "BRL")
+	cart.AddItem(product3, 9, 1550, "BRL")
 
 	assert.Equal(t, int(3), len(cart.Items))
 	assert.Equal(t, int32(21), cart.TotalQuantity().Value)
-	assert.Equal(t, int64(251350), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(251350), totalPrice.Value)
 }
 
 func TestCart_RemoveItem_OnAddingAndRemovingSameProduct_UpdatesCart(t *testing.T) {
@@ -54,12 +57,13 @@ func TestCart_RemoveItem_OnAddingAndRemovingSameProduct_UpdatesCart(t *testing.T
 	product1 := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	cart.AddItem(product1, 2, 32000)
+	cart.AddItem(product1, 2, 32000, "BRL")
 	cart.RemoveItem(product1)
 
 	assert.Equal(t, int(0), len(cart.Items))
 	assert.Equal(t, int32(0), cart.TotalQuantity().Value)
-	assert.Equal(t, int64(0), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(0), totalPrice.Value)
 }
 
 func TestCart_RemoveItem_OnAddingAndRemovingDifferentProducts_UpdatesCart(t *testing.T) {
@@ -69,23 +73,24 @@ func TestCart_RemoveItem_OnAddingAndRemovingDifferentProducts_UpdatesCart(t *tes
 	product3 := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	cart.AddItem(product1, 2, 32000)
-	cart.AddItem(product2, 5, 17340)
-	cart.AddItem(product2, 1, 17340)
-	cart.AddItem(product2, 4, 17340)
-	cart.AddItem(product3, 9, 1550)
+	cart.AddItem(product1, 2, 32000, "BRL")
+	cart.AddItem(product2, 5, 17340, "BRL")
+	cart.AddItem(product2, 1, 17340, "BRL")
+	cart.AddItem(product2, 4, 17340, "BRL")
+	cart.AddItem(product3, 9, 1550, "BRL")
 	cart.RemoveItem(product2)
 
 	assert.Equal(t, int(2), len(cart.Items))
 	assert.Equal(t, int32(11), cart.TotalQuantity().Value)
-	assert.Equal(t, int64(77950), cart.TotalPrice().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(77950), totalPrice.Value)
 }
 
 func TestCart_AddItem_OnNegativeQuantity_ReturnsError(t *testing.T) {
 	customerId := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	err := cart.AddItem(uuid.New(), -2, 32000)
+	err := cart.AddItem(uuid.New(), -2, 32000, "BRL")
 
 	assert.EqualError(t, err, "quantity value cannot be negative")
 }
@@ -94,7 +99,7 @@ func TestCart_AddItem_OnQuantityEqualsZero_ReturnsError(t *testing.T) {
 	customerId := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	err := cart.AddItem(uuid.New(), 0, 32000)
+	err := cart.AddItem(uuid.New(), 0, 32000, "BRL")
 
 	assert.EqualError(t, err, "cart item quantity cannot be less than one")
 }
@@ -103,7 +108,7 @@ func TestCart_AddItem_OnNegativePrice_ReturnsError(t *testing.T) {
 	customerId := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	err := cart.AddItem(uuid.New(), 2, -550)
+	err := cart.AddItem(uuid.New(), 2, -550, "BRL")
 
 	assert.EqualError(t, err, "money value cannot be negative")
 }
@@ -114,7 +119,7 @@ func TestCart_RemoveItem_OnProdutNotInCart_ReturnsError(t *testing.T) {
 	product2 := uuid.New()
 	cart, _ := cart.NewCart(customerId)
 
-	cart.AddItem(product1, 2, 32000)
+	cart.AddItem(product1, 2, 32000, "BRL")
 	err := cart.RemoveItem(product2)
 
 	assert.EqualError(t, err, "product not found in cart")
@@ -127,3 +132,340 @@ func TestCart_RemoveItem_OnCartEmpty_ReturnsError(t *testing.T) {
 
 	assert.EqualError(t, err, "cart is empty")
 }
+
+func TestCart_SetItemQuantity_OnLowerQuantity_UpdatesCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 5, 32000, "BRL")
+	err := cart.SetItemQuantity(product1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int(1), len(cart.Items))
+	assert.Equal(t, int32(2), cart.TotalQuantity().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(64000), totalPrice.Value)
+}
+
+func TestCart_SetItemQuantity_OnHigherQuantity_UpdatesCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 2, 32000, "BRL")
+	err := cart.SetItemQuantity(product1, 6)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int(1), len(cart.Items))
+	assert.Equal(t, int32(6), cart.TotalQuantity().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(192000), totalPrice.Value)
+}
+
+func TestCart_SetItemQuantity_OnSameQuantity_KeepsCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 3, 1550, "BRL")
+	err := cart.SetItemQuantity(product1, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), cart.TotalQuantity().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(4650), totalPrice.Value)
+}
+
+func TestCart_SetItemQuantity_OnZeroQuantity_RemovesItem(t *testing.T) {
+	product1 := uuid.New()
+	product2 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 5, 32000, "BRL")
+	cart.AddItem(product2, 1, 17340, "BRL")
+	err := cart.SetItemQuantity(product1, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int(1), len(cart.Items))
+	assert.Equal(t, product2, cart.Items[0].ProductId)
+	assert.Equal(t, int32(1), cart.TotalQuantity().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(17340), totalPrice.Value)
+}
+
+func TestCart_SetItemQuantity_OnNegativeQuantity_ReturnsError(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 5, 32000, "BRL")
+	err := cart.SetItemQuantity(product1, -1)
+
+	assert.EqualError(t, err, "quantity value cannot be negative")
+}
+
+func TestCart_SetItemQuantity_OnProductNotInCart_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(uuid.New(), 5, 32000, "BRL")
+	err := cart.SetItemQuantity(uuid.New(), 2)
+
+	assert.EqualError(t, err, "product not found in cart")
+}
+
+func TestCart_SetItemQuantity_OnCartEmpty_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	err := cart.SetItemQuantity(uuid.New(), 2)
+
+	assert.EqualError(t, err, "cart is empty")
+}
+
+func TestCart_Clear_OnCartWithItems_RemovesAllItems(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+	cart.AddItem(uuid.New(), 5, 17340, "BRL")
+	cart.Clear()
+
+	assert.Equal(t, int(0), len(cart.Items))
+	assert.Equal(t, int32(0), cart.TotalQuantity().Value)
+	totalPrice, _ := cart.TotalPrice()
+	assert.Equal(t, int64(0), totalPrice.Value)
+}
+
+func TestCart_AddItemWithinStock_OnQuantityWithinStock_UpdatesCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItemWithinStock(product1, 2, 32000, "BRL", 5)
+	err := cart.AddItemWithinStock(product1, 3, 32000, "BRL", 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), cart.TotalQuantity().Value)
+}
+
+func TestCart_AddItemWithinStock_OnQuantityExceedingStock_ReturnsError(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItemWithinStock(product1, 2, 32000, "BRL", 5)
+	err := cart.AddItemWithinStock(product1, 4, 32000, "BRL", 5)
+
+	assert.EqualError(t, err, "insufficient stock")
+	assert.Equal(t, int32(2), cart.TotalQuantity().Value)
+}
+
+func TestCart_SetItemQuantityWithinStock_OnQuantityWithinStock_UpdatesCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 2, 32000, "BRL")
+	err := cart.SetItemQuantityWithinStock(product1, 5, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), cart.TotalQuantity().Value)
+}
+
+func TestCart_SetItemQuantityWithinStock_OnQuantityExceedingStock_ReturnsError(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 2, 32000, "BRL")
+	err := cart.SetItemQuantityWithinStock(product1, 6, 5)
+
+	assert.EqualError(t, err, "insufficient stock")
+	assert.Equal(t, int32(2), cart.TotalQuantity().Value)
+}
+
+func TestCart_SetItemQuantityWithinStock_OnLowerQuantityAboveStock_UpdatesCart(t *testing.T) {
+	product1 := uuid.New()
+	cart, _ := cart.NewCart(uuid.New())
+
+	cart.AddItem(product1, 6, 32000, "BRL")
+	err := cart.SetItemQuantityWithinStock(product1, 4, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), cart.TotalQuantity().Value)
+}
+
+func TestCart_ApplyCoupon_OnCartWithItems_StoresNormalizedCode(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+
+	err := cart.ApplyCoupon(" summer10 ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SUMMER10", cart.CouponCode)
+}
+
+func TestCart_ApplyCoupon_OnEmptyCode_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+
+	err := cart.ApplyCoupon("  ")
+
+	assert.EqualError(t, err, "coupon code cannot be empty")
+}
+
+func TestCart_ApplyCoupon_OnCartEmpty_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	err := cart.ApplyCoupon("SUMMER10")
+
+	assert.EqualError(t, err, "cart is empty")
+}
+
+func TestCart_RemoveCoupon_OnNoCoupon_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	err := cart.RemoveCoupon()
+
+	assert.EqualError(t, err, "cart has no coupon")
+}
+
+func TestCart_SelectShippingMethod_OnCartWithItems_StoresNormalizedCode(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+
+	err := cart.SelectShippingMethod(" express ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EXPRESS", cart.ShippingMethodCode)
+}
+
+func TestCart_SelectShippingMethod_OnEmptyCode_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+
+	err := cart.SelectShippingMethod(" ")
+
+	assert.EqualError(t, err, "shipping method code cannot be empty")
+}
+
+func TestCart_SelectShippingMethod_OnCartEmpty_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	err := cart.SelectShippingMethod("EXPRESS")
+
+	assert.EqualError(t, err, "cart is empty")
+}
+
+func TestCart_Clear_OnSelectedShippingMethod_RemovesShippingMethod(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 32000, "BRL")
+	cart.SelectShippingMethod("EXPRESS")
+
+	cart.Clear()
+
+	assert.Equal(t, "", cart.ShippingMethodCode)
+}
+
+func TestCart_Breakdown_OnDiscount_SubtractsDiscountFromSubtotal(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 5000, "BRL")
+
+	sut, _ := cart.Breakdown(models.Money{Value: 1500, Currency: models.BRL}, true)
+
+	assert.Equal(t, int64(10000), sut.Subtotal.Value)
+	assert.Equal(t, int64(1500), sut.Discount.Value)
+	assert.Equal(t, int64(8500), sut.Total.Value)
+	assert.Equal(t, true, sut.FreeShipping)
+}
+
+func TestCart_Breakdown_OnDiscountGreaterThanSubtotal_CapsDiscount(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 1, 5000, "BRL")
+
+	sut, _ := cart.Breakdown(models.Money{Value: 8000, Currency: models.BRL}, false)
+
+	assert.Equal(t, int64(5000), sut.Discount.Value)
+	assert.Equal(t, int64(0), sut.Total.Value)
+}
+
+func TestCart_AddItem_OnDifferentCurrency_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 1, 5000, "BRL")
+
+	err := cart.AddItem(uuid.New(), 1, 1000, "USD")
+
+	assert.EqualError(t, err, "cart cannot mix currencies")
+	assert.Equal(t, int(1), len(cart.Items))
+}
+
+func TestCart_AddItem_OnUnsupportedCurrency_ReturnsError(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+
+	err := cart.AddItem(uuid.New(), 1, 5000, "XYZ")
+
+	assert.EqualError(t, err, "currency is not supported")
+}
+
+func TestCart_TotalPrice_OnItems_ReturnsTotalInCartCurrency(t *testing.T) {
+	cart, _ := cart.NewCart(uuid.New())
+	cart.AddItem(uuid.New(), 2, 1999, "EUR")
+	cart.AddItem(uuid.New(), 1, 500, "EUR")
+
+	sut, err := cart.TotalPrice()
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 4498, Currency: models.EUR}, sut)
+}
+
+func TestCart_NewGuestCart_OnCall_ReturnsCartWithoutCustomer(t *testing.T) {
+	sut := cart.NewGuestCart()
+
+	assert.NotEqual(t, uuid.Nil, sut.Id)
+	assert.True(t, sut.IsGuest())
+	assert.Equal(t, []cart.CartItem{}, sut.Items)
+}
+
+func TestCart_Merge_OnGuestCart_SumsQuantitiesOfSameProductsAndAppendsNewOnes(t *testing.T) {
+	product1 := uuid.New()
+	product2 := uuid.New()
+	customerCart, _ := cart.NewCart(uuid.New())
+	customerCart.AddItem(product1, 2, 32000, "BRL")
+	guestCart := cart.NewGuestCart()
+	guestCart.AddItem(product1, 3, 32000, "BRL")
+	guestCart.AddItem(product2, 1, 1500, "BRL")
+	guestCart.ApplyCoupon("welcome10")
+
+	err := customerCart.Merge(guestCart)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(customerCart.Items))
+	assert.Equal(t, int32(5), customerCart.Items[0].Quantity.Value)
+	assert.Equal(t, product2, customerCart.Items[1].ProductId)
+	assert.Equal(t, int32(6), customerCart.TotalQuantity().Value)
+	assert.Equal(t, "WELCOME10", customerCart.CouponCode)
+}
+
+func TestCart_Merge_OnCustomerCartWithCoupon_KeepsCustomerCoupon(t *testing.T) {
+	customerCart, _ := cart.NewCart(uuid.New())
+	customerCart.AddItem(uuid.New(), 1, 1500, "BRL")
+	customerCart.ApplyCoupon("loyal20")
+	guestCart := cart.NewGuestCart()
+	guestCart.AddItem(uuid.New(), 1, 1500, "BRL")
+	guestCart.ApplyCoupon("welcome10")
+
+	customerCart.Merge(guestCart)
+
+	assert.Equal(t, "LOYAL20", customerCart.CouponCode)
+}
+
+func TestCart_Merge_OnDifferentCurrencies_ReturnsErrorAndLeavesCartUnchanged(t *testing.T) {
+	product1 := uuid.New()
+	customerCart, _ := cart.NewCart(uuid.New())
+	customerCart.AddItem(product1, 2, 32000, "BRL")
+	guestCart := cart.NewGuestCart()
+	guestCart.AddItem(product1, 1, 32000, "BRL")
+	guestCart.Items = append(guestCart.Items, cart.CartItem{
+		Id:        uuid.New(),
+		ProductId: uuid.New(),
+		Quantity:  models.Quantity{Value: 1},
+		Price:     models.Money{Value: 1000, Currency: models.USD},
+	})
+
+	err := customerCart.Merge(guestCart)
+
+	assert.EqualError(t, err, "cart cannot mix currencies")
+	assert.Equal(t, 1, len(customerCart.Items))
+	assert.Equal(t, int32(2), customerCart.Items[0].Quantity.Value)
+}
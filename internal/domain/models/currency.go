@@ -0,0 +1,33 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var ErrCurrencyNotSupported = errs.Validation("CURRENCY_NOT_SUPPORTED", "currency is not supported")
+
+type Currency struct {
+	Code     string
+	Exponent int32
+}
+
+var (
+	BRL = Currency{Code: "BRL", Exponent: 2}
+	USD = Currency{Code: "USD", Exponent: 2}
+	EUR = Currency{Code: "EUR", Exponent: 2}
+)
+
+func NewCurrency(code string) (Currency, error) {
+	switch strings.ToUpper(strings.TrimSpace(code)) {
+	case BRL.Code:
+		return BRL, nil
+	case USD.Code:
+		return USD, nil
+	case EUR.Code:
+		return EUR, nil
+	}
+
+	return Currency{}, ErrCurrencyNotSupported
+}
@@ -0,0 +1,21 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_NewCurrency_OnSupportedCode_ReturnsCurrency(t *testing.T) {
+	sut, err := models.NewCurrency(" usd ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Currency{Code: "USD", Exponent: 2}, sut)
+}
+
+func TestCurrency_NewCurrency_OnUnsupportedCode_ReturnsError(t *testing.T) {
+	_, err := models.NewCurrency("XYZ")
+
+	assert.EqualError(t, err, "currency is not supported")
+}
@@ -0,0 +1,73 @@
+package customer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrCustomerEmailInvalid      = errs.Validation("CUSTOMER_EMAIL_INVALID", "customer email is invalid")
+	ErrCustomerPasswordTooShort  = errs.Validation("CUSTOMER_PASSWORD_TOO_SHORT", "customer password is too short")
+	ErrCustomerPasswordTooLong   = errs.Validation("CUSTOMER_PASSWORD_TOO_LONG", "customer password is too long")
+	ErrCustomerPasswordHashEmpty = errs.Validation("CUSTOMER_PASSWORD_HASH_EMPTY", "customer password hash cannot be empty")
+)
+
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+type Customer struct {
+	Id           uuid.UUID
+	Email        string
+	PasswordHash string
+	Role         string
+}
+
+func NewCustomer(email string, passwordHash string) (Customer, error) {
+	normalizedEmail, err := NormalizeEmail(email)
+	if err != nil {
+		return Customer{}, err
+	}
+
+	if passwordHash == "" {
+		return Customer{}, ErrCustomerPasswordHashEmpty
+	}
+
+	return Customer{
+		Id:           uuid.New(),
+		Email:        normalizedEmail,
+		PasswordHash: passwordHash,
+		Role:         CustomerRole,
+	}, nil
+}
+
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if len(email) > 320 || !emailPattern.MatchString(email) {
+		return "", ErrCustomerEmailInvalid
+	}
+
+	return email, nil
+}
+
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return ErrCustomerPasswordTooShort
+	}
+
+	if len(password) > MaxPasswordLength {
+		return ErrCustomerPasswordTooLong
+	}
+
+	return nil
+}
+
+func (c Customer) Scopes() []string {
+	return ScopesOf(c.Role)
+}
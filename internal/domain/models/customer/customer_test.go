@@ -0,0 +1,65 @@
+package customer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomer_NewCustomer_OnValidValues_ReturnsCustomerWithNormalizedEmail(t *testing.T) {
+	sut, err := customer.NewCustomer(" John.Doe@Example.COM ", "$2a$10$hash")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "john.doe@example.com", sut.Email)
+	assert.Equal(t, "$2a$10$hash", sut.PasswordHash)
+	assert.Equal(t, customer.CustomerRole, sut.Role)
+}
+
+func TestCustomer_NewCustomer_OnInvalidEmail_ReturnsError(t *testing.T) {
+	_, err := customer.NewCustomer("john.doe", "$2a$10$hash")
+
+	assert.EqualError(t, err, "customer email is invalid")
+}
+
+func TestCustomer_NewCustomer_OnEmptyPasswordHash_ReturnsError(t *testing.T) {
+	_, err := customer.NewCustomer("john.doe@example.com", "")
+
+	assert.EqualError(t, err, "customer password hash cannot be empty")
+}
+
+func TestCustomer_ValidatePassword_OnShortPassword_ReturnsError(t *testing.T) {
+	err := customer.ValidatePassword("1234567")
+
+	assert.EqualError(t, err, "customer password is too short")
+}
+
+func TestCustomer_ValidatePassword_OnPasswordLongerThanBcryptLimit_ReturnsError(t *testing.T) {
+	err := customer.ValidatePassword(strings.Repeat("a", 73))
+
+	assert.EqualError(t, err, "customer password is too long")
+}
+
+func TestCustomer_ValidatePassword_OnValidPassword_ReturnsNil(t *testing.T) {
+	err := customer.ValidatePassword("correct horse battery")
+
+	assert.NoError(t, err)
+}
+
+func TestCustomer_Scopes_OnEachRole_ReturnsTheScopesGrantedToIt(t *testing.T) {
+	sut, _ := customer.NewCustomer("john.doe@example.com", "$2a$10$hash")
+
+	assert.Equal(t, []string{"cart", "addresses", "checkout"}, sut.Scopes())
+
+	sut.Role = customer.AdminRole
+
+	assert.Equal(t, []string{"catalog", "orders", "tokens"}, sut.Scopes())
+}
+
+func TestCustomer_Scopes_OnUnknownRole_ReturnsNoScopes(t *testing.T) {
+	sut, _ := customer.NewCustomer("john.doe@example.com", "$2a$10$hash")
+	sut.Role = "guest"
+
+	assert.Empty(t, sut.Scopes())
+}
@@ -0,0 +1,58 @@
+package customer
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshToken struct {
+	Id         uuid.UUID
+	CustomerId uuid.UUID
+	FamilyId   uuid.UUID
+	TokenHash  string
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+func NewRefreshToken(customerId uuid.UUID, familyId uuid.UUID, expiresAt time.Time) (RefreshToken, string, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return RefreshToken{}, "", err
+	}
+
+	rawToken := base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	return RefreshToken{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		FamilyId:   familyId,
+		TokenHash:  HashRefreshToken(rawToken),
+		ExpiresAt:  expiresAt,
+	}, rawToken, nil
+}
+
+func HashRefreshToken(rawToken string) string {
+	sum := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *RefreshToken) IsRevoked() bool {
+	return r.RevokedAt != nil
+}
+
+func (r *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+func (r *RefreshToken) Revoke(now time.Time) {
+	if r.RevokedAt != nil {
+		return
+	}
+
+	r.RevokedAt = &now
+}
@@ -0,0 +1,55 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshToken_NewRefreshToken_OnValidValues_StoresOnlyTheTokenHash(t *testing.T) {
+	customerId := uuid.New()
+	familyId := uuid.New()
+	expiresAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	sut, rawToken, err := customer.NewRefreshToken(customerId, familyId, expiresAt)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rawToken)
+	assert.NotEqual(t, rawToken, sut.TokenHash)
+	assert.Equal(t, customer.HashRefreshToken(rawToken), sut.TokenHash)
+	assert.Equal(t, customerId, sut.CustomerId)
+	assert.Equal(t, familyId, sut.FamilyId)
+	assert.Equal(t, expiresAt, sut.ExpiresAt)
+	assert.Equal(t, false, sut.IsRevoked())
+}
+
+func TestRefreshToken_NewRefreshToken_OnEachCall_ReturnsDifferentTokens(t *testing.T) {
+	expiresAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	_, first, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), expiresAt)
+	_, second, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), expiresAt)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestRefreshToken_IsExpired_OnExpiryInstant_ReturnsTrue(t *testing.T) {
+	expiresAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+	sut, _, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), expiresAt)
+
+	assert.Equal(t, false, sut.IsExpired(expiresAt.Add(-time.Second)))
+	assert.Equal(t, true, sut.IsExpired(expiresAt))
+}
+
+func TestRefreshToken_Revoke_OnAlreadyRevokedToken_KeepsFirstRevocationTime(t *testing.T) {
+	sut, _, _ := customer.NewRefreshToken(uuid.New(), uuid.New(), time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC))
+	firstRevocation := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	sut.Revoke(firstRevocation)
+	sut.Revoke(firstRevocation.Add(time.Hour))
+
+	assert.Equal(t, true, sut.IsRevoked())
+	assert.Equal(t, firstRevocation, *sut.RevokedAt)
+}
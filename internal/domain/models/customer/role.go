@@ -0,0 +1,15 @@
+package customer
+
+const (
+	CustomerRole = "customer"
+	AdminRole    = "admin"
+)
+
+var roleScopes = map[string][]string{
+	CustomerRole: {"cart", "addresses", "checkout"},
+	AdminRole:    {"catalog", "orders", "tokens"},
+}
+
+func ScopesOf(role string) []string {
+	return append([]string{}, roleScopes[role]...)
+}
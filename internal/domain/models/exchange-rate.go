@@ -0,0 +1,79 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrExchangeRateInvalid     = errs.Validation("EXCHANGE_RATE_INVALID", "exchange rate is invalid")
+	ErrExchangeRateTooPrecise  = errs.Validation("EXCHANGE_RATE_TOO_PRECISE", "exchange rate is too precise")
+	ErrExchangeRateNotPositive = errs.Validation("EXCHANGE_RATE_NOT_POSITIVE", "exchange rate must be positive")
+)
+
+type ExchangeRate struct {
+	From        Currency
+	To          Currency
+	Numerator   int64
+	Denominator int64
+}
+
+func NewExchangeRate(from string, to string, rate string) (ExchangeRate, error) {
+	fromCurrency, err := NewCurrency(from)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	toCurrency, err := NewCurrency(to)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	ratio, ok := new(big.Rat).SetString(rate)
+	if !ok {
+		return ExchangeRate{}, ErrExchangeRateInvalid
+	}
+
+	if ratio.Sign() <= 0 {
+		return ExchangeRate{}, ErrExchangeRateNotPositive
+	}
+
+	if !ratio.Num().IsInt64() || !ratio.Denom().IsInt64() {
+		return ExchangeRate{}, ErrExchangeRateTooPrecise
+	}
+
+	return ExchangeRate{
+		From:        fromCurrency,
+		To:          toCurrency,
+		Numerator:   ratio.Num().Int64(),
+		Denominator: ratio.Denom().Int64(),
+	}, nil
+}
+
+func (e ExchangeRate) Convert(money Money) (Money, error) {
+	if money.Currency != e.From {
+		return Money{}, ErrMoneyCurrencyMismatch
+	}
+
+	numerator := new(big.Int).Mul(big.NewInt(money.Value), big.NewInt(e.Numerator))
+	denominator := big.NewInt(e.Denominator)
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absInt32(e.To.Exponent-e.From.Exponent))), nil)
+	if e.To.Exponent > e.From.Exponent {
+		numerator.Mul(numerator, scale)
+	} else {
+		denominator.Mul(denominator, scale)
+	}
+
+	converted := Money{Currency: e.To}
+	return converted.fromBig(roundHalfToEven(numerator, denominator))
+}
+
+func absInt32(value int32) int32 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
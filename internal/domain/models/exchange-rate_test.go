@@ -0,0 +1,73 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRate_NewExchangeRate_OnDecimalRate_ReturnsExchangeRate(t *testing.T) {
+	sut, err := models.NewExchangeRate("brl", "USD", "0.18")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ExchangeRate{From: models.BRL, To: models.USD, Numerator: 9, Denominator: 50}, sut)
+}
+
+func TestExchangeRate_NewExchangeRate_OnFractionRate_ReturnsExchangeRate(t *testing.T) {
+	sut, err := models.NewExchangeRate("USD", "EUR", "17/18")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ExchangeRate{From: models.USD, To: models.EUR, Numerator: 17, Denominator: 18}, sut)
+}
+
+func TestExchangeRate_NewExchangeRate_OnUnsupportedCurrency_ReturnsError(t *testing.T) {
+	_, err := models.NewExchangeRate("BRL", "JPY", "27.5")
+
+	assert.EqualError(t, err, "currency is not supported")
+}
+
+func TestExchangeRate_NewExchangeRate_OnInvalidRate_ReturnsError(t *testing.T) {
+	_, err := models.NewExchangeRate("BRL", "USD", "abc")
+
+	assert.EqualError(t, err, "exchange rate is invalid")
+}
+
+func TestExchangeRate_NewExchangeRate_OnNonPositiveRate_ReturnsError(t *testing.T) {
+	_, err := models.NewExchangeRate("BRL", "USD", "0")
+
+	assert.EqualError(t, err, "exchange rate must be positive")
+}
+
+func TestExchangeRate_NewExchangeRate_OnTooPreciseRate_ReturnsError(t *testing.T) {
+	_, err := models.NewExchangeRate("BRL", "USD", "0.1234567890123456789012345")
+
+	assert.EqualError(t, err, "exchange rate is too precise")
+}
+
+func TestExchangeRate_Convert_OnMatchingCurrency_ReturnsConvertedMoney(t *testing.T) {
+	rate, _ := models.NewExchangeRate("BRL", "USD", "0.18")
+
+	sut, err := rate.Convert(models.Money{Value: 3550, Currency: models.BRL})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 639, Currency: models.USD}, sut)
+}
+
+func TestExchangeRate_Convert_OnHalfCent_RoundsHalfToEven(t *testing.T) {
+	rate, _ := models.NewExchangeRate("BRL", "USD", "0.5")
+
+	first, _ := rate.Convert(models.Money{Value: 25, Currency: models.BRL})
+	second, _ := rate.Convert(models.Money{Value: 27, Currency: models.BRL})
+
+	assert.Equal(t, int64(12), first.Value)
+	assert.Equal(t, int64(14), second.Value)
+}
+
+func TestExchangeRate_Convert_OnDifferentCurrency_ReturnsError(t *testing.T) {
+	rate, _ := models.NewExchangeRate("BRL", "USD", "0.18")
+
+	_, err := rate.Convert(models.Money{Value: 3550, Currency: models.EUR})
+
+	assert.EqualError(t, err, "money currency mismatch")
+}
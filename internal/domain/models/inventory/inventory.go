@@ -0,0 +1,46 @@
+package inventory
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+var (
+	ErrInsufficientStock              = errs.Conflict("INSUFFICIENT_STOCK", "insufficient stock")
+	ErrInventoryReservedExceedsOnHand = errs.Validation("INVENTORY_RESERVED_EXCEEDS_ON_HAND", "inventory reserved cannot exceed on hand")
+)
+
+type Inventory struct {
+	ProductId uuid.UUID
+	OnHand    models.Quantity
+	Reserved  models.Quantity
+}
+
+func NewInventory(productId uuid.UUID, onHand int32, reserved int32) (Inventory, error) {
+	onHandQuantity, err := models.NewQuantity(onHand)
+	if err != nil {
+		return Inventory{}, err
+	}
+
+	reservedQuantity, err := models.NewQuantity(reserved)
+	if err != nil {
+		return Inventory{}, err
+	}
+
+	if reserved > onHand {
+		return Inventory{}, ErrInventoryReservedExceedsOnHand
+	}
+
+	return Inventory{
+		ProductId: productId,
+		OnHand:    onHandQuantity,
+		Reserved:  reservedQuantity,
+	}, nil
+}
+
+func (i *Inventory) Available() models.Quantity {
+	return models.Quantity{
+		Value: i.OnHand.Value - i.Reserved.Value,
+	}
+}
@@ -0,0 +1,33 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventory_NewInventory_OnValidValues_ReturnsInventory(t *testing.T) {
+	productId := uuid.New()
+
+	sut, err := inventory.NewInventory(productId, 10, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, productId, sut.ProductId)
+	assert.Equal(t, int32(10), sut.OnHand.Value)
+	assert.Equal(t, int32(3), sut.Reserved.Value)
+	assert.Equal(t, int32(7), sut.Available().Value)
+}
+
+func TestInventory_NewInventory_OnNegativeOnHand_ReturnsError(t *testing.T) {
+	_, err := inventory.NewInventory(uuid.New(), -1, 0)
+
+	assert.EqualError(t, err, "quantity value cannot be negative")
+}
+
+func TestInventory_NewInventory_OnReservedGreaterThanOnHand_ReturnsError(t *testing.T) {
+	_, err := inventory.NewInventory(uuid.New(), 2, 3)
+
+	assert.EqualError(t, err, "inventory reserved cannot exceed on hand")
+}
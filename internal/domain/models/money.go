@@ -1,17 +1,178 @@
 package models
 
-import "errors"
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrMoneyCurrencyMismatch        = errs.Validation("MONEY_CURRENCY_MISMATCH", "money currency mismatch")
+	ErrMoneyNegative                = errs.Validation("MONEY_NEGATIVE", "money value cannot be negative")
+	ErrMoneyOverflow                = errs.Validation("MONEY_OVERFLOW", "money value overflow")
+	ErrMoneyRatioDenominatorZero    = errs.Validation("MONEY_RATIO_DENOMINATOR_ZERO", "money ratio denominator cannot be zero")
+	ErrMoneyAllocationNoRatios      = errs.Validation("MONEY_ALLOCATION_NO_RATIOS", "money allocation needs at least one ratio")
+	ErrMoneyAllocationRatioNegative = errs.Validation("MONEY_ALLOCATION_RATIO_NEGATIVE", "money allocation ratio cannot be negative")
+	ErrMoneyAllocationRatiosZero    = errs.Validation("MONEY_ALLOCATION_RATIOS_ZERO", "money allocation ratios cannot all be zero")
+)
 
 type Money struct {
-	Value int64
+	Value    int64
+	Currency Currency
 }
 
-func NewMoney(value int64) (Money, error) {
+func NewMoney(value int64, currencyCode string) (Money, error) {
 	if value < 0 {
-		return Money{}, errors.New("money value cannot be negative")
+		return Money{}, ErrMoneyNegative
+	}
+
+	currency, err := NewCurrency(currencyCode)
+	if err != nil {
+		return Money{}, err
 	}
 
 	return Money{
-		Value: value,
+		Value:    value,
+		Currency: currency,
 	}, nil
 }
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrMoneyCurrencyMismatch
+	}
+
+	sum := new(big.Int).Add(big.NewInt(m.Value), big.NewInt(other.Value))
+	return m.fromBig(sum)
+}
+
+func (m Money) Subtract(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrMoneyCurrencyMismatch
+	}
+
+	difference := new(big.Int).Sub(big.NewInt(m.Value), big.NewInt(other.Value))
+	if difference.Sign() < 0 {
+		return Money{}, ErrMoneyNegative
+	}
+
+	return m.fromBig(difference)
+}
+
+func (m Money) Multiply(factor int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.Value), big.NewInt(factor))
+	return m.fromBig(product)
+}
+
+func (m Money) MultiplyRatio(numerator int64, denominator int64) (Money, error) {
+	if denominator == 0 {
+		return Money{}, ErrMoneyRatioDenominatorZero
+	}
+
+	product := new(big.Int).Mul(big.NewInt(m.Value), big.NewInt(numerator))
+	return m.fromBig(roundHalfToEven(product, big.NewInt(denominator)))
+}
+
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	if m.Value < 0 {
+		return nil, ErrMoneyNegative
+	}
+
+	if len(ratios) == 0 {
+		return nil, ErrMoneyAllocationNoRatios
+	}
+
+	total := int64(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrMoneyAllocationRatioNegative
+		}
+
+		total += ratio
+	}
+
+	if total == 0 {
+		return nil, ErrMoneyAllocationRatiosZero
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.Value
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(big.NewInt(m.Value), big.NewInt(ratio))
+		share.Quo(share, big.NewInt(total))
+		shares[i] = Money{Value: share.Int64(), Currency: m.Currency}
+		remainder -= shares[i].Value
+	}
+
+	for i := 0; remainder > 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+
+		shares[i].Value++
+		remainder--
+	}
+
+	return shares, nil
+}
+
+func (m Money) String() string {
+	sign := ""
+	value := new(big.Int).SetInt64(m.Value)
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Neg(value)
+	}
+
+	digits := value.String()
+	exponent := int(m.Currency.Exponent)
+	if exponent == 0 {
+		return strings.TrimSpace(fmt.Sprintf("%s%s %s", sign, digits, m.Currency.Code))
+	}
+
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-exponent]
+	fraction := digits[len(digits)-exponent:]
+	return strings.TrimSpace(fmt.Sprintf("%s%s.%s %s", sign, whole, fraction, m.Currency.Code))
+}
+
+func (m Money) fromBig(value *big.Int) (Money, error) {
+	if !value.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{
+		Value:    value.Int64(),
+		Currency: m.Currency,
+	}, nil
+}
+
+func roundHalfToEven(numerator *big.Int, denominator *big.Int) *big.Int {
+	if denominator.Sign() < 0 {
+		numerator = new(big.Int).Neg(numerator)
+		denominator = new(big.Int).Neg(denominator)
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	doubled := new(big.Int).Abs(remainder)
+	doubled.Lsh(doubled, 1)
+	comparison := doubled.Cmp(denominator)
+	if comparison > 0 || (comparison == 0 && quotient.Bit(0) == 1) {
+		if numerator.Sign() < 0 {
+			return quotient.Sub(quotient, big.NewInt(1))
+		}
+
+		return quotient.Add(quotient, big.NewInt(1))
+	}
+
+	return quotient
+}
@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
@@ -8,14 +9,140 @@ import (
 )
 
 func TestMoney_NewMoney_OnValid_ReturnsMoney(t *testing.T) {
-	sut, err := models.NewMoney(220)
+	sut, err := models.NewMoney(220, "BRL")
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(220), sut.Value)
+	assert.Equal(t, models.BRL, sut.Currency)
 }
 
 func TestMoney_NewMoney_OnNegativeValue_ReturnsError(t *testing.T) {
-	_, err := models.NewMoney(-2)
+	_, err := models.NewMoney(-2, "BRL")
 
 	assert.EqualError(t, err, "money value cannot be negative")
 }
+
+func TestMoney_NewMoney_OnUnsupportedCurrency_ReturnsError(t *testing.T) {
+	_, err := models.NewMoney(220, "JPY")
+
+	assert.EqualError(t, err, "currency is not supported")
+}
+
+func TestMoney_Add_OnSameCurrency_ReturnsSum(t *testing.T) {
+	money := models.Money{Value: 1550, Currency: models.USD}
+
+	sut, err := money.Add(models.Money{Value: 450, Currency: models.USD})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 2000, Currency: models.USD}, sut)
+}
+
+func TestMoney_Add_OnDifferentCurrencies_ReturnsError(t *testing.T) {
+	money := models.Money{Value: 1550, Currency: models.USD}
+
+	_, err := money.Add(models.Money{Value: 450, Currency: models.EUR})
+
+	assert.EqualError(t, err, "money currency mismatch")
+}
+
+func TestMoney_Add_OnOverflow_ReturnsError(t *testing.T) {
+	money := models.Money{Value: math.MaxInt64, Currency: models.BRL}
+
+	_, err := money.Add(models.Money{Value: 1, Currency: models.BRL})
+
+	assert.EqualError(t, err, "money value overflow")
+}
+
+func TestMoney_Subtract_OnSameCurrency_ReturnsDifference(t *testing.T) {
+	money := models.Money{Value: 1550, Currency: models.EUR}
+
+	sut, err := money.Subtract(models.Money{Value: 550, Currency: models.EUR})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 1000, Currency: models.EUR}, sut)
+}
+
+func TestMoney_Subtract_OnNegativeResult_ReturnsError(t *testing.T) {
+	money := models.Money{Value: 100, Currency: models.EUR}
+
+	_, err := money.Subtract(models.Money{Value: 550, Currency: models.EUR})
+
+	assert.EqualError(t, err, "money value cannot be negative")
+}
+
+func TestMoney_Multiply_OnOverflow_ReturnsError(t *testing.T) {
+	money := models.Money{Value: math.MaxInt64 / 2, Currency: models.BRL}
+
+	_, err := money.Multiply(3)
+
+	assert.EqualError(t, err, "money value overflow")
+}
+
+func TestMoney_MultiplyRatio_OnHalfway_RoundsToEven(t *testing.T) {
+	testCases := map[int64]int64{
+		25:  2,
+		35:  4,
+		45:  4,
+		44:  4,
+		46:  5,
+		150: 15,
+	}
+
+	for value, expected := range testCases {
+		money := models.Money{Value: value, Currency: models.BRL}
+
+		sut, err := money.MultiplyRatio(1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sut.Value, "value %d", value)
+	}
+}
+
+func TestMoney_MultiplyRatio_OnZeroDenominator_ReturnsError(t *testing.T) {
+	money := models.Money{Value: 100, Currency: models.BRL}
+
+	_, err := money.MultiplyRatio(1, 0)
+
+	assert.EqualError(t, err, "money ratio denominator cannot be zero")
+}
+
+func TestMoney_Allocate_OnUnevenSplit_DistributesRemainderWithoutLosingCents(t *testing.T) {
+	money := models.Money{Value: 1000, Currency: models.USD}
+
+	sut, err := money.Allocate(1, 1, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Money{
+		{Value: 334, Currency: models.USD},
+		{Value: 333, Currency: models.USD},
+		{Value: 333, Currency: models.USD},
+	}, sut)
+}
+
+func TestMoney_Allocate_OnWeightedRatios_SplitsProportionally(t *testing.T) {
+	money := models.Money{Value: 5, Currency: models.USD}
+
+	sut, err := money.Allocate(3, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), sut[0].Value)
+	assert.Equal(t, int64(3), sut[1].Value)
+}
+
+func TestMoney_Allocate_OnInvalidRatios_ReturnsError(t *testing.T) {
+	money := models.Money{Value: 5, Currency: models.USD}
+
+	_, err := money.Allocate()
+	assert.EqualError(t, err, "money allocation needs at least one ratio")
+
+	_, err = money.Allocate(0, 0)
+	assert.EqualError(t, err, "money allocation ratios cannot all be zero")
+
+	_, err = money.Allocate(1, -1)
+	assert.EqualError(t, err, "money allocation ratio cannot be negative")
+}
+
+func TestMoney_String_OnMinorUnits_FormatsWithExponent(t *testing.T) {
+	assert.Equal(t, "35.50 BRL", models.Money{Value: 3550, Currency: models.BRL}.String())
+	assert.Equal(t, "0.05 USD", models.Money{Value: 5, Currency: models.USD}.String())
+}
@@ -0,0 +1,11 @@
+package order
+
+type OrderAddress struct {
+	RecipientName string
+	Line1         string
+	Line2         string
+	City          string
+	Region        string
+	PostalCode    string
+	Country       string
+}
@@ -0,0 +1,48 @@
+package order
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+var ErrOrderLineQuantityBelowOne = errs.Validation("ORDER_LINE_QUANTITY_BELOW_ONE", "order line quantity cannot be less than one")
+
+type OrderLine struct {
+	Id                 uuid.UUID
+	ProductId          uuid.UUID
+	Quantity           models.Quantity
+	UnitPrice          models.Money
+	TaxClass           string
+	TaxRateBasisPoints int64
+	TaxInclusive       bool
+	Tax                models.Money
+}
+
+func NewOrderLine(productId uuid.UUID, quantity int32, unitPrice int64, currency string) (OrderLine, error) {
+	if _, err := models.NewQuantity(quantity); err != nil {
+		return OrderLine{}, err
+	}
+
+	money, err := models.NewMoney(unitPrice, currency)
+	if err != nil {
+		return OrderLine{}, err
+	}
+
+	MINIMUM_QUANTITY := int32(1)
+	if quantity < MINIMUM_QUANTITY {
+		return OrderLine{}, ErrOrderLineQuantityBelowOne
+	}
+
+	return OrderLine{
+		Id:        uuid.New(),
+		ProductId: productId,
+		Quantity:  models.Quantity{Value: quantity},
+		UnitPrice: money,
+		Tax:       models.Money{Value: 0, Currency: money.Currency},
+	}, nil
+}
+
+func (o *OrderLine) TotalPrice() (models.Money, error) {
+	return o.UnitPrice.Multiply(int64(o.Quantity.Value))
+}
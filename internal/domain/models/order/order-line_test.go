@@ -0,0 +1,40 @@
+package order_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderLine_NewOrderLine_OnValidValues_ReturnsOrderLine(t *testing.T) {
+	productId := uuid.New()
+
+	sut, err := order.NewOrderLine(productId, 3, 2550, "BRL")
+
+	assert.NoError(t, err)
+	assert.Equal(t, productId, sut.ProductId)
+	assert.Equal(t, int32(3), sut.Quantity.Value)
+	assert.Equal(t, int64(2550), sut.UnitPrice.Value)
+	totalPrice, _ := sut.TotalPrice()
+	assert.Equal(t, int64(7650), totalPrice.Value)
+}
+
+func TestOrderLine_NewOrderLine_OnQuantityEqualsZero_ReturnsError(t *testing.T) {
+	_, err := order.NewOrderLine(uuid.New(), 0, 2550, "BRL")
+
+	assert.EqualError(t, err, "order line quantity cannot be less than one")
+}
+
+func TestOrderLine_NewOrderLine_OnNegativeQuantity_ReturnsError(t *testing.T) {
+	_, err := order.NewOrderLine(uuid.New(), -1, 2550, "BRL")
+
+	assert.EqualError(t, err, "quantity value cannot be negative")
+}
+
+func TestOrderLine_NewOrderLine_OnNegativeUnitPrice_ReturnsError(t *testing.T) {
+	_, err := order.NewOrderLine(uuid.New(), 1, -500, "BRL")
+
+	assert.EqualError(t, err, "money value cannot be negative")
+}
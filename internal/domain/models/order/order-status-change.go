@@ -0,0 +1,15 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStatusChange struct {
+	Id        uuid.UUID
+	From      OrderStatus
+	To        OrderStatus
+	Actor     string
+	ChangedAt time.Time
+}
@@ -0,0 +1,46 @@
+package order
+
+import "github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+
+var ErrOrderStatusInvalid = errs.Validation("ORDER_STATUS_INVALID", "order status is invalid")
+
+type OrderStatus string
+
+const (
+	PendingPayment OrderStatus = "PENDING_PAYMENT"
+	Paid           OrderStatus = "PAID"
+	Fulfilling     OrderStatus = "FULFILLING"
+	Shipped        OrderStatus = "SHIPPED"
+	Delivered      OrderStatus = "DELIVERED"
+	Cancelled      OrderStatus = "CANCELLED"
+	Refunded       OrderStatus = "REFUNDED"
+)
+
+var allowedTransitions = map[OrderStatus][]OrderStatus{
+	PendingPayment: {Paid, Cancelled},
+	Paid:           {Fulfilling, Refunded},
+	Fulfilling:     {Shipped, Refunded},
+	Shipped:        {Delivered},
+	Delivered:      {Refunded},
+	Cancelled:      {},
+	Refunded:       {},
+}
+
+func NewOrderStatus(value string) (OrderStatus, error) {
+	status := OrderStatus(value)
+	if _, exists := allowedTransitions[status]; !exists {
+		return "", ErrOrderStatusInvalid
+	}
+
+	return status, nil
+}
+
+func (o OrderStatus) CanTransitionTo(status OrderStatus) bool {
+	for _, allowedStatus := range allowedTransitions[o] {
+		if allowedStatus == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+package order_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderStatus_NewOrderStatus_OnValidValue_ReturnsOrderStatus(t *testing.T) {
+	sut, err := order.NewOrderStatus("SHIPPED")
+
+	assert.NoError(t, err)
+	assert.Equal(t, order.Shipped, sut)
+}
+
+func TestOrderStatus_NewOrderStatus_OnInvalidValue_ReturnsError(t *testing.T) {
+	_, err := order.NewOrderStatus("LOST")
+
+	assert.EqualError(t, err, "order status is invalid")
+}
+
+func TestOrderStatus_CanTransitionTo_OnAllowedTransitions_ReturnsTrue(t *testing.T) {
+	transitions := [][2]order.OrderStatus{
+		{order.PendingPayment, order.Paid},
+		{order.PendingPayment, order.Cancelled},
+		{order.Paid, order.Fulfilling},
+		{order.Paid, order.Refunded},
+		{order.Fulfilling, order.Shipped},
+		{order.Fulfilling, order.Refunded},
+		{order.Shipped, order.Delivered},
+		{order.Delivered, order.Refunded},
+	}
+
+	for _, transition := range transitions {
+		assert.True(t, transition[0].CanTransitionTo(transition[1]), "%s -> %s", transition[0], transition[1])
+	}
+}
+
+func TestOrderStatus_CanTransitionTo_OnIllegalTransitions_ReturnsFalse(t *testing.T) {
+	transitions := [][2]order.OrderStatus{
+		{order.PendingPayment, order.Shipped},
+		{order.PendingPayment, order.Refunded},
+		{order.Paid, order.PendingPayment},
+		{order.Paid, order.Cancelled},
+		{order.Shipped, order.Cancelled},
+		{order.Delivered, order.Shipped},
+		{order.Cancelled, order.Paid},
+		{order.Refunded, order.Paid},
+	}
+
+	for _, transition := range transitions {
+		assert.False(t, transition[0].CanTransitionTo(transition[1]), "%s -> %s", transition[0], transition[1])
+	}
+}
@@ -0,0 +1,207 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+)
+
+var (
+	ErrOrderHasNoLines                 = errs.Validation("ORDER_HAS_NO_LINES", "order must have at least one line")
+	ErrOrderCannotMixCurrencies        = errs.Validation("ORDER_CANNOT_MIX_CURRENCIES", "order cannot mix currencies")
+	ErrOrderStatusActorEmpty           = errs.Validation("ORDER_STATUS_ACTOR_EMPTY", "order status change actor cannot be empty")
+	ErrOrderStatusTransitionNotAllowed = errs.Conflict("ORDER_STATUS_TRANSITION_NOT_ALLOWED", "order status transition is not allowed")
+	ErrTaxCalculationMismatch          = errs.Validation("TAX_CALCULATION_MISMATCH", "tax calculation does not match order lines")
+)
+
+type Order struct {
+	Id              uuid.UUID
+	CustomerId      uuid.UUID
+	Status          OrderStatus
+	Lines           []OrderLine
+	StatusHistory   []OrderStatusChange
+	PaymentId       string
+	CouponCode      string
+	Discount        models.Money
+	TaxRegion       string
+	ShippingMethod  string
+	ShippingCost    models.Money
+	ShippingAddress OrderAddress
+}
+
+func NewOrder(customerId uuid.UUID, lines []OrderLine) (Order, error) {
+	if len(lines) == 0 {
+		return Order{}, ErrOrderHasNoLines
+	}
+
+	for _, line := range lines {
+		if line.UnitPrice.Currency != lines[0].UnitPrice.Currency {
+			return Order{}, ErrOrderCannotMixCurrencies
+		}
+	}
+
+	return Order{
+		Id:            uuid.New(),
+		CustomerId:    customerId,
+		Status:        PendingPayment,
+		Lines:         lines,
+		StatusHistory: []OrderStatusChange{},
+	}, nil
+}
+
+func (o *Order) ChangeStatus(status OrderStatus, actor string, changedAt time.Time) error {
+	if _, err := NewOrderStatus(string(status)); err != nil {
+		return err
+	}
+
+	if actor == "" {
+		return ErrOrderStatusActorEmpty
+	}
+
+	if !o.Status.CanTransitionTo(status) {
+		return ErrOrderStatusTransitionNotAllowed
+	}
+
+	o.StatusHistory = append(o.StatusHistory, OrderStatusChange{
+		Id:        uuid.New(),
+		From:      o.Status,
+		To:        status,
+		Actor:     actor,
+		ChangedAt: changedAt,
+	})
+	o.Status = status
+	return nil
+}
+
+func (o *Order) TotalQuantity() models.Quantity {
+	totalQuantity := int32(0)
+
+	for _, line := range o.Lines {
+		totalQuantity += line.Quantity.Value
+	}
+
+	return models.Quantity{
+		Value: totalQuantity,
+	}
+}
+
+func (o *Order) Currency() models.Currency {
+	if len(o.Lines) == 0 {
+		return models.Currency{}
+	}
+
+	return o.Lines[0].UnitPrice.Currency
+}
+
+func (o *Order) TotalPrice() (models.Money, error) {
+	totalPrice := models.Money{Value: 0, Currency: o.Currency()}
+
+	for _, line := range o.Lines {
+		lineTotalPrice, err := line.TotalPrice()
+		if err != nil {
+			return models.Money{}, err
+		}
+
+		totalPrice, err = totalPrice.Add(lineTotalPrice)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	return totalPrice, nil
+}
+
+func (o *Order) ApplyTax(calculation tax.TaxCalculation) error {
+	if len(calculation.Lines) != len(o.Lines) {
+		return ErrTaxCalculationMismatch
+	}
+
+	for i, taxLine := range calculation.Lines {
+		if taxLine.ProductId != o.Lines[i].ProductId {
+			return ErrTaxCalculationMismatch
+		}
+
+		if taxLine.Tax.Currency != o.Currency() {
+			return models.ErrMoneyCurrencyMismatch
+		}
+	}
+
+	for i, taxLine := range calculation.Lines {
+		o.Lines[i].TaxClass = taxLine.TaxClass
+		o.Lines[i].TaxRateBasisPoints = taxLine.RateBasisPoints
+		o.Lines[i].TaxInclusive = taxLine.Inclusive
+		o.Lines[i].Tax = taxLine.Tax
+	}
+
+	o.TaxRegion = calculation.Region
+	return nil
+}
+
+func (o *Order) ApplyShipping(quote shipping.ShippingQuote) error {
+	if quote.Cost.Currency != o.Currency() {
+		return models.ErrMoneyCurrencyMismatch
+	}
+
+	o.ShippingMethod = quote.MethodCode
+	o.ShippingCost = quote.Cost
+	return nil
+}
+
+func (o *Order) TotalTax() (models.Money, error) {
+	totalTax := models.Money{Value: 0, Currency: o.Currency()}
+
+	for _, line := range o.Lines {
+		if line.Tax.Value == 0 {
+			continue
+		}
+
+		var err error
+		totalTax, err = totalTax.Add(line.Tax)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	return totalTax, nil
+}
+
+func (o *Order) AmountDue() (models.Money, error) {
+	totalPrice, err := o.TotalPrice()
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	amountDue := totalPrice
+	if o.Discount.Value > totalPrice.Value {
+		amountDue = models.Money{Value: 0, Currency: totalPrice.Currency}
+	} else if o.Discount.Value > 0 {
+		amountDue, err = totalPrice.Subtract(o.Discount)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	for _, line := range o.Lines {
+		if line.TaxInclusive || line.Tax.Value == 0 {
+			continue
+		}
+
+		amountDue, err = amountDue.Add(line.Tax)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	if o.ShippingCost.Value > 0 {
+		amountDue, err = amountDue.Add(o.ShippingCost)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	return amountDue, nil
+}
@@ -0,0 +1,207 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrder_NewOrder_OnValidValues_ReturnsOrder(t *testing.T) {
+	customerId := uuid.New()
+	line1, _ := order.NewOrderLine(uuid.New(), 2, 32000, "BRL")
+	line2, _ := order.NewOrderLine(uuid.New(), 5, 17340, "BRL")
+
+	sut, err := order.NewOrder(customerId, []order.OrderLine{line1, line2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, customerId, sut.CustomerId)
+	assert.Equal(t, order.PendingPayment, sut.Status)
+	assert.Equal(t, []order.OrderStatusChange{}, sut.StatusHistory)
+	assert.Equal(t, int(2), len(sut.Lines))
+	assert.Equal(t, int32(7), sut.TotalQuantity().Value)
+	totalPrice, _ := sut.TotalPrice()
+	assert.Equal(t, int64(150700), totalPrice.Value)
+}
+
+func TestOrder_NewOrder_OnNoLines_ReturnsError(t *testing.T) {
+	_, err := order.NewOrder(uuid.New(), []order.OrderLine{})
+
+	assert.EqualError(t, err, "order must have at least one line")
+}
+
+func TestOrder_ChangeStatus_OnAllowedTransitions_RecordsHistory(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 2550, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+	paidAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	fulfillingAt := paidAt.Add(time.Hour)
+
+	errPaid := sut.ChangeStatus(order.Paid, "payment-provider", paidAt)
+	errFulfilling := sut.ChangeStatus(order.Fulfilling, "admin@store.com", fulfillingAt)
+
+	assert.NoError(t, errPaid)
+	assert.NoError(t, errFulfilling)
+	assert.Equal(t, order.Fulfilling, sut.Status)
+	assert.Equal(t, int(2), len(sut.StatusHistory))
+	assert.Equal(t, order.PendingPayment, sut.StatusHistory[0].From)
+	assert.Equal(t, order.Paid, sut.StatusHistory[0].To)
+	assert.Equal(t, "payment-provider", sut.StatusHistory[0].Actor)
+	assert.Equal(t, paidAt, sut.StatusHistory[0].ChangedAt)
+	assert.Equal(t, order.Paid, sut.StatusHistory[1].From)
+	assert.Equal(t, order.Fulfilling, sut.StatusHistory[1].To)
+	assert.Equal(t, "admin@store.com", sut.StatusHistory[1].Actor)
+	assert.Equal(t, fulfillingAt, sut.StatusHistory[1].ChangedAt)
+}
+
+func TestOrder_ChangeStatus_OnIllegalTransition_ReturnsError(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 2550, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ChangeStatus(order.Shipped, "admin@store.com", time.Now())
+
+	assert.EqualError(t, err, "order status transition is not allowed")
+	assert.Equal(t, order.PendingPayment, sut.Status)
+	assert.Equal(t, int(0), len(sut.StatusHistory))
+}
+
+func TestOrder_ChangeStatus_OnInvalidStatus_ReturnsError(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 2550, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ChangeStatus(order.OrderStatus("LOST"), "admin@store.com", time.Now())
+
+	assert.EqualError(t, err, "order status is invalid")
+}
+
+func TestOrder_ChangeStatus_OnEmptyActor_ReturnsError(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 2550, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ChangeStatus(order.Paid, "", time.Now())
+
+	assert.EqualError(t, err, "order status change actor cannot be empty")
+}
+
+func TestOrder_AmountDue_OnDiscount_SubtractsDiscountFromTotalPrice(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+	sut.Discount = models.Money{Value: 1500, Currency: models.BRL}
+
+	amountDue, _ := sut.AmountDue()
+	assert.Equal(t, int64(8500), amountDue.Value)
+}
+
+func TestOrder_AmountDue_OnNoDiscount_ReturnsTotalPrice(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	amountDue, _ := sut.AmountDue()
+	assert.Equal(t, int64(10000), amountDue.Value)
+}
+
+func TestOrder_NewOrder_OnLinesWithDifferentCurrencies_ReturnsError(t *testing.T) {
+	line1, _ := order.NewOrderLine(uuid.New(), 1, 5000, "BRL")
+	line2, _ := order.NewOrderLine(uuid.New(), 1, 1000, "EUR")
+
+	_, err := order.NewOrder(uuid.New(), []order.OrderLine{line1, line2})
+
+	assert.EqualError(t, err, "order cannot mix currencies")
+}
+
+func TestOrder_ApplyTax_OnMatchingCalculation_FreezesTaxIntoLines(t *testing.T) {
+	line1, _ := order.NewOrderLine(uuid.New(), 1, 10000, "BRL")
+	line2, _ := order.NewOrderLine(uuid.New(), 1, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line1, line2})
+
+	err := sut.ApplyTax(tax.TaxCalculation{
+		Region: "NY",
+		Lines: []tax.TaxLine{
+			{ProductId: line1.ProductId, TaxClass: "standard", RateBasisPoints: 888, Tax: models.Money{Value: 888, Currency: models.BRL}},
+			{ProductId: line2.ProductId, TaxClass: "books", RateBasisPoints: 0, Tax: models.Money{Value: 0, Currency: models.BRL}},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "NY", sut.TaxRegion)
+	assert.Equal(t, "standard", sut.Lines[0].TaxClass)
+	assert.Equal(t, int64(888), sut.Lines[0].TaxRateBasisPoints)
+	assert.Equal(t, "books", sut.Lines[1].TaxClass)
+	totalTax, _ := sut.TotalTax()
+	assert.Equal(t, models.Money{Value: 888, Currency: models.BRL}, totalTax)
+}
+
+func TestOrder_ApplyTax_OnMismatchingLines_ReturnsError(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 10000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ApplyTax(tax.TaxCalculation{
+		Region: "NY",
+		Lines:  []tax.TaxLine{{ProductId: uuid.New(), Tax: models.Money{Value: 888, Currency: models.BRL}}},
+	})
+
+	assert.EqualError(t, err, "tax calculation does not match order lines")
+	assert.Equal(t, "", sut.TaxRegion)
+}
+
+func TestOrder_AmountDue_OnExclusiveTax_AddsTaxToDiscountedTotal(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+	sut.Discount = models.Money{Value: 1000, Currency: models.BRL}
+	sut.ApplyTax(tax.TaxCalculation{
+		Region: "NY",
+		Lines:  []tax.TaxLine{{ProductId: line.ProductId, RateBasisPoints: 1000, Tax: models.Money{Value: 900, Currency: models.BRL}}},
+	})
+
+	amountDue, _ := sut.AmountDue()
+	assert.Equal(t, int64(9900), amountDue.Value)
+}
+
+func TestOrder_AmountDue_OnInclusiveTax_ReturnsDiscountedTotal(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 1, 11800, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+	sut.ApplyTax(tax.TaxCalculation{
+		Region: "SP",
+		Lines: []tax.TaxLine{
+			{ProductId: line.ProductId, RateBasisPoints: 1800, Inclusive: true, Tax: models.Money{Value: 1800, Currency: models.BRL}},
+		},
+	})
+
+	amountDue, _ := sut.AmountDue()
+	assert.Equal(t, int64(11800), amountDue.Value)
+}
+
+func TestOrder_ApplyShipping_OnQuote_StoresMethodAndCost(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ApplyShipping(shipping.ShippingQuote{MethodCode: "EXPRESS", Cost: models.Money{Value: 4990, Currency: models.BRL}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EXPRESS", sut.ShippingMethod)
+	assert.Equal(t, models.Money{Value: 4990, Currency: models.BRL}, sut.ShippingCost)
+}
+
+func TestOrder_ApplyShipping_OnQuoteInAnotherCurrency_ReturnsError(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+
+	err := sut.ApplyShipping(shipping.ShippingQuote{MethodCode: "EXPRESS", Cost: models.Money{Value: 4990, Currency: models.USD}})
+
+	assert.EqualError(t, err, "money currency mismatch")
+	assert.Equal(t, "", sut.ShippingMethod)
+}
+
+func TestOrder_AmountDue_OnShippingCost_AddsShippingAfterDiscount(t *testing.T) {
+	line, _ := order.NewOrderLine(uuid.New(), 2, 5000, "BRL")
+	sut, _ := order.NewOrder(uuid.New(), []order.OrderLine{line})
+	sut.Discount = models.Money{Value: 12000, Currency: models.BRL}
+	sut.ApplyShipping(shipping.ShippingQuote{MethodCode: "EXPRESS", Cost: models.Money{Value: 4990, Currency: models.BRL}})
+
+	amountDue, _ := sut.AmountDue()
+	assert.Equal(t, int64(4990), amountDue.Value)
+}
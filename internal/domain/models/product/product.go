@@ -0,0 +1,94 @@
+package product
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+var (
+	ErrProductNameEmpty          = errs.Validation("PRODUCT_NAME_EMPTY", "product name cannot be empty")
+	ErrProductSkuEmpty           = errs.Validation("PRODUCT_SKU_EMPTY", "product sku cannot be empty")
+	ErrProductTaxClassEmpty      = errs.Validation("PRODUCT_TAX_CLASS_EMPTY", "product tax class cannot be empty")
+	ErrProductDimensionsNegative = errs.Validation("PRODUCT_DIMENSIONS_NEGATIVE", "product dimensions cannot be negative")
+	ErrProductAlreadyArchived    = errs.Conflict("PRODUCT_ALREADY_ARCHIVED", "product is already archived")
+)
+
+const StandardTaxClass = "standard"
+
+type Product struct {
+	Id          uuid.UUID
+	Name        string
+	Description string
+	Sku         string
+	Price       models.Money
+	TaxClass    string
+	WeightGrams int32
+	LengthCm    int32
+	WidthCm     int32
+	HeightCm    int32
+	Active      bool
+}
+
+func NewProduct(name string, description string, sku string, price int64, currency string, taxClass string) (Product, error) {
+	product := Product{
+		Id:     uuid.New(),
+		Active: true,
+	}
+
+	err := product.Update(name, description, sku, price, currency, taxClass)
+	if err != nil {
+		return Product{}, err
+	}
+
+	return product, nil
+}
+
+func (p *Product) Update(name string, description string, sku string, price int64, currency string, taxClass string) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrProductNameEmpty
+	}
+
+	if strings.TrimSpace(sku) == "" {
+		return ErrProductSkuEmpty
+	}
+
+	if strings.TrimSpace(taxClass) == "" {
+		return ErrProductTaxClassEmpty
+	}
+
+	money, err := models.NewMoney(price, currency)
+	if err != nil {
+		return err
+	}
+
+	p.Name = strings.TrimSpace(name)
+	p.Description = strings.TrimSpace(description)
+	p.Sku = strings.ToUpper(strings.TrimSpace(sku))
+	p.Price = money
+	p.TaxClass = strings.ToLower(strings.TrimSpace(taxClass))
+	return nil
+}
+
+func (p *Product) SetDimensions(weightGrams int32, lengthCm int32, widthCm int32, heightCm int32) error {
+	if weightGrams < 0 || lengthCm < 0 || widthCm < 0 || heightCm < 0 {
+		return ErrProductDimensionsNegative
+	}
+
+	p.WeightGrams = weightGrams
+	p.LengthCm = lengthCm
+	p.WidthCm = widthCm
+	p.HeightCm = heightCm
+	return nil
+}
+
+func (p *Product) Archive() error {
+	if !p.Active {
+		return ErrProductAlreadyArchived
+	}
+
+	p.Active = false
+	return nil
+}
@@ -0,0 +1,106 @@
+package product_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct_NewProduct_OnValidValues_ReturnsActiveProduct(t *testing.T) {
+	sut, err := product.NewProduct(" Mechanical Keyboard ", "Hot-swappable switches", " kb-001 ", 45990, "BRL", "standard")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Mechanical Keyboard", sut.Name)
+	assert.Equal(t, "Hot-swappable switches", sut.Description)
+	assert.Equal(t, "KB-001", sut.Sku)
+	assert.Equal(t, int64(45990), sut.Price.Value)
+	assert.Equal(t, "standard", sut.TaxClass)
+	assert.Equal(t, true, sut.Active)
+}
+
+func TestProduct_NewProduct_OnEmptyName_ReturnsError(t *testing.T) {
+	_, err := product.NewProduct("  ", "", "KB-001", 45990, "BRL", "standard")
+
+	assert.EqualError(t, err, "product name cannot be empty")
+}
+
+func TestProduct_NewProduct_OnEmptySku_ReturnsError(t *testing.T) {
+	_, err := product.NewProduct("Mechanical Keyboard", "", "", 45990, "BRL", "standard")
+
+	assert.EqualError(t, err, "product sku cannot be empty")
+}
+
+func TestProduct_NewProduct_OnNegativePrice_ReturnsError(t *testing.T) {
+	_, err := product.NewProduct("Mechanical Keyboard", "", "KB-001", -1, "BRL", "standard")
+
+	assert.EqualError(t, err, "money value cannot be negative")
+}
+
+func TestProduct_NewProduct_OnEmptyTaxClass_ReturnsError(t *testing.T) {
+	_, err := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", " ")
+
+	assert.EqualError(t, err, "product tax class cannot be empty")
+}
+
+func TestProduct_Update_OnValidValues_UpdatesProduct(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+
+	err := sut.Update("Wireless Keyboard", "Bluetooth", "KB-002", 39990, "BRL", " Books ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "books", sut.TaxClass)
+	assert.Equal(t, "Wireless Keyboard", sut.Name)
+	assert.Equal(t, "Bluetooth", sut.Description)
+	assert.Equal(t, "KB-002", sut.Sku)
+	assert.Equal(t, int64(39990), sut.Price.Value)
+}
+
+func TestProduct_Update_OnInvalidValues_KeepsProduct(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+
+	err := sut.Update("Wireless Keyboard", "", "KB-002", -5, "BRL", "standard")
+
+	assert.EqualError(t, err, "money value cannot be negative")
+	assert.Equal(t, "Mechanical Keyboard", sut.Name)
+	assert.Equal(t, int64(45990), sut.Price.Value)
+}
+
+func TestProduct_SetDimensions_OnValidValues_UpdatesProduct(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+
+	err := sut.SetDimensions(1200, 45, 15, 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1200), sut.WeightGrams)
+	assert.Equal(t, int32(45), sut.LengthCm)
+	assert.Equal(t, int32(15), sut.WidthCm)
+	assert.Equal(t, int32(4), sut.HeightCm)
+}
+
+func TestProduct_SetDimensions_OnNegativeValue_ReturnsError(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+
+	err := sut.SetDimensions(1200, 45, -15, 4)
+
+	assert.EqualError(t, err, "product dimensions cannot be negative")
+	assert.Equal(t, int32(0), sut.WeightGrams)
+}
+
+func TestProduct_Archive_OnActiveProduct_ArchivesProduct(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+
+	err := sut.Archive()
+
+	assert.NoError(t, err)
+	assert.Equal(t, false, sut.Active)
+}
+
+func TestProduct_Archive_OnArchivedProduct_ReturnsError(t *testing.T) {
+	sut, _ := product.NewProduct("Mechanical Keyboard", "", "KB-001", 45990, "BRL", "standard")
+	sut.Archive()
+
+	err := sut.Archive()
+
+	assert.EqualError(t, err, "product is already archived")
+}
@@ -0,0 +1,134 @@
+package promotion
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+)
+
+var (
+	ErrPromotionTypeInvalid     = errs.Validation("PROMOTION_TYPE_INVALID", "promotion type is invalid")
+	ErrCouponNotActiveYet       = errs.Conflict("COUPON_NOT_ACTIVE_YET", "coupon is not active yet")
+	ErrCouponExpired            = errs.Conflict("COUPON_EXPIRED", "coupon has expired")
+	ErrCouponUsageLimitReached  = errs.Conflict("COUPON_USAGE_LIMIT_REACHED", "coupon usage limit reached")
+	ErrCouponCurrencyMismatch   = errs.Validation("COUPON_CURRENCY_MISMATCH", "coupon currency does not match cart")
+	ErrCouponMinimumSpendNotMet = errs.Validation("COUPON_MINIMUM_SPEND_NOT_MET", "cart does not meet coupon minimum spend")
+	ErrCouponNotApplicable      = errs.Validation("COUPON_NOT_APPLICABLE", "cart does not qualify for coupon")
+)
+
+type PromotionType string
+
+const (
+	PercentageOff  PromotionType = "PERCENTAGE_OFF"
+	FixedAmountOff PromotionType = "FIXED_AMOUNT_OFF"
+	FreeShipping   PromotionType = "FREE_SHIPPING"
+	BuyXGetY       PromotionType = "BUY_X_GET_Y"
+)
+
+type Promotion struct {
+	Id           uuid.UUID
+	Code         string
+	Type         PromotionType
+	PercentOff   int32
+	AmountOff    models.Money
+	BuyQuantity  int32
+	GetQuantity  int32
+	ProductId    uuid.UUID
+	MinimumSpend models.Money
+	StartsAt     time.Time
+	EndsAt       time.Time
+	UsageLimit   int32
+	TimesUsed    int32
+}
+
+func (p *Promotion) Apply(customerCart cart.Cart, now time.Time) (cart.CartBreakdown, error) {
+	if now.Before(p.StartsAt) {
+		return cart.CartBreakdown{}, ErrCouponNotActiveYet
+	}
+
+	if !now.Before(p.EndsAt) {
+		return cart.CartBreakdown{}, ErrCouponExpired
+	}
+
+	if p.UsageLimit > 0 && p.TimesUsed >= p.UsageLimit {
+		return cart.CartBreakdown{}, ErrCouponUsageLimitReached
+	}
+
+	subtotal, err := customerCart.TotalPrice()
+	if err != nil {
+		return cart.CartBreakdown{}, err
+	}
+
+	if p.MinimumSpend.Value > 0 || p.Type == FixedAmountOff {
+		currency := p.MinimumSpend.Currency
+		if p.Type == FixedAmountOff {
+			currency = p.AmountOff.Currency
+		}
+
+		if currency != subtotal.Currency {
+			return cart.CartBreakdown{}, ErrCouponCurrencyMismatch
+		}
+	}
+
+	if subtotal.Value < p.MinimumSpend.Value {
+		return cart.CartBreakdown{}, ErrCouponMinimumSpendNotMet
+	}
+
+	switch p.Type {
+	case PercentageOff:
+		discount, err := subtotal.MultiplyRatio(int64(p.PercentOff), 100)
+		if err != nil {
+			return cart.CartBreakdown{}, err
+		}
+
+		return customerCart.Breakdown(discount, false)
+	case FixedAmountOff:
+		return customerCart.Breakdown(p.AmountOff, false)
+	case FreeShipping:
+		return customerCart.Breakdown(models.Money{Value: 0, Currency: subtotal.Currency}, true)
+	case BuyXGetY:
+		discount, err := p.buyXGetYDiscount(customerCart, subtotal.Currency)
+		if err != nil {
+			return cart.CartBreakdown{}, err
+		}
+
+		if discount.Value == 0 {
+			return cart.CartBreakdown{}, ErrCouponNotApplicable
+		}
+
+		return customerCart.Breakdown(discount, false)
+	}
+
+	return cart.CartBreakdown{}, ErrPromotionTypeInvalid
+}
+
+func (p *Promotion) buyXGetYDiscount(customerCart cart.Cart, currency models.Currency) (models.Money, error) {
+	discount := models.Money{Value: 0, Currency: currency}
+	if p.BuyQuantity < 1 || p.GetQuantity < 1 {
+		return discount, nil
+	}
+
+	bundleSize := p.BuyQuantity + p.GetQuantity
+
+	for _, item := range customerCart.Items {
+		if item.ProductId != p.ProductId {
+			continue
+		}
+
+		freeUnits := (item.Quantity.Value / bundleSize) * p.GetQuantity
+		itemDiscount, err := item.Price.Multiply(int64(freeUnits))
+		if err != nil {
+			return models.Money{}, err
+		}
+
+		discount, err = discount.Add(itemDiscount)
+		if err != nil {
+			return models.Money{}, err
+		}
+	}
+
+	return discount, nil
+}
@@ -0,0 +1,146 @@
+package promotion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+func newPromotion(promotionType promotion.PromotionType) promotion.Promotion {
+	return promotion.Promotion{
+		Id:       uuid.New(),
+		Code:     "SUMMER10",
+		Type:     promotionType,
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	}
+}
+
+func newCart(productId uuid.UUID, quantity int32, price int64) cart.Cart {
+	customerCart, _ := cart.NewCart(uuid.New())
+	customerCart.AddItem(productId, quantity, price, "BRL")
+	return customerCart
+}
+
+func TestPromotion_Apply_OnPercentageOff_DiscountsPercentageOfSubtotal(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.PercentOff = 10
+
+	breakdown, err := sut.Apply(newCart(uuid.New(), 3, 3550), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10650), breakdown.Subtotal.Value)
+	assert.Equal(t, int64(1065), breakdown.Discount.Value)
+	assert.Equal(t, int64(9585), breakdown.Total.Value)
+}
+
+func TestPromotion_Apply_OnFixedAmountOff_DiscountsAmount(t *testing.T) {
+	sut := newPromotion(promotion.FixedAmountOff)
+	sut.AmountOff = models.Money{Value: 2000, Currency: models.BRL}
+
+	breakdown, err := sut.Apply(newCart(uuid.New(), 2, 5000), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2000), breakdown.Discount.Value)
+	assert.Equal(t, int64(8000), breakdown.Total.Value)
+}
+
+func TestPromotion_Apply_OnFreeShipping_FlagsFreeShippingWithoutDiscount(t *testing.T) {
+	sut := newPromotion(promotion.FreeShipping)
+
+	breakdown, err := sut.Apply(newCart(uuid.New(), 2, 5000), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), breakdown.Discount.Value)
+	assert.Equal(t, true, breakdown.FreeShipping)
+}
+
+func TestPromotion_Apply_OnBuyXGetY_DiscountsFreeUnits(t *testing.T) {
+	productId := uuid.New()
+	sut := newPromotion(promotion.BuyXGetY)
+	sut.ProductId = productId
+	sut.BuyQuantity = 2
+	sut.GetQuantity = 1
+
+	breakdown, err := sut.Apply(newCart(productId, 7, 1000), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2000), breakdown.Discount.Value)
+	assert.Equal(t, int64(5000), breakdown.Total.Value)
+}
+
+func TestPromotion_Apply_OnBuyXGetYWithoutEnoughUnits_ReturnsError(t *testing.T) {
+	productId := uuid.New()
+	sut := newPromotion(promotion.BuyXGetY)
+	sut.ProductId = productId
+	sut.BuyQuantity = 2
+	sut.GetQuantity = 1
+
+	_, err := sut.Apply(newCart(productId, 2, 1000), now)
+
+	assert.EqualError(t, err, "cart does not qualify for coupon")
+}
+
+func TestPromotion_Apply_OnNotStarted_ReturnsError(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.StartsAt = now.Add(time.Minute)
+
+	_, err := sut.Apply(newCart(uuid.New(), 1, 1000), now)
+
+	assert.EqualError(t, err, "coupon is not active yet")
+}
+
+func TestPromotion_Apply_OnEnded_ReturnsError(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.EndsAt = now
+
+	_, err := sut.Apply(newCart(uuid.New(), 1, 1000), now)
+
+	assert.EqualError(t, err, "coupon has expired")
+}
+
+func TestPromotion_Apply_OnUsageLimitReached_ReturnsError(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.UsageLimit = 100
+	sut.TimesUsed = 100
+
+	_, err := sut.Apply(newCart(uuid.New(), 1, 1000), now)
+
+	assert.EqualError(t, err, "coupon usage limit reached")
+}
+
+func TestPromotion_Apply_OnSubtotalBelowMinimumSpend_ReturnsError(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.MinimumSpend = models.Money{Value: 5000, Currency: models.BRL}
+
+	_, err := sut.Apply(newCart(uuid.New(), 1, 4999), now)
+
+	assert.EqualError(t, err, "cart does not meet coupon minimum spend")
+}
+
+func TestPromotion_Apply_OnPercentageOffHalfCent_RoundsHalfToEven(t *testing.T) {
+	sut := newPromotion(promotion.PercentageOff)
+	sut.PercentOff = 10
+
+	breakdown, err := sut.Apply(newCart(uuid.New(), 1, 1025), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(102), breakdown.Discount.Value)
+	assert.Equal(t, int64(923), breakdown.Total.Value)
+}
+
+func TestPromotion_Apply_OnFixedAmountInOtherCurrency_ReturnsError(t *testing.T) {
+	sut := newPromotion(promotion.FixedAmountOff)
+	sut.AmountOff = models.Money{Value: 2000, Currency: models.USD}
+
+	_, err := sut.Apply(newCart(uuid.New(), 2, 5000), now)
+
+	assert.EqualError(t, err, "coupon currency does not match cart")
+}
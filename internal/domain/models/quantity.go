@@ -1,6 +1,8 @@
 package models
 
-import "errors"
+import "github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+
+var ErrQuantityNegative = errs.Validation("QUANTITY_NEGATIVE", "quantity value cannot be negative")
 
 type Quantity struct {
 	Value int32
@@ -8,7 +10,7 @@ type Quantity struct {
 
 func NewQuantity(value int32) (Quantity, error) {
 	if value < 0 {
-		return Quantity{}, errors.New("quantity value cannot be negative")
+		return Quantity{}, ErrQuantityNegative
 	}
 
 	return Quantity{
@@ -0,0 +1,26 @@
+package shipping
+
+type Parcel struct {
+	WeightGrams int64
+	VolumeCm3   int64
+}
+
+func (p Parcel) Add(weightGrams int64, lengthCm int64, widthCm int64, heightCm int64, quantity int32) Parcel {
+	return Parcel{
+		WeightGrams: p.WeightGrams + weightGrams*int64(quantity),
+		VolumeCm3:   p.VolumeCm3 + lengthCm*widthCm*heightCm*int64(quantity),
+	}
+}
+
+func (p Parcel) BillableWeightGrams(volumetricDivisor int64) int64 {
+	if volumetricDivisor <= 0 {
+		return p.WeightGrams
+	}
+
+	volumetricWeightGrams := (p.VolumeCm3*1000 + volumetricDivisor - 1) / volumetricDivisor
+	if volumetricWeightGrams > p.WeightGrams {
+		return volumetricWeightGrams
+	}
+
+	return p.WeightGrams
+}
@@ -0,0 +1,35 @@
+package shipping_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParcel_Add_OnItems_AccumulatesWeightAndVolume(t *testing.T) {
+	sut := shipping.Parcel{}
+
+	sut = sut.Add(500, 10, 10, 10, 2)
+	sut = sut.Add(300, 20, 10, 5, 1)
+
+	assert.Equal(t, shipping.Parcel{WeightGrams: 1300, VolumeCm3: 3000}, sut)
+}
+
+func TestParcel_BillableWeightGrams_OnDenseParcel_ReturnsActualWeight(t *testing.T) {
+	sut := shipping.Parcel{WeightGrams: 2000, VolumeCm3: 1000}
+
+	assert.Equal(t, int64(2000), sut.BillableWeightGrams(5000))
+}
+
+func TestParcel_BillableWeightGrams_OnBulkyParcel_ReturnsVolumetricWeight(t *testing.T) {
+	sut := shipping.Parcel{WeightGrams: 500, VolumeCm3: 40 * 30 * 20}
+
+	assert.Equal(t, int64(4800), sut.BillableWeightGrams(5000))
+}
+
+func TestParcel_BillableWeightGrams_OnFractionalVolumetricWeight_RoundsUp(t *testing.T) {
+	sut := shipping.Parcel{WeightGrams: 0, VolumeCm3: 7}
+
+	assert.Equal(t, int64(2), sut.BillableWeightGrams(5000))
+}
@@ -0,0 +1,147 @@
+package shipping
+
+import (
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+var (
+	ErrShippingMethodCodeEmpty         = errs.Validation("SHIPPING_METHOD_CODE_EMPTY", "shipping method code cannot be empty")
+	ErrShippingRateTypeNotSupported    = errs.Validation("SHIPPING_RATE_TYPE_NOT_SUPPORTED", "shipping rate type is not supported")
+	ErrShippingMethodNoWeightBands     = errs.Validation("SHIPPING_METHOD_NO_WEIGHT_BANDS", "shipping method needs at least one weight band")
+	ErrShippingWeightBandRateInvalid   = errs.Validation("SHIPPING_WEIGHT_BAND_RATE_INVALID", "shipping weight band rate is invalid")
+	ErrShippingWeightBandsNotAscending = errs.Validation("SHIPPING_WEIGHT_BANDS_NOT_ASCENDING", "shipping weight bands must be in ascending order")
+	ErrShippingMethodCurrencyMismatch  = errs.Validation("SHIPPING_METHOD_CURRENCY_MISMATCH", "shipping method currency does not match cart")
+	ErrShippingWeightNotSupported      = errs.Validation("SHIPPING_WEIGHT_NOT_SUPPORTED", "shipping method cannot ship this weight")
+)
+
+type RateType string
+
+const (
+	FlatRate              RateType = "FLAT"
+	WeightBandRate        RateType = "WEIGHT_BAND"
+	FreeOverThresholdRate RateType = "FREE_OVER_THRESHOLD"
+)
+
+const DefaultVolumetricDivisor = 5000
+
+type WeightBand struct {
+	MaxWeightGrams int64
+	Rate           models.Money
+}
+
+type ShippingMethod struct {
+	Code              string
+	Name              string
+	RateType          RateType
+	Rate              models.Money
+	WeightBands       []WeightBand
+	FreeOverThreshold models.Money
+	VolumetricDivisor int64
+}
+
+type ShippingQuote struct {
+	MethodCode string
+	MethodName string
+	Cost       models.Money
+}
+
+func NewShippingMethod(code string, name string, rateType string, currency string, rate int64, weightBands []WeightBand,
+	freeOverThreshold int64, volumetricDivisor int64) (ShippingMethod, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return ShippingMethod{}, ErrShippingMethodCodeEmpty
+	}
+
+	switch RateType(rateType) {
+	case FlatRate, WeightBandRate, FreeOverThresholdRate:
+	default:
+		return ShippingMethod{}, ErrShippingRateTypeNotSupported
+	}
+
+	rateMoney, err := models.NewMoney(rate, currency)
+	if err != nil {
+		return ShippingMethod{}, err
+	}
+
+	threshold, err := models.NewMoney(freeOverThreshold, currency)
+	if err != nil {
+		return ShippingMethod{}, err
+	}
+
+	if RateType(rateType) == WeightBandRate && len(weightBands) == 0 {
+		return ShippingMethod{}, ErrShippingMethodNoWeightBands
+	}
+
+	bands := []WeightBand{}
+	for i, band := range weightBands {
+		if band.Rate.Currency != rateMoney.Currency || band.Rate.Value < 0 {
+			return ShippingMethod{}, ErrShippingWeightBandRateInvalid
+		}
+
+		if i > 0 && band.MaxWeightGrams <= weightBands[i-1].MaxWeightGrams {
+			return ShippingMethod{}, ErrShippingWeightBandsNotAscending
+		}
+
+		bands = append(bands, band)
+	}
+
+	if volumetricDivisor <= 0 {
+		volumetricDivisor = DefaultVolumetricDivisor
+	}
+
+	return ShippingMethod{
+		Code:              code,
+		Name:              strings.TrimSpace(name),
+		RateType:          RateType(rateType),
+		Rate:              rateMoney,
+		WeightBands:       bands,
+		FreeOverThreshold: threshold,
+		VolumetricDivisor: volumetricDivisor,
+	}, nil
+}
+
+func (s *ShippingMethod) Quote(parcel Parcel, subtotal models.Money, freeShipping bool) (ShippingQuote, error) {
+	if subtotal.Currency != s.Rate.Currency {
+		return ShippingQuote{}, ErrShippingMethodCurrencyMismatch
+	}
+
+	cost, err := s.cost(parcel, subtotal)
+	if err != nil {
+		return ShippingQuote{}, err
+	}
+
+	if freeShipping {
+		cost = models.Money{Value: 0, Currency: s.Rate.Currency}
+	}
+
+	return ShippingQuote{
+		MethodCode: s.Code,
+		MethodName: s.Name,
+		Cost:       cost,
+	}, nil
+}
+
+func (s *ShippingMethod) cost(parcel Parcel, subtotal models.Money) (models.Money, error) {
+	switch s.RateType {
+	case WeightBandRate:
+		billableWeight := parcel.BillableWeightGrams(s.VolumetricDivisor)
+		for _, band := range s.WeightBands {
+			if billableWeight <= band.MaxWeightGrams {
+				return band.Rate, nil
+			}
+		}
+
+		return models.Money{}, ErrShippingWeightNotSupported
+	case FreeOverThresholdRate:
+		if subtotal.Value >= s.FreeOverThreshold.Value {
+			return models.Money{Value: 0, Currency: s.Rate.Currency}, nil
+		}
+
+		return s.Rate, nil
+	}
+
+	return s.Rate, nil
+}
@@ -0,0 +1,138 @@
+package shipping_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWeightBandMethod() shipping.ShippingMethod {
+	method, _ := shipping.NewShippingMethod("standard", "Standard", "WEIGHT_BAND", "BRL", 0, []shipping.WeightBand{
+		{MaxWeightGrams: 1000, Rate: models.Money{Value: 1990, Currency: models.BRL}},
+		{MaxWeightGrams: 5000, Rate: models.Money{Value: 2990, Currency: models.BRL}},
+	}, 0, 0)
+
+	return method
+}
+
+func TestShippingMethod_NewShippingMethod_OnValidValues_ReturnsShippingMethod(t *testing.T) {
+	sut, err := shipping.NewShippingMethod(" express ", " Express ", "FLAT", "BRL", 4990, nil, 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EXPRESS", sut.Code)
+	assert.Equal(t, "Express", sut.Name)
+	assert.Equal(t, shipping.FlatRate, sut.RateType)
+	assert.Equal(t, models.Money{Value: 4990, Currency: models.BRL}, sut.Rate)
+	assert.Equal(t, int64(shipping.DefaultVolumetricDivisor), sut.VolumetricDivisor)
+}
+
+func TestShippingMethod_NewShippingMethod_OnEmptyCode_ReturnsError(t *testing.T) {
+	_, err := shipping.NewShippingMethod(" ", "Express", "FLAT", "BRL", 4990, nil, 0, 0)
+
+	assert.EqualError(t, err, "shipping method code cannot be empty")
+}
+
+func TestShippingMethod_NewShippingMethod_OnUnsupportedRateType_ReturnsError(t *testing.T) {
+	_, err := shipping.NewShippingMethod("EXPRESS", "Express", "BY_DISTANCE", "BRL", 4990, nil, 0, 0)
+
+	assert.EqualError(t, err, "shipping rate type is not supported")
+}
+
+func TestShippingMethod_NewShippingMethod_OnWeightBandWithoutBands_ReturnsError(t *testing.T) {
+	_, err := shipping.NewShippingMethod("STANDARD", "Standard", "WEIGHT_BAND", "BRL", 0, nil, 0, 0)
+
+	assert.EqualError(t, err, "shipping method needs at least one weight band")
+}
+
+func TestShippingMethod_NewShippingMethod_OnUnorderedBands_ReturnsError(t *testing.T) {
+	_, err := shipping.NewShippingMethod("STANDARD", "Standard", "WEIGHT_BAND", "BRL", 0, []shipping.WeightBand{
+		{MaxWeightGrams: 5000, Rate: models.Money{Value: 2990, Currency: models.BRL}},
+		{MaxWeightGrams: 1000, Rate: models.Money{Value: 1990, Currency: models.BRL}},
+	}, 0, 0)
+
+	assert.EqualError(t, err, "shipping weight bands must be in ascending order")
+}
+
+func TestShippingMethod_NewShippingMethod_OnBandInAnotherCurrency_ReturnsError(t *testing.T) {
+	_, err := shipping.NewShippingMethod("STANDARD", "Standard", "WEIGHT_BAND", "BRL", 0, []shipping.WeightBand{
+		{MaxWeightGrams: 1000, Rate: models.Money{Value: 1990, Currency: models.USD}},
+	}, 0, 0)
+
+	assert.EqualError(t, err, "shipping weight band rate is invalid")
+}
+
+func TestShippingMethod_Quote_OnFlatRate_ReturnsFlatRate(t *testing.T) {
+	sut, _ := shipping.NewShippingMethod("EXPRESS", "Express", "FLAT", "BRL", 4990, nil, 0, 0)
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 25000}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, shipping.ShippingQuote{
+		MethodCode: "EXPRESS",
+		MethodName: "Express",
+		Cost:       models.Money{Value: 4990, Currency: models.BRL},
+	}, quote)
+}
+
+func TestShippingMethod_Quote_OnWeightBand_ReturnsFirstBandThatFits(t *testing.T) {
+	sut := newWeightBandMethod()
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 1001}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2990), quote.Cost.Value)
+}
+
+func TestShippingMethod_Quote_OnBulkyParcel_UsesVolumetricWeight(t *testing.T) {
+	sut := newWeightBandMethod()
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 300, VolumeCm3: 30 * 20 * 10}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2990), quote.Cost.Value)
+}
+
+func TestShippingMethod_Quote_OnWeightAboveLastBand_ReturnsError(t *testing.T) {
+	sut := newWeightBandMethod()
+
+	_, err := sut.Quote(shipping.Parcel{WeightGrams: 5001}, models.Money{Value: 10000, Currency: models.BRL}, false)
+
+	assert.EqualError(t, err, "shipping method cannot ship this weight")
+}
+
+func TestShippingMethod_Quote_OnSubtotalAboveThreshold_ReturnsFreeShipping(t *testing.T) {
+	sut, _ := shipping.NewShippingMethod("ECONOMY", "Economy", "FREE_OVER_THRESHOLD", "BRL", 1490, nil, 29900, 0)
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 1000}, models.Money{Value: 29900, Currency: models.BRL}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 0, Currency: models.BRL}, quote.Cost)
+}
+
+func TestShippingMethod_Quote_OnSubtotalBelowThreshold_ReturnsRate(t *testing.T) {
+	sut, _ := shipping.NewShippingMethod("ECONOMY", "Economy", "FREE_OVER_THRESHOLD", "BRL", 1490, nil, 29900, 0)
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 1000}, models.Money{Value: 29899, Currency: models.BRL}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1490), quote.Cost.Value)
+}
+
+func TestShippingMethod_Quote_OnFreeShipping_ReturnsZeroCost(t *testing.T) {
+	sut := newWeightBandMethod()
+
+	quote, err := sut.Quote(shipping.Parcel{WeightGrams: 800}, models.Money{Value: 10000, Currency: models.BRL}, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Money{Value: 0, Currency: models.BRL}, quote.Cost)
+}
+
+func TestShippingMethod_Quote_OnCartInAnotherCurrency_ReturnsError(t *testing.T) {
+	sut := newWeightBandMethod()
+
+	_, err := sut.Quote(shipping.Parcel{WeightGrams: 800}, models.Money{Value: 10000, Currency: models.USD}, false)
+
+	assert.EqualError(t, err, "shipping method currency does not match cart")
+}
@@ -0,0 +1,188 @@
+package tax
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+)
+
+var (
+	ErrTaxCountryEmpty = errs.Validation("TAX_COUNTRY_EMPTY", "tax country cannot be empty")
+	ErrTaxRuleNotFound = errs.NotFound("TAX_RULE_NOT_FOUND", "tax rule not found")
+)
+
+type TaxableLine struct {
+	ProductId uuid.UUID
+	TaxClass  string
+	Amount    models.Money
+}
+
+type TaxLine struct {
+	ProductId       uuid.UUID
+	TaxClass        string
+	RateBasisPoints int64
+	Inclusive       bool
+	Net             models.Money
+	Tax             models.Money
+	Gross           models.Money
+}
+
+type TaxCalculation struct {
+	Country string
+	Region  string
+	Lines   []TaxLine
+	Net     models.Money
+	Tax     models.Money
+	Gross   models.Money
+}
+
+func Calculate(country string, region string, rules []TaxRule, lines []TaxableLine, discount models.Money) (TaxCalculation, error) {
+	country = NormalizeCountry(country)
+	if country == "" {
+		return TaxCalculation{}, ErrTaxCountryEmpty
+	}
+
+	region = NormalizeRegion(region)
+
+	currency := models.Currency{}
+	if len(lines) > 0 {
+		currency = lines[0].Amount.Currency
+	}
+
+	calculation := TaxCalculation{
+		Country: country,
+		Region:  region,
+		Lines:   []TaxLine{},
+		Net:     models.Money{Value: 0, Currency: currency},
+		Tax:     models.Money{Value: 0, Currency: currency},
+		Gross:   models.Money{Value: 0, Currency: currency},
+	}
+
+	amounts, err := discountedAmounts(lines, discount)
+	if err != nil {
+		return TaxCalculation{}, err
+	}
+
+	for i, line := range lines {
+		rule, err := findRule(country, region, rules, line.TaxClass)
+		if err != nil {
+			return TaxCalculation{}, err
+		}
+
+		taxLine, err := calculateLine(line, amounts[i], rule)
+		if err != nil {
+			return TaxCalculation{}, err
+		}
+
+		calculation.Lines = append(calculation.Lines, taxLine)
+
+		if calculation.Net, err = calculation.Net.Add(taxLine.Net); err != nil {
+			return TaxCalculation{}, err
+		}
+
+		if calculation.Tax, err = calculation.Tax.Add(taxLine.Tax); err != nil {
+			return TaxCalculation{}, err
+		}
+
+		if calculation.Gross, err = calculation.Gross.Add(taxLine.Gross); err != nil {
+			return TaxCalculation{}, err
+		}
+	}
+
+	return calculation, nil
+}
+
+func discountedAmounts(lines []TaxableLine, discount models.Money) ([]models.Money, error) {
+	amounts := []models.Money{}
+	ratios := []int64{}
+	for _, line := range lines {
+		amounts = append(amounts, line.Amount)
+		ratios = append(ratios, line.Amount.Value)
+	}
+
+	if discount.Value == 0 || len(lines) == 0 {
+		return amounts, nil
+	}
+
+	shares, err := discount.Allocate(ratios...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range amounts {
+		if shares[i].Value > amounts[i].Value {
+			shares[i].Value = amounts[i].Value
+		}
+
+		amounts[i], err = amounts[i].Subtract(shares[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return amounts, nil
+}
+
+func findRule(country string, region string, rules []TaxRule, taxClass string) (TaxRule, error) {
+	rule, err := findRegionRule(country, region, rules, taxClass)
+	if err == nil || region == "" {
+		return rule, err
+	}
+
+	return findRegionRule(country, "", rules, taxClass)
+}
+
+func findRegionRule(country string, region string, rules []TaxRule, taxClass string) (TaxRule, error) {
+	taxClass = NormalizeTaxClass(taxClass)
+
+	var fallback *TaxRule
+	for i, rule := range rules {
+		if rule.Country != country || rule.Region != region {
+			continue
+		}
+
+		if rule.TaxClass == taxClass {
+			return rule, nil
+		}
+
+		if rule.TaxClass == AnyTaxClass {
+			fallback = &rules[i]
+		}
+	}
+
+	if fallback == nil {
+		return TaxRule{}, ErrTaxRuleNotFound
+	}
+
+	return *fallback, nil
+}
+
+func calculateLine(line TaxableLine, amount models.Money, rule TaxRule) (TaxLine, error) {
+	taxLine := TaxLine{
+		ProductId:       line.ProductId,
+		TaxClass:        NormalizeTaxClass(line.TaxClass),
+		RateBasisPoints: rule.RateBasisPoints,
+		Inclusive:       rule.Inclusive,
+	}
+
+	var err error
+	if rule.Inclusive {
+		taxLine.Gross = amount
+		taxLine.Tax, err = amount.MultiplyRatio(rule.RateBasisPoints, 10000+rule.RateBasisPoints)
+		if err != nil {
+			return TaxLine{}, err
+		}
+
+		taxLine.Net, err = amount.Subtract(taxLine.Tax)
+		return taxLine, err
+	}
+
+	taxLine.Net = amount
+	taxLine.Tax, err = amount.MultiplyRatio(rule.RateBasisPoints, 10000)
+	if err != nil {
+		return TaxLine{}, err
+	}
+
+	taxLine.Gross, err = amount.Add(taxLine.Tax)
+	return taxLine, err
+}
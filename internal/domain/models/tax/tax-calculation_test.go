@@ -0,0 +1,143 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func brl(value int64) models.Money {
+	return models.Money{Value: value, Currency: models.BRL}
+}
+
+func TestTaxCalculation_Calculate_OnExclusiveRule_AddsTaxOnTop(t *testing.T) {
+	productId := uuid.New()
+	rules := []tax.TaxRule{{Country: "US", Region: "NY", TaxClass: "standard", RateBasisPoints: 888}}
+
+	sut, err := tax.Calculate("us", "ny", rules, []tax.TaxableLine{{ProductId: productId, TaxClass: "standard", Amount: brl(10000)}}, models.Money{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, tax.TaxCalculation{
+		Country: "US",
+		Region:  "NY",
+		Lines: []tax.TaxLine{
+			{ProductId: productId, TaxClass: "standard", RateBasisPoints: 888, Net: brl(10000), Tax: brl(888), Gross: brl(10888)},
+		},
+		Net:   brl(10000),
+		Tax:   brl(888),
+		Gross: brl(10888),
+	}, sut)
+}
+
+func TestTaxCalculation_Calculate_OnInclusiveRule_ExtractsTaxFromPrice(t *testing.T) {
+	productId := uuid.New()
+	rules := []tax.TaxRule{{Country: "BR", Region: "SP", TaxClass: "standard", RateBasisPoints: 1800, Inclusive: true}}
+
+	sut, err := tax.Calculate("BR", "SP", rules, []tax.TaxableLine{{ProductId: productId, TaxClass: "standard", Amount: brl(11800)}}, models.Money{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []tax.TaxLine{
+		{ProductId: productId, TaxClass: "standard", RateBasisPoints: 1800, Inclusive: true, Net: brl(10000), Tax: brl(1800), Gross: brl(11800)},
+	}, sut.Lines)
+	assert.Equal(t, brl(11800), sut.Gross)
+}
+
+func TestTaxCalculation_Calculate_OnMultipleTaxClasses_UsesMatchingRuleOrRegionFallback(t *testing.T) {
+	book := uuid.New()
+	keyboard := uuid.New()
+	rules := []tax.TaxRule{
+		{Country: "BR", Region: "SP", TaxClass: tax.AnyTaxClass, RateBasisPoints: 1800, Inclusive: true},
+		{Country: "BR", Region: "SP", TaxClass: "books", RateBasisPoints: 0, Inclusive: true},
+		{Country: "BR", Region: "RJ", TaxClass: "books", RateBasisPoints: 500, Inclusive: true},
+	}
+
+	sut, err := tax.Calculate("BR", "SP", rules, []tax.TaxableLine{
+		{ProductId: book, TaxClass: "books", Amount: brl(5000)},
+		{ProductId: keyboard, TaxClass: "standard", Amount: brl(11800)},
+	}, models.Money{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sut.Lines[0].Tax.Value)
+	assert.Equal(t, int64(1800), sut.Lines[1].Tax.Value)
+	assert.Equal(t, brl(1800), sut.Tax)
+	assert.Equal(t, brl(15000), sut.Net)
+	assert.Equal(t, brl(16800), sut.Gross)
+}
+
+func TestTaxCalculation_Calculate_OnDiscount_TaxesDiscountedAmountsProportionally(t *testing.T) {
+	rules := []tax.TaxRule{{Country: "US", Region: "NY", TaxClass: "standard", RateBasisPoints: 1000}}
+
+	sut, err := tax.Calculate("US", "NY", rules, []tax.TaxableLine{
+		{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(3000)},
+		{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(1000)},
+	}, brl(1000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, brl(2250), sut.Lines[0].Net)
+	assert.Equal(t, brl(225), sut.Lines[0].Tax)
+	assert.Equal(t, brl(750), sut.Lines[1].Net)
+	assert.Equal(t, brl(75), sut.Lines[1].Tax)
+	assert.Equal(t, brl(3300), sut.Gross)
+}
+
+func TestTaxCalculation_Calculate_OnHalfCentTax_RoundsHalfToEven(t *testing.T) {
+	rules := []tax.TaxRule{{Country: "US", Region: "NY", TaxClass: "standard", RateBasisPoints: 500}}
+
+	sut, _ := tax.Calculate("US", "NY", rules, []tax.TaxableLine{
+		{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(50)},
+		{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(70)},
+	}, models.Money{})
+
+	assert.Equal(t, int64(2), sut.Lines[0].Tax.Value)
+	assert.Equal(t, int64(4), sut.Lines[1].Tax.Value)
+}
+
+func TestTaxCalculation_Calculate_OnNoMatchingRule_ReturnsError(t *testing.T) {
+	rules := []tax.TaxRule{{Country: "BR", Region: "SP", TaxClass: "books", RateBasisPoints: 0}}
+
+	_, err := tax.Calculate("BR", "SP", rules, []tax.TaxableLine{{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(1000)}}, models.Money{})
+
+	assert.EqualError(t, err, "tax rule not found")
+}
+
+func TestTaxCalculation_Calculate_OnSameRegionInAnotherCountry_ReturnsError(t *testing.T) {
+	rules := []tax.TaxRule{{Country: "BR", Region: "PA", TaxClass: tax.AnyTaxClass, RateBasisPoints: 1900, Inclusive: true}}
+
+	_, err := tax.Calculate("US", "PA", rules, []tax.TaxableLine{{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(1000)}}, models.Money{})
+
+	assert.EqualError(t, err, "tax rule not found")
+}
+
+func TestTaxCalculation_Calculate_OnAddressWithoutRegion_UsesCountryWideRule(t *testing.T) {
+	rules := []tax.TaxRule{
+		{Country: "PT", TaxClass: tax.AnyTaxClass, RateBasisPoints: 2300, Inclusive: true},
+		{Country: "PT", TaxClass: "books", RateBasisPoints: 600, Inclusive: true},
+	}
+
+	sut, err := tax.Calculate("PT", "", rules, []tax.TaxableLine{{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(12300)}}, models.Money{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, brl(2300), sut.Tax)
+	assert.Equal(t, "", sut.Region)
+}
+
+func TestTaxCalculation_Calculate_OnRegionWithoutRules_FallsBackToCountryWideRule(t *testing.T) {
+	rules := []tax.TaxRule{
+		{Country: "US", Region: "PA", TaxClass: tax.AnyTaxClass, RateBasisPoints: 600},
+		{Country: "US", TaxClass: tax.AnyTaxClass, RateBasisPoints: 0},
+	}
+
+	sut, err := tax.Calculate("US", "OR", rules, []tax.TaxableLine{{ProductId: uuid.New(), TaxClass: "standard", Amount: brl(1000)}}, models.Money{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, brl(0), sut.Tax)
+}
+
+func TestTaxCalculation_Calculate_OnEmptyCountry_ReturnsError(t *testing.T) {
+	_, err := tax.Calculate(" ", "SP", []tax.TaxRule{}, []tax.TaxableLine{}, models.Money{})
+
+	assert.EqualError(t, err, "tax country cannot be empty")
+}
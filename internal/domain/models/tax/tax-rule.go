@@ -0,0 +1,67 @@
+package tax
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+)
+
+var (
+	ErrTaxRuleCountryEmpty  = errs.Validation("TAX_RULE_COUNTRY_EMPTY", "tax rule country cannot be empty")
+	ErrTaxRuleTaxClassEmpty = errs.Validation("TAX_RULE_TAX_CLASS_EMPTY", "tax rule tax class cannot be empty")
+	ErrTaxRateOutOfRange    = errs.Validation("TAX_RATE_OUT_OF_RANGE", "tax rate must be a percentage between 0 and 100")
+	ErrTaxRateTooPrecise    = errs.Validation("TAX_RATE_TOO_PRECISE", "tax rate cannot have more than two decimal places")
+)
+
+const AnyTaxClass = "*"
+
+type TaxRule struct {
+	Country         string
+	Region          string
+	TaxClass        string
+	RateBasisPoints int64
+	Inclusive       bool
+}
+
+func NewTaxRule(country string, region string, taxClass string, rate string, inclusive bool) (TaxRule, error) {
+	country = NormalizeCountry(country)
+	if country == "" {
+		return TaxRule{}, ErrTaxRuleCountryEmpty
+	}
+
+	taxClass = NormalizeTaxClass(taxClass)
+	if taxClass == "" {
+		return TaxRule{}, ErrTaxRuleTaxClassEmpty
+	}
+
+	percent, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || percent.Sign() < 0 || percent.Cmp(big.NewRat(100, 1)) > 0 {
+		return TaxRule{}, ErrTaxRateOutOfRange
+	}
+
+	basisPoints := new(big.Rat).Mul(percent, big.NewRat(100, 1))
+	if !basisPoints.IsInt() {
+		return TaxRule{}, ErrTaxRateTooPrecise
+	}
+
+	return TaxRule{
+		Country:         country,
+		Region:          NormalizeRegion(region),
+		TaxClass:        taxClass,
+		RateBasisPoints: basisPoints.Num().Int64(),
+		Inclusive:       inclusive,
+	}, nil
+}
+
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+func NormalizeTaxClass(taxClass string) string {
+	return strings.ToLower(strings.TrimSpace(taxClass))
+}
@@ -0,0 +1,55 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxRule_NewTaxRule_OnValidValues_ReturnsTaxRule(t *testing.T) {
+	sut, err := tax.NewTaxRule(" br ", " sp ", " Standard ", "7.25", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tax.TaxRule{Country: "BR", Region: "SP", TaxClass: "standard", RateBasisPoints: 725, Inclusive: false}, sut)
+}
+
+func TestTaxRule_NewTaxRule_OnZeroRate_ReturnsTaxRule(t *testing.T) {
+	sut, err := tax.NewTaxRule("BR", "SP", "books", "0", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sut.RateBasisPoints)
+}
+
+func TestTaxRule_NewTaxRule_OnEmptyRegion_ReturnsCountryWideRule(t *testing.T) {
+	sut, err := tax.NewTaxRule("PT", " ", "standard", "23", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tax.TaxRule{Country: "PT", Region: "", TaxClass: "standard", RateBasisPoints: 2300, Inclusive: true}, sut)
+}
+
+func TestTaxRule_NewTaxRule_OnEmptyCountry_ReturnsError(t *testing.T) {
+	_, err := tax.NewTaxRule(" ", "SP", "standard", "18", true)
+
+	assert.EqualError(t, err, "tax rule country cannot be empty")
+}
+
+func TestTaxRule_NewTaxRule_OnEmptyTaxClass_ReturnsError(t *testing.T) {
+	_, err := tax.NewTaxRule("BR", "SP", "", "18", true)
+
+	assert.EqualError(t, err, "tax rule tax class cannot be empty")
+}
+
+func TestTaxRule_NewTaxRule_OnInvalidRate_ReturnsError(t *testing.T) {
+	for _, rate := range []string{"abc", "-1", "100.01", ""} {
+		_, err := tax.NewTaxRule("BR", "SP", "standard", rate, true)
+
+		assert.EqualError(t, err, "tax rate must be a percentage between 0 and 100")
+	}
+}
+
+func TestTaxRule_NewTaxRule_OnTooPreciseRate_ReturnsError(t *testing.T) {
+	_, err := tax.NewTaxRule("BR", "SP", "standard", "7.125", true)
+
+	assert.EqualError(t, err, "tax rate cannot have more than two decimal places")
+}
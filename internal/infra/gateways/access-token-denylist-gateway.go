@@ -0,0 +1,29 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+)
+
+type AccessTokenDenylistGateway struct {
+	Conn database.IConn
+}
+
+func (a *AccessTokenDenylistGateway) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	var revoked bool
+	err := a.Conn.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM revoked_access_tokens WHERE id = $1)", tokenId).Scan(&revoked)
+	if err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (a *AccessTokenDenylistGateway) Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error {
+	_, err := a.Conn.Exec(ctx,
+		"INSERT INTO revoked_access_tokens (id, expires_at) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING", tokenId, expiresAt)
+	return err
+}
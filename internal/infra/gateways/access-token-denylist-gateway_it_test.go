@@ -0,0 +1,101 @@
+package gateways_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type AccessTokenDenylistGatewaySuite struct {
+	conn                       *pgx.Conn
+	accessTokenDenylistGateway gateways.AccessTokenDenylistGateway
+	postgresContainer          testcontainers.Container
+	suite.Suite
+}
+
+func (a *AccessTokenDenylistGatewaySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	a.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	a.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	a.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	a.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS revoked_access_tokens (
+			id VARCHAR(64) PRIMARY KEY,
+			expires_at TIMESTAMPTZ NOT NULL,
+			revoked_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+  `)
+	a.Require().NoError(err)
+
+	a.conn = conn
+	a.postgresContainer = postgresContainer
+	a.accessTokenDenylistGateway = gateways.AccessTokenDenylistGateway{
+		Conn: conn,
+	}
+}
+
+func (a *AccessTokenDenylistGatewaySuite) TearDownTest() {
+	a.postgresContainer.Terminate(context.Background())
+}
+
+func (a *AccessTokenDenylistGatewaySuite) TestAccessTokenDenylistGateway_IsRevoked_OnRevokedToken_ReturnsTrue() {
+	err := a.accessTokenDenylistGateway.Revoke(context.Background(), "token-id", time.Now().Add(15*time.Minute))
+	a.Require().NoError(err)
+
+	revoked, err := a.accessTokenDenylistGateway.IsRevoked(context.Background(), "token-id")
+
+	a.NoError(err)
+	a.True(revoked)
+}
+
+func (a *AccessTokenDenylistGatewaySuite) TestAccessTokenDenylistGateway_IsRevoked_OnUnknownToken_ReturnsFalse() {
+	revoked, err := a.accessTokenDenylistGateway.IsRevoked(context.Background(), "token-id")
+
+	a.NoError(err)
+	a.False(revoked)
+}
+
+func (a *AccessTokenDenylistGatewaySuite) TestAccessTokenDenylistGateway_Revoke_OnAlreadyRevokedToken_DoesNothing() {
+	err := a.accessTokenDenylistGateway.Revoke(context.Background(), "token-id", time.Now().Add(15*time.Minute))
+	a.Require().NoError(err)
+
+	err = a.accessTokenDenylistGateway.Revoke(context.Background(), "token-id", time.Now().Add(15*time.Minute))
+
+	a.NoError(err)
+}
+
+func TestAccessTokenDenylistGateway(t *testing.T) {
+	suite.Run(t, new(AccessTokenDenylistGatewaySuite))
+}
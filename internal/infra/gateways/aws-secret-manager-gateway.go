@@ -15,12 +15,12 @@ type AwsSecretManagerGateway struct {
 	SecretManager *secretsmanager.Client
 }
 
-func (s *AwsSecretManagerGateway) Get(key string) (string, error) {
+func (s *AwsSecretManagerGateway) Get(ctx context.Context, key string) (string, error) {
 	if _, ok := os.LookupEnv("AWS_SECRET_MANAGER_NAME"); !ok {
 		return "", errors.New("environment variable 'AWS_SECRET_MANAGER_NAME' not set")
 	}
 
-	result, err := s.SecretManager.GetSecretValue(context.TODO(), &secretsmanager.GetSecretValueInput{
+	result, err := s.SecretManager.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
 		SecretId: aws.String(os.Getenv("AWS_SECRET_MANAGER_NAME")),
 	})
 
@@ -0,0 +1,38 @@
+package gateways
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptPasswordHasherGateway struct {
+	Cost int
+}
+
+func (b *BcryptPasswordHasherGateway) Hash(password string) (string, error) {
+	cost := b.Cost
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(passwordHash), nil
+}
+
+func (b *BcryptPasswordHasherGateway) Compare(passwordHash string, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+
+	return false, err
+}
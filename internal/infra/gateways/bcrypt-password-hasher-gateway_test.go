@@ -0,0 +1,39 @@
+package gateways_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcryptPasswordHasherGateway_Hash_OnPassword_ReturnsVerifiableHash(t *testing.T) {
+	sut := gateways.BcryptPasswordHasherGateway{Cost: bcrypt.MinCost}
+
+	passwordHash, err := sut.Hash("correct horse battery")
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, "correct horse battery", passwordHash)
+	matches, err := sut.Compare(passwordHash, "correct horse battery")
+	assert.NoError(t, err)
+	assert.Equal(t, true, matches)
+}
+
+func TestBcryptPasswordHasherGateway_Compare_OnWrongPassword_ReturnsFalse(t *testing.T) {
+	sut := gateways.BcryptPasswordHasherGateway{Cost: bcrypt.MinCost}
+	passwordHash, _ := sut.Hash("correct horse battery")
+
+	matches, err := sut.Compare(passwordHash, "wrong horse battery")
+
+	assert.NoError(t, err)
+	assert.Equal(t, false, matches)
+}
+
+func TestBcryptPasswordHasherGateway_Compare_OnMalformedHash_ReturnsError(t *testing.T) {
+	sut := gateways.BcryptPasswordHasherGateway{Cost: bcrypt.MinCost}
+
+	_, err := sut.Compare("not-a-hash", "correct horse battery")
+
+	assert.Error(t, err)
+}
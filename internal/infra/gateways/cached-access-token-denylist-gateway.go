@@ -0,0 +1,70 @@
+package gateways
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type cachedRevocation struct {
+	revoked   bool
+	fetchedAt time.Time
+}
+
+type CachedAccessTokenDenylistGateway struct {
+	AccessTokenDenylistGateway gateways.IAccessTokenDenylistGateway
+	ClockGateway               gateways.IClockGateway
+	Ttl                        time.Duration
+
+	mutex sync.Mutex
+	cache map[string]cachedRevocation
+}
+
+func (c *CachedAccessTokenDenylistGateway) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.ClockGateway.Now()
+
+	if cached, ok := c.cache[tokenId]; ok && now.Sub(cached.fetchedAt) < c.Ttl {
+		return cached.revoked, nil
+	}
+
+	revoked, err := c.AccessTokenDenylistGateway.IsRevoked(ctx, tokenId)
+	if err != nil {
+		return false, err
+	}
+
+	c.store(tokenId, cachedRevocation{revoked: revoked, fetchedAt: now}, now)
+	return revoked, nil
+}
+
+func (c *CachedAccessTokenDenylistGateway) Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	err := c.AccessTokenDenylistGateway.Revoke(ctx, tokenId, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	now := c.ClockGateway.Now()
+	c.store(tokenId, cachedRevocation{revoked: true, fetchedAt: now}, now)
+	return nil
+}
+
+func (c *CachedAccessTokenDenylistGateway) store(tokenId string, revocation cachedRevocation, now time.Time) {
+	if c.cache == nil {
+		c.cache = map[string]cachedRevocation{}
+	}
+
+	for cachedTokenId, cached := range c.cache {
+		if now.Sub(cached.fetchedAt) >= c.Ttl {
+			delete(c.cache, cachedTokenId)
+		}
+	}
+
+	c.cache[tokenId] = revocation
+}
@@ -0,0 +1,104 @@
+package gateways_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type AccessTokenDenylistGatewayMock struct {
+	mock.Mock
+}
+
+func (a *AccessTokenDenylistGatewayMock) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	args := a.Called(ctx, tokenId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (a *AccessTokenDenylistGatewayMock) Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error {
+	args := a.Called(ctx, tokenId, expiresAt)
+	return args.Error(0)
+}
+
+func TestCachedAccessTokenDenylistGateway_IsRevoked_OnFreshEntry_ReturnsCachedValue(t *testing.T) {
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	accessTokenDenylistGatewayMock := AccessTokenDenylistGatewayMock{}
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	sut := gateways.CachedAccessTokenDenylistGateway{
+		AccessTokenDenylistGateway: &accessTokenDenylistGatewayMock,
+		ClockGateway:               clockGateway,
+		Ttl:                        30 * time.Second,
+	}
+
+	sut.IsRevoked(context.Background(), "token-id")
+	clockGateway.Advance(29 * time.Second)
+	revoked, err := sut.IsRevoked(context.Background(), "token-id")
+
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+	accessTokenDenylistGatewayMock.AssertNumberOfCalls(t, "IsRevoked", 1)
+}
+
+func TestCachedAccessTokenDenylistGateway_IsRevoked_OnStaleEntry_QueriesAgain(t *testing.T) {
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	accessTokenDenylistGatewayMock := AccessTokenDenylistGatewayMock{}
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil).Once()
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(true, nil).Once()
+	sut := gateways.CachedAccessTokenDenylistGateway{
+		AccessTokenDenylistGateway: &accessTokenDenylistGatewayMock,
+		ClockGateway:               clockGateway,
+		Ttl:                        30 * time.Second,
+	}
+
+	sut.IsRevoked(context.Background(), "token-id")
+	clockGateway.Advance(30 * time.Second)
+	revoked, err := sut.IsRevoked(context.Background(), "token-id")
+
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+	accessTokenDenylistGatewayMock.AssertNumberOfCalls(t, "IsRevoked", 2)
+}
+
+func TestCachedAccessTokenDenylistGateway_Revoke_OnSuccess_MarksTokenAsRevokedImmediately(t *testing.T) {
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	expiresAt := clockGateway.Now().Add(15 * time.Minute)
+	accessTokenDenylistGatewayMock := AccessTokenDenylistGatewayMock{}
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	accessTokenDenylistGatewayMock.On("Revoke", mock.Anything, "token-id", expiresAt).Return(nil)
+	sut := gateways.CachedAccessTokenDenylistGateway{
+		AccessTokenDenylistGateway: &accessTokenDenylistGatewayMock,
+		ClockGateway:               clockGateway,
+		Ttl:                        30 * time.Second,
+	}
+
+	sut.IsRevoked(context.Background(), "token-id")
+	err := sut.Revoke(context.Background(), "token-id", expiresAt)
+	revoked, _ := sut.IsRevoked(context.Background(), "token-id")
+
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+	accessTokenDenylistGatewayMock.AssertNumberOfCalls(t, "IsRevoked", 1)
+}
+
+func TestCachedAccessTokenDenylistGateway_IsRevoked_OnFailure_ReturnsErrorAndDoesNotCache(t *testing.T) {
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	accessTokenDenylistGatewayMock := AccessTokenDenylistGatewayMock{}
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, errors.New("connection refused")).Once()
+	accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(true, nil).Once()
+	sut := gateways.CachedAccessTokenDenylistGateway{
+		AccessTokenDenylistGateway: &accessTokenDenylistGatewayMock,
+		ClockGateway:               clockGateway,
+		Ttl:                        30 * time.Second,
+	}
+
+	_, err := sut.IsRevoked(context.Background(), "token-id")
+	revoked, _ := sut.IsRevoked(context.Background(), "token-id")
+
+	assert.EqualError(t, err, "connection refused")
+	assert.True(t, revoked)
+}
@@ -2,24 +2,26 @@ package gateways
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
 	"github.com/jackc/pgx/v5"
 )
 
 type CustomerGateway struct {
-	Conn *pgx.Conn
+	Conn database.IConn
 }
 
-func (c *CustomerGateway) ExistsById(id uuid.UUID) (bool, error) {
+func (c *CustomerGateway) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
 	var customerId string
-	err := c.Conn.QueryRow(context.Background(), "SELECT id FROM customers WHERE id = $1", id).Scan(&customerId)
+	err := c.Conn.QueryRow(ctx, "SELECT id FROM customers WHERE id = $1", id).Scan(&customerId)
 
 	if err == nil {
 		return true, nil
 	}
 
-	if err.Error() == "no rows in result set" {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return false, nil
 	}
 
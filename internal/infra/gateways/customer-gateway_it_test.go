@@ -74,7 +74,7 @@ func (p *CustomerGatewaySuite) TestCustomerGateway_ExistsById_OnCustomerExists_R
 	_, err := p.conn.Exec(context.Background(), "INSERT INTO customers (id) VALUES ($1)", customerId)
 	p.Require().NoError(err)
 
-	exists, err := p.customerGateway.ExistsById(customerId)
+	exists, err := p.customerGateway.ExistsById(context.Background(), customerId)
 
 	p.NoError(err)
 	p.Equal(exists, true)
@@ -83,7 +83,7 @@ func (p *CustomerGatewaySuite) TestCustomerGateway_ExistsById_OnCustomerExists_R
 func (suite *CustomerGatewaySuite) TestCustomerGateway_ExistsById_OnCustomerNotExists_ReturnsCustomer() {
 	customerId := uuid.New()
 
-	exists, err := suite.customerGateway.ExistsById(customerId)
+	exists, err := suite.customerGateway.ExistsById(context.Background(), customerId)
 
 	suite.NoError(err)
 	suite.Equal(exists, false)
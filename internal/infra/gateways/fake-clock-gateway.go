@@ -0,0 +1,38 @@
+package gateways
+
+import (
+	"sync"
+	"time"
+)
+
+type FakeClockGateway struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func NewFakeClockGateway(now time.Time) *FakeClockGateway {
+	return &FakeClockGateway{
+		now: now,
+	}
+}
+
+func (f *FakeClockGateway) Now() time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.now
+}
+
+func (f *FakeClockGateway) Set(now time.Time) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = now
+}
+
+func (f *FakeClockGateway) Advance(duration time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = f.now.Add(duration)
+}
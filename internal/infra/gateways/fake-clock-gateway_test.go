@@ -0,0 +1,34 @@
+package gateways_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClockGateway_Now_OnCreated_ReturnsInitialTime(t *testing.T) {
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	sut := gateways.NewFakeClockGateway(now)
+
+	assert.Equal(t, now, sut.Now())
+}
+
+func TestFakeClockGateway_Advance_OnDuration_MovesTimeForward(t *testing.T) {
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	sut := gateways.NewFakeClockGateway(now)
+
+	sut.Advance(15 * time.Minute)
+
+	assert.Equal(t, now.Add(15*time.Minute), sut.Now())
+}
+
+func TestFakeClockGateway_Set_OnTime_ReplacesCurrentTime(t *testing.T) {
+	sut := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	later := time.Date(2024, 12, 1, 8, 30, 0, 0, time.UTC)
+
+	sut.Set(later)
+
+	assert.Equal(t, later, sut.Now())
+}
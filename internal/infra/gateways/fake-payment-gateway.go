@@ -0,0 +1,118 @@
+package gateways
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+const (
+	FakeCardApproved        = "4242424242424242"
+	FakeCardDeclined        = "4000000000000002"
+	FakeCardTimeout         = "4000000000000119"
+	FakeCardCaptureDeclined = "4000000000000341"
+)
+
+type fakePaymentAuthorization struct {
+	amount          int64
+	captured        int64
+	refunded        int64
+	voided          bool
+	captureDeclined bool
+}
+
+type FakePaymentGateway struct {
+	mutex          sync.Mutex
+	authorizations map[string]*fakePaymentAuthorization
+}
+
+func NewFakePaymentGateway() *FakePaymentGateway {
+	return &FakePaymentGateway{
+		authorizations: map[string]*fakePaymentAuthorization{},
+	}
+}
+
+func (f *FakePaymentGateway) Authorize(ctx context.Context, cardNumber string, amount int64) (*gateways.PaymentAuthorizationDTO, error) {
+	switch cardNumber {
+	case FakeCardTimeout:
+		return nil, gateways.ErrPaymentProviderTimeout
+	case FakeCardApproved, FakeCardCaptureDeclined:
+	default:
+		return nil, gateways.ErrPaymentDeclined
+	}
+
+	if amount <= 0 {
+		return nil, gateways.ErrPaymentAmountInvalid
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	authorizationId := "auth_" + uuid.NewString()
+	f.authorizations[authorizationId] = &fakePaymentAuthorization{
+		amount:          amount,
+		captureDeclined: cardNumber == FakeCardCaptureDeclined,
+	}
+
+	return &gateways.PaymentAuthorizationDTO{
+		Id:     authorizationId,
+		Amount: amount,
+	}, nil
+}
+
+func (f *FakePaymentGateway) Capture(ctx context.Context, authorizationId string, amount int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	authorization, exists := f.authorizations[authorizationId]
+	if !exists {
+		return gateways.ErrPaymentAuthorizationNotFound
+	}
+
+	if authorization.voided || authorization.captured > 0 || amount <= 0 || amount > authorization.amount {
+		return gateways.ErrPaymentCannotBeCaptured
+	}
+
+	if authorization.captureDeclined {
+		return gateways.ErrPaymentDeclined
+	}
+
+	authorization.captured = amount
+	return nil
+}
+
+func (f *FakePaymentGateway) Void(ctx context.Context, authorizationId string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	authorization, exists := f.authorizations[authorizationId]
+	if !exists {
+		return gateways.ErrPaymentAuthorizationNotFound
+	}
+
+	if authorization.captured > 0 {
+		return gateways.ErrPaymentCannotBeVoided
+	}
+
+	authorization.voided = true
+	return nil
+}
+
+func (f *FakePaymentGateway) Refund(ctx context.Context, authorizationId string, amount int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	authorization, exists := f.authorizations[authorizationId]
+	if !exists {
+		return gateways.ErrPaymentAuthorizationNotFound
+	}
+
+	if amount <= 0 || amount > authorization.captured-authorization.refunded {
+		return gateways.ErrPaymentCannotBeRefunded
+	}
+
+	authorization.refunded += amount
+	return nil
+}
@@ -0,0 +1,108 @@
+package gateways_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakePaymentGateway_Authorize_OnApprovedCard_ReturnsAuthorization(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+
+	authorization, err := sut.Authorize(context.Background(), gateways.FakeCardApproved, 12000)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, authorization.Id)
+	assert.Equal(t, int64(12000), authorization.Amount)
+}
+
+func TestFakePaymentGateway_Authorize_OnDeclinedCard_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+
+	_, err := sut.Authorize(context.Background(), gateways.FakeCardDeclined, 12000)
+
+	assert.EqualError(t, err, "payment declined")
+}
+
+func TestFakePaymentGateway_Authorize_OnUnknownCard_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+
+	_, err := sut.Authorize(context.Background(), "5555555555554444", 12000)
+
+	assert.EqualError(t, err, "payment declined")
+}
+
+func TestFakePaymentGateway_Authorize_OnTimeoutCard_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+
+	_, err := sut.Authorize(context.Background(), gateways.FakeCardTimeout, 12000)
+
+	assert.EqualError(t, err, "payment provider timeout")
+}
+
+func TestFakePaymentGateway_CaptureAndRefund_OnAuthorizedPayment_ReturnsNil(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+	authorization, _ := sut.Authorize(context.Background(), gateways.FakeCardApproved, 12000)
+
+	errCapture := sut.Capture(context.Background(), authorization.Id, 12000)
+	errPartialRefund := sut.Refund(context.Background(), authorization.Id, 2000)
+	errRefund := sut.Refund(context.Background(), authorization.Id, 10000)
+	errOverRefund := sut.Refund(context.Background(), authorization.Id, 1)
+
+	assert.NoError(t, errCapture)
+	assert.NoError(t, errPartialRefund)
+	assert.NoError(t, errRefund)
+	assert.EqualError(t, errOverRefund, "payment cannot be refunded")
+}
+
+func TestFakePaymentGateway_Capture_OnCaptureDeclinedCard_ReturnsErrorAndAllowsVoid(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+	authorization, errAuthorize := sut.Authorize(context.Background(), gateways.FakeCardCaptureDeclined, 12000)
+
+	errCapture := sut.Capture(context.Background(), authorization.Id, 12000)
+	errVoid := sut.Void(context.Background(), authorization.Id)
+
+	assert.NoError(t, errAuthorize)
+	assert.EqualError(t, errCapture, "payment declined")
+	assert.NoError(t, errVoid)
+}
+
+func TestFakePaymentGateway_Capture_OnVoidedPayment_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+	authorization, _ := sut.Authorize(context.Background(), gateways.FakeCardApproved, 12000)
+
+	errVoid := sut.Void(context.Background(), authorization.Id)
+	errCapture := sut.Capture(context.Background(), authorization.Id, 12000)
+
+	assert.NoError(t, errVoid)
+	assert.EqualError(t, errCapture, "payment cannot be captured")
+}
+
+func TestFakePaymentGateway_Void_OnCapturedPayment_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+	authorization, _ := sut.Authorize(context.Background(), gateways.FakeCardApproved, 12000)
+	sut.Capture(context.Background(), authorization.Id, 12000)
+
+	err := sut.Void(context.Background(), authorization.Id)
+
+	assert.EqualError(t, err, "payment cannot be voided")
+}
+
+func TestFakePaymentGateway_Refund_OnUncapturedPayment_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+	authorization, _ := sut.Authorize(context.Background(), gateways.FakeCardApproved, 12000)
+
+	err := sut.Refund(context.Background(), authorization.Id, 12000)
+
+	assert.EqualError(t, err, "payment cannot be refunded")
+}
+
+func TestFakePaymentGateway_Capture_OnUnknownAuthorization_ReturnsError(t *testing.T) {
+	sut := gateways.NewFakePaymentGateway()
+
+	err := sut.Capture(context.Background(), "auth_unknown", 12000)
+
+	assert.EqualError(t, err, "payment authorization not found")
+}
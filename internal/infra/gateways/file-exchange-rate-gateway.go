@@ -0,0 +1,77 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type exchangeRateTable struct {
+	Base  string            `json:"base"`
+	AsOf  time.Time         `json:"asOf"`
+	Rates map[string]string `json:"rates"`
+}
+
+type FileExchangeRateGateway struct {
+	Path string
+
+	mutex    sync.Mutex
+	loadedAt time.Time
+	table    *StaticExchangeRateGateway
+}
+
+func (f *FileExchangeRateGateway) FindRate(ctx context.Context, from string, to string) (*gateways.ExchangeRateDTO, error) {
+	table, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return table.FindRate(ctx, from, to)
+}
+
+func (f *FileExchangeRateGateway) Load() error {
+	_, err := f.load()
+	return err
+}
+
+func (f *FileExchangeRateGateway) load() (*StaticExchangeRateGateway, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.table != nil && info.ModTime().Equal(f.loadedAt) {
+		return f.table, nil
+	}
+
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file exchangeRateTable
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, err
+	}
+
+	if file.Base == "" {
+		return nil, errors.New("exchange rate table has no base currency")
+	}
+
+	table, err := NewStaticExchangeRateGateway(file.Base, file.AsOf, file.Rates)
+	if err != nil {
+		return nil, err
+	}
+
+	f.table = table
+	f.loadedAt = info.ModTime()
+	return f.table, nil
+}
@@ -0,0 +1,67 @@
+package gateways_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileExchangeRateGateway_FindRate_OnValidFile_ReturnsTableRate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exchange-rates.json")
+	os.WriteFile(path, []byte(`{"base":"BRL","asOf":"2024-11-20T10:00:00Z","rates":{"USD":"0.18","EUR":"0.17"}}`), 0o600)
+	sut := infragateways.FileExchangeRateGateway{Path: path}
+
+	rate, err := sut.FindRate(context.Background(), "EUR", "BRL")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &gateways.ExchangeRateDTO{
+		From: "EUR",
+		To:   "BRL",
+		Rate: "100/17",
+		AsOf: time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC),
+	}, rate)
+}
+
+func TestFileExchangeRateGateway_FindRate_OnFileChanged_ReloadsTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exchange-rates.json")
+	os.WriteFile(path, []byte(`{"base":"BRL","asOf":"2024-11-20T10:00:00Z","rates":{"USD":"0.18"}}`), 0o600)
+	sut := infragateways.FileExchangeRateGateway{Path: path}
+	sut.FindRate(context.Background(), "BRL", "USD")
+
+	os.WriteFile(path, []byte(`{"base":"BRL","asOf":"2024-11-22T10:00:00Z","rates":{"USD":"0.2"}}`), 0o600)
+	modifiedAt := time.Now().Add(time.Minute)
+	os.Chtimes(path, modifiedAt, modifiedAt)
+	rate, err := sut.FindRate(context.Background(), "BRL", "USD")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &gateways.ExchangeRateDTO{
+		From: "BRL",
+		To:   "USD",
+		Rate: "1/5",
+		AsOf: time.Date(2024, 11, 22, 10, 0, 0, 0, time.UTC),
+	}, rate)
+}
+
+func TestFileExchangeRateGateway_Load_OnMissingBase_ReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exchange-rates.json")
+	os.WriteFile(path, []byte(`{"rates":{"USD":"0.18"}}`), 0o600)
+	sut := infragateways.FileExchangeRateGateway{Path: path}
+
+	err := sut.Load()
+
+	assert.EqualError(t, err, "exchange rate table has no base currency")
+}
+
+func TestFileExchangeRateGateway_Load_OnMissingFile_ReturnsError(t *testing.T) {
+	sut := infragateways.FileExchangeRateGateway{Path: filepath.Join(t.TempDir(), "missing.json")}
+
+	err := sut.Load()
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type taxRuleFile struct {
+	Rules []struct {
+		Country   string `json:"country"`
+		Region    string `json:"region"`
+		TaxClass  string `json:"taxClass"`
+		Rate      string `json:"rate"`
+		Inclusive bool   `json:"inclusive"`
+	} `json:"rules"`
+}
+
+type FileTaxRuleGateway struct {
+	Path string
+
+	mutex    sync.Mutex
+	loadedAt time.Time
+	rules    []gateways.TaxRuleDTO
+}
+
+func (f *FileTaxRuleGateway) FindByCountry(ctx context.Context, country string) ([]gateways.TaxRuleDTO, error) {
+	rules, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	country = strings.ToUpper(strings.TrimSpace(country))
+	countryRules := []gateways.TaxRuleDTO{}
+	for _, rule := range rules {
+		if strings.ToUpper(strings.TrimSpace(rule.Country)) == country {
+			countryRules = append(countryRules, rule)
+		}
+	}
+
+	return countryRules, nil
+}
+
+func (f *FileTaxRuleGateway) Load() error {
+	rules, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		return fmt.Errorf("tax rules file %s has no rules", f.Path)
+	}
+
+	return nil
+}
+
+func (f *FileTaxRuleGateway) load() ([]gateways.TaxRuleDTO, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.rules != nil && info.ModTime().Equal(f.loadedAt) {
+		return f.rules, nil
+	}
+
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file taxRuleFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, err
+	}
+
+	rules := []gateways.TaxRuleDTO{}
+	for _, rule := range file.Rules {
+		rules = append(rules, gateways.TaxRuleDTO{
+			Country:   rule.Country,
+			Region:    rule.Region,
+			TaxClass:  rule.TaxClass,
+			Rate:      rule.Rate,
+			Inclusive: rule.Inclusive,
+		})
+	}
+
+	f.rules = rules
+	f.loadedAt = info.ModTime()
+	return f.rules, nil
+}
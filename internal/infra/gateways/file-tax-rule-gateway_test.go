@@ -0,0 +1,86 @@
+package gateways_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTaxRuleGateway_FindByCountry_OnMatchingRules_ReturnsCountryRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax-rules.json")
+	os.WriteFile(path, []byte(`{"rules":[
+		{"country":"BR","region":"SP","taxClass":"standard","rate":"18","inclusive":true},
+		{"country":"BR","region":"PA","taxClass":"*","rate":"19","inclusive":true},
+		{"country":"US","region":"PA","taxClass":"*","rate":"6","inclusive":false}
+	]}`), 0o600)
+	sut := infragateways.FileTaxRuleGateway{Path: path}
+
+	rules, err := sut.FindByCountry(context.Background(), " br ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gateways.TaxRuleDTO{
+		{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "18", Inclusive: true},
+		{Country: "BR", Region: "PA", TaxClass: "*", Rate: "19", Inclusive: true},
+	}, rules)
+}
+
+func TestFileTaxRuleGateway_FindByCountry_OnUnknownCountry_ReturnsEmptyList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax-rules.json")
+	os.WriteFile(path, []byte(`{"rules":[{"country":"BR","region":"SP","taxClass":"standard","rate":"18","inclusive":true}]}`), 0o600)
+	sut := infragateways.FileTaxRuleGateway{Path: path}
+
+	rules, err := sut.FindByCountry(context.Background(), "PT")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gateways.TaxRuleDTO{}, rules)
+}
+
+func TestFileTaxRuleGateway_FindByCountry_OnFileChanged_ReloadsRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax-rules.json")
+	os.WriteFile(path, []byte(`{"rules":[{"country":"BR","region":"SP","taxClass":"standard","rate":"18","inclusive":true}]}`), 0o600)
+	sut := infragateways.FileTaxRuleGateway{Path: path}
+	sut.FindByCountry(context.Background(), "BR")
+
+	os.WriteFile(path, []byte(`{"rules":[{"country":"BR","region":"SP","taxClass":"standard","rate":"20","inclusive":true}]}`), 0o600)
+	modifiedAt := time.Now().Add(time.Minute)
+	os.Chtimes(path, modifiedAt, modifiedAt)
+	rules, err := sut.FindByCountry(context.Background(), "BR")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gateways.TaxRuleDTO{{Country: "BR", Region: "SP", TaxClass: "standard", Rate: "20", Inclusive: true}}, rules)
+}
+
+func TestFileTaxRuleGateway_FindByCountry_OnMissingFile_ReturnsError(t *testing.T) {
+	sut := infragateways.FileTaxRuleGateway{Path: filepath.Join(t.TempDir(), "missing.json")}
+
+	_, err := sut.FindByCountry(context.Background(), "BR")
+
+	assert.Error(t, err)
+}
+
+func TestFileTaxRuleGateway_Load_OnMissingOrEmptyFile_ReturnsError(t *testing.T) {
+	emptyPath := filepath.Join(t.TempDir(), "tax-rules.json")
+	os.WriteFile(emptyPath, []byte(`{"rules":[]}`), 0o600)
+
+	for _, path := range []string{filepath.Join(t.TempDir(), "missing.json"), emptyPath} {
+		sut := infragateways.FileTaxRuleGateway{Path: path}
+
+		err := sut.Load()
+
+		assert.Error(t, err, path)
+	}
+}
+
+func TestFileTaxRuleGateway_Load_OnShippedRulesFile_ReturnsNil(t *testing.T) {
+	sut := infragateways.FileTaxRuleGateway{Path: "../../../config/tax-rules.json"}
+
+	err := sut.Load()
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,69 @@
+package gateways
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type HmacCartTokenGateway struct {
+	SecretManagerGateway gateways.ISecretManagerGateway
+}
+
+func (h *HmacCartTokenGateway) Issue(ctx context.Context, cartId uuid.UUID) (string, error) {
+	signature, err := h.sign(ctx, cartId[:])
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cartId[:]) + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (h *HmacCartTokenGateway) Verify(ctx context.Context, cartToken string) (*uuid.UUID, error) {
+	parts := strings.Split(cartToken, ".")
+	if len(parts) != 2 {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil
+	}
+
+	expectedSignature, err := h.sign(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(signature, expectedSignature) {
+		return nil, nil
+	}
+
+	cartId, err := uuid.FromBytes(payload)
+	if err != nil {
+		return nil, nil
+	}
+
+	return &cartId, nil
+}
+
+func (h *HmacCartTokenGateway) sign(ctx context.Context, payload []byte) ([]byte, error) {
+	cartTokenSecret, err := h.SecretManagerGateway.Get(ctx, "CART_TOKEN_SECRET")
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(cartTokenSecret))
+	mac.Write(payload)
+	return mac.Sum(nil), nil
+}
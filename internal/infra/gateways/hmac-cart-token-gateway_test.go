@@ -0,0 +1,58 @@
+package gateways_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHmacCartTokenGateway_Verify_OnIssuedToken_ReturnsCartId(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "CART_TOKEN_SECRET").Return("secret", nil)
+	sut := gateways.HmacCartTokenGateway{SecretManagerGateway: &secretManagerGatewayMock}
+	cartId := uuid.New()
+
+	cartToken, err := sut.Issue(context.Background(), cartId)
+	assert.NoError(t, err)
+	verifiedCartId, err := sut.Verify(context.Background(), cartToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, cartId, *verifiedCartId)
+}
+
+func TestHmacCartTokenGateway_Verify_OnTamperedTokens_ReturnsNil(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "CART_TOKEN_SECRET").Return("secret", nil)
+	sut := gateways.HmacCartTokenGateway{SecretManagerGateway: &secretManagerGatewayMock}
+	cartToken, _ := sut.Issue(context.Background(), uuid.New())
+	otherCartToken, _ := sut.Issue(context.Background(), uuid.New())
+	forgedCartToken := strings.Split(otherCartToken, ".")[0] + "." + strings.Split(cartToken, ".")[1]
+
+	otherSecretManagerGatewayMock := SecretManagerGatewayMock{}
+	otherSecretManagerGatewayMock.On("Get", mock.Anything, "CART_TOKEN_SECRET").Return("another-secret", nil)
+	otherSut := gateways.HmacCartTokenGateway{SecretManagerGateway: &otherSecretManagerGatewayMock}
+	foreignCartToken, _ := otherSut.Issue(context.Background(), uuid.New())
+
+	for _, token := range []string{"", "abc", "abc.def.ghi", "!!!.???", forgedCartToken, foreignCartToken} {
+		cartId, err := sut.Verify(context.Background(), token)
+
+		assert.NoError(t, err, token)
+		assert.Nil(t, cartId, token)
+	}
+}
+
+func TestHmacCartTokenGateway_Issue_OnSecretManagerFailure_ReturnsError(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "CART_TOKEN_SECRET").Return("", errors.New("secret not found"))
+	sut := gateways.HmacCartTokenGateway{SecretManagerGateway: &secretManagerGatewayMock}
+
+	_, err := sut.Issue(context.Background(), uuid.New())
+
+	assert.EqualError(t, err, "secret not found")
+}
@@ -0,0 +1,247 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+)
+
+type InventoryGateway struct {
+	Conn database.IConn
+}
+
+func (i *InventoryGateway) Create(ctx context.Context, inventory gateways.InventoryDTO) error {
+	_, err := i.Conn.Exec(ctx, "INSERT INTO inventories (product_id, on_hand, reserved) VALUES ($1, $2, $3)",
+		inventory.ProductId.String(), inventory.OnHand, inventory.Reserved)
+
+	return err
+}
+
+func (i *InventoryGateway) FindOneByProductId(ctx context.Context, productId uuid.UUID) (*gateways.InventoryDTO, error) {
+	inventorySchema := struct {
+		productId uuid.UUID
+		onHand    int32
+		reserved  int32
+	}{}
+
+	err := i.Conn.QueryRow(ctx, "SELECT product_id, on_hand, reserved FROM inventories WHERE product_id = $1", productId).
+		Scan(&inventorySchema.productId, &inventorySchema.onHand, &inventorySchema.reserved)
+
+	if err == nil {
+		return &gateways.InventoryDTO{
+			ProductId: inventorySchema.productId,
+			OnHand:    inventorySchema.onHand,
+			Reserved:  inventorySchema.reserved,
+		}, nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return nil, err
+}
+
+func (i *InventoryGateway) Reserve(ctx context.Context, reservationId uuid.UUID, items []gateways.StockReservationItemDTO, expiresAt time.Time) error {
+	transaction, err := i.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	for _, item := range items {
+		commandTag, err := transaction.Exec(ctx,
+			"UPDATE inventories SET reserved = reserved + $1, updated_at = CURRENT_TIMESTAMP WHERE product_id = $2 AND on_hand - reserved >= $1",
+			item.Quantity, item.ProductId.String())
+
+		if err != nil {
+			return err
+		}
+
+		if commandTag.RowsAffected() == 0 {
+			return inventory.ErrInsufficientStock
+		}
+
+		_, err = transaction.Exec(ctx, "INSERT INTO stock_reservations (id, product_id, quantity, expires_at) VALUES ($1, $2, $3, $4)",
+			reservationId.String(), item.ProductId.String(), item.Quantity, expiresAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *InventoryGateway) Release(ctx context.Context, reservationId uuid.UUID) error {
+	transaction, err := i.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	reservedItems, err := i.deleteReservedItems(ctx, transaction,
+		"DELETE FROM stock_reservations WHERE id = $1 RETURNING id, product_id, quantity", reservationId.String())
+
+	if err != nil {
+		return err
+	}
+
+	for _, reservedItem := range reservedItems {
+		_, err = transaction.Exec(ctx, "UPDATE inventories SET reserved = reserved - $1, updated_at = CURRENT_TIMESTAMP WHERE product_id = $2",
+			reservedItem.quantity, reservedItem.productId.String())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *InventoryGateway) Commit(ctx context.Context, reservationId uuid.UUID, now time.Time) error {
+	transaction, err := i.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	reservedItems, err := i.deleteReservedItems(ctx, transaction,
+		"DELETE FROM stock_reservations WHERE id = $1 AND expires_at > $2 RETURNING id, product_id, quantity", reservationId.String(), now)
+
+	if err != nil {
+		return err
+	}
+
+	if len(reservedItems) == 0 {
+		return gateways.ErrStockReservationExpired
+	}
+
+	for _, reservedItem := range reservedItems {
+		_, err = transaction.Exec(ctx,
+			"UPDATE inventories SET on_hand = on_hand - $1, reserved = reserved - $1, updated_at = CURRENT_TIMESTAMP WHERE product_id = $2",
+			reservedItem.quantity, reservedItem.productId.String())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *InventoryGateway) ReleaseExpired(ctx context.Context, now time.Time) (int, error) {
+	transaction, err := i.Conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	reservedItems, err := i.deleteReservedItems(ctx, transaction,
+		"DELETE FROM stock_reservations WHERE expires_at <= $1 RETURNING id, product_id, quantity", now)
+
+	if err != nil {
+		return 0, err
+	}
+
+	releasedReservations := map[uuid.UUID]bool{}
+	for _, reservedItem := range reservedItems {
+		_, err = transaction.Exec(ctx, "UPDATE inventories SET reserved = reserved - $1, updated_at = CURRENT_TIMESTAMP WHERE product_id = $2",
+			reservedItem.quantity, reservedItem.productId.String())
+
+		if err != nil {
+			return 0, err
+		}
+
+		releasedReservations[reservedItem.reservationId] = true
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(releasedReservations), nil
+}
+
+type reservedItemSchema struct {
+	reservationId uuid.UUID
+	productId     uuid.UUID
+	quantity      int32
+}
+
+func (i *InventoryGateway) Restock(ctx context.Context, items []gateways.StockReservationItemDTO) error {
+	transaction, err := i.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	for _, item := range items {
+		_, err = transaction.Exec(ctx, "UPDATE inventories SET on_hand = on_hand + $1, updated_at = CURRENT_TIMESTAMP WHERE product_id = $2",
+			item.Quantity, item.ProductId.String())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *InventoryGateway) deleteReservedItems(ctx context.Context, transaction pgx.Tx, sql string, args ...any) ([]reservedItemSchema, error) {
+	rows, err := transaction.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	reservedItems := []reservedItemSchema{}
+	for rows.Next() {
+		reservedItem := reservedItemSchema{}
+		err = rows.Scan(&reservedItem.reservationId, &reservedItem.productId, &reservedItem.quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		reservedItems = append(reservedItems, reservedItem)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return reservedItems, nil
+}
@@ -0,0 +1,258 @@
+package gateways_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	appgateways "github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type InventoryGatewaySuite struct {
+	conn              *pgx.Conn
+	inventoryGateway  gateways.InventoryGateway
+	postgresContainer testcontainers.Container
+	suite.Suite
+}
+
+func (i *InventoryGatewaySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	i.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	i.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	i.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	i.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS products (
+			id UUID PRIMARY KEY,
+			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	i.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS inventories (
+			product_id UUID PRIMARY KEY,
+			on_hand INTEGER NOT NULL DEFAULT 0 CHECK (on_hand >= 0),
+			reserved INTEGER NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+			updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	i.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS stock_reservations (
+			id UUID NOT NULL,
+			product_id UUID NOT NULL,
+			quantity INTEGER NOT NULL CHECK (quantity > 0),
+			expires_at TIMESTAMPTZ NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id, product_id),
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	i.Require().NoError(err)
+
+	i.conn = conn
+	i.postgresContainer = postgresContainer
+	i.inventoryGateway = gateways.InventoryGateway{
+		Conn: conn,
+	}
+}
+
+func (i *InventoryGatewaySuite) TearDownTest() {
+	i.postgresContainer.Terminate(context.Background())
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Create_OnNewProduct_PersistsInventory() {
+	productId := uuid.New()
+	_, err := i.conn.Exec(context.Background(), "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	i.Require().NoError(err)
+
+	err = i.inventoryGateway.Create(context.Background(), appgateways.InventoryDTO{ProductId: productId, OnHand: 25})
+
+	i.NoError(err)
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(25), sut.OnHand)
+	i.Equal(int32(0), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_FindOneByProductId_OnInventoryExists_ReturnsInventory() {
+	productId := uuid.New()
+	_, err := i.conn.Exec(context.Background(), "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	i.Require().NoError(err)
+	_, err = i.conn.Exec(context.Background(), "INSERT INTO inventories (product_id, on_hand, reserved) VALUES ($1, $2, $3)", productId, 10, 3)
+	i.Require().NoError(err)
+
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+
+	i.NoError(err)
+	i.Equal(productId, sut.ProductId)
+	i.Equal(int32(10), sut.OnHand)
+	i.Equal(int32(3), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_FindOneByProductId_OnInventoryNotExists_ReturnsNil() {
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), uuid.New())
+
+	i.NoError(err)
+	i.Nil(sut)
+}
+
+func (i *InventoryGatewaySuite) insertInventory(onHand int32, reserved int32) uuid.UUID {
+	productId := uuid.New()
+	_, err := i.conn.Exec(context.Background(), "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	i.Require().NoError(err)
+	_, err = i.conn.Exec(context.Background(), "INSERT INTO inventories (product_id, on_hand, reserved) VALUES ($1, $2, $3)", productId, onHand, reserved)
+	i.Require().NoError(err)
+
+	return productId
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Reserve_OnAvailableStock_ReservesStock() {
+	productId := i.insertInventory(5, 1)
+	reservationId := uuid.New()
+	expiresAt := time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC)
+
+	err := i.inventoryGateway.Reserve(context.Background(), reservationId, []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}}, expiresAt)
+	i.Require().NoError(err)
+
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(5), sut.OnHand)
+	i.Equal(int32(4), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Reserve_OnInsufficientStock_ReturnsErrorAndReservesNothing() {
+	product1 := i.insertInventory(5, 0)
+	product2 := i.insertInventory(2, 1)
+	expiresAt := time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC)
+
+	err := i.inventoryGateway.Reserve(context.Background(), uuid.New(), []appgateways.StockReservationItemDTO{
+		{ProductId: product1, Quantity: 3},
+		{ProductId: product2, Quantity: 2},
+	}, expiresAt)
+
+	i.EqualError(err, "insufficient stock")
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), product1)
+	i.Require().NoError(err)
+	i.Equal(int32(0), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Commit_OnActiveReservation_DecrementsOnHand() {
+	productId := i.insertInventory(5, 0)
+	reservationId := uuid.New()
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	err := i.inventoryGateway.Reserve(context.Background(), reservationId, []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}}, now.Add(15*time.Minute))
+	i.Require().NoError(err)
+
+	err = i.inventoryGateway.Commit(context.Background(), reservationId, now.Add(5*time.Minute))
+	i.Require().NoError(err)
+
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(2), sut.OnHand)
+	i.Equal(int32(0), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Commit_OnExpiredReservation_ReturnsError() {
+	productId := i.insertInventory(5, 0)
+	reservationId := uuid.New()
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	err := i.inventoryGateway.Reserve(context.Background(), reservationId, []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}}, now.Add(15*time.Minute))
+	i.Require().NoError(err)
+
+	err = i.inventoryGateway.Commit(context.Background(), reservationId, now.Add(16*time.Minute))
+
+	i.EqualError(err, "stock reservation expired")
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(5), sut.OnHand)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Release_OnReservation_ReturnsStock() {
+	productId := i.insertInventory(5, 0)
+	reservationId := uuid.New()
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	err := i.inventoryGateway.Reserve(context.Background(), reservationId, []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}}, now.Add(15*time.Minute))
+	i.Require().NoError(err)
+
+	err = i.inventoryGateway.Release(context.Background(), reservationId)
+	i.Require().NoError(err)
+
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(0), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_ReleaseExpired_OnMixedReservations_ReleasesOnlyExpired() {
+	productId := i.insertInventory(10, 0)
+	now := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	err := i.inventoryGateway.Reserve(context.Background(), uuid.New(), []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 2}}, now.Add(-time.Minute))
+	i.Require().NoError(err)
+	err = i.inventoryGateway.Reserve(context.Background(), uuid.New(), []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}}, now.Add(time.Minute))
+	i.Require().NoError(err)
+
+	released, err := i.inventoryGateway.ReleaseExpired(context.Background(), now)
+	i.Require().NoError(err)
+
+	i.Equal(1, released)
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(3), sut.Reserved)
+}
+
+func (i *InventoryGatewaySuite) TestInventoryGateway_Restock_OnCommittedStock_IncrementsOnHand() {
+	productId := i.insertInventory(2, 1)
+
+	err := i.inventoryGateway.Restock(context.Background(), []appgateways.StockReservationItemDTO{{ProductId: productId, Quantity: 3}})
+	i.Require().NoError(err)
+
+	sut, err := i.inventoryGateway.FindOneByProductId(context.Background(), productId)
+	i.Require().NoError(err)
+	i.Equal(int32(5), sut.OnHand)
+	i.Equal(int32(1), sut.Reserved)
+}
+
+func TestInventoryGateway(t *testing.T) {
+	suite.Run(t, new(InventoryGatewaySuite))
+}
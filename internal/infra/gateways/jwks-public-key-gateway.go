@@ -0,0 +1,231 @@
+package gateways
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"golang.org/x/sync/singleflight"
+)
+
+const JwksMinRefreshInterval = 30 * time.Second
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type JwksPublicKeyGateway struct {
+	ClockGateway    gateways.IClockGateway
+	RefreshInterval time.Duration
+
+	load        func(ctx context.Context) ([]byte, error)
+	group       singleflight.Group
+	mutex       sync.Mutex
+	keys        map[string]gateways.PublicKeyDTO
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+func NewFileJwksPublicKeyGateway(path string, clockGateway gateways.IClockGateway, refreshInterval time.Duration) *JwksPublicKeyGateway {
+	return &JwksPublicKeyGateway{
+		ClockGateway:    clockGateway,
+		RefreshInterval: refreshInterval,
+		load: func(ctx context.Context) ([]byte, error) {
+			return os.ReadFile(path)
+		},
+	}
+}
+
+func NewUrlJwksPublicKeyGateway(url string, httpClient *http.Client, clockGateway gateways.IClockGateway, refreshInterval time.Duration) *JwksPublicKeyGateway {
+	return &JwksPublicKeyGateway{
+		ClockGateway:    clockGateway,
+		RefreshInterval: refreshInterval,
+		load: func(ctx context.Context) ([]byte, error) {
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			response, err := httpClient.Do(request)
+			if err != nil {
+				return nil, err
+			}
+			defer response.Body.Close()
+
+			if response.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("key set request failed with status %d", response.StatusCode)
+			}
+
+			return io.ReadAll(io.LimitReader(response.Body, 1<<20))
+		},
+	}
+}
+
+func (j *JwksPublicKeyGateway) FindPublicKey(ctx context.Context, keyId string) (*gateways.PublicKeyDTO, error) {
+	now := j.ClockGateway.Now()
+
+	j.mutex.Lock()
+	keys := j.keys
+	_, found := keys[keyId]
+	stale := keys == nil || now.Sub(j.fetchedAt) >= j.RefreshInterval
+	refresh := (stale || !found) && (j.attemptedAt.IsZero() || now.Sub(j.attemptedAt) >= JwksMinRefreshInterval)
+	j.mutex.Unlock()
+
+	if refresh {
+		fetched, err, _ := j.group.Do("keys", func() (interface{}, error) {
+			return j.fetch(ctx)
+		})
+
+		j.mutex.Lock()
+		j.attemptedAt = now
+		if err == nil {
+			j.keys = fetched.(map[string]gateways.PublicKeyDTO)
+			j.fetchedAt = now
+		}
+		keys = j.keys
+		j.mutex.Unlock()
+
+		if err != nil && keys == nil {
+			return nil, err
+		}
+	}
+
+	if keys == nil {
+		return nil, errors.New("key set is not available")
+	}
+
+	key, found := keys[keyId]
+	if !found {
+		return nil, nil
+	}
+
+	return &key, nil
+}
+
+func (j *JwksPublicKeyGateway) fetch(ctx context.Context) (map[string]gateways.PublicKeyDTO, error) {
+	content, err := j.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keySet jsonWebKeySet
+	if err := json.Unmarshal(content, &keySet); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]gateways.PublicKeyDTO{}
+	for _, webKey := range keySet.Keys {
+		if webKey.Kid == "" || (webKey.Use != "" && webKey.Use != "sig") {
+			continue
+		}
+
+		var publicKey crypto.PublicKey
+		switch webKey.Kty {
+		case "RSA":
+			publicKey, err = parseRsaPublicKey(webKey)
+		case "EC":
+			publicKey, err = parseEcdsaPublicKey(webKey)
+		default:
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		keys[webKey.Kid] = gateways.PublicKeyDTO{
+			KeyId:     webKey.Kid,
+			Algorithm: webKey.Alg,
+			Key:       publicKey,
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("key set has no signing keys")
+	}
+
+	return keys, nil
+}
+
+func parseRsaPublicKey(webKey jsonWebKey) (*rsa.PublicKey, error) {
+	modulus, err := decodeBigInt(webKey.N)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := decodeBigInt(webKey.E)
+	if err != nil {
+		return nil, err
+	}
+
+	if modulus.BitLen() < 2048 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("rsa key parameters are not acceptable")
+	}
+
+	return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+}
+
+func parseEcdsaPublicKey(webKey jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch webKey.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, errors.New("ec curve is not supported")
+	}
+
+	x, err := decodeBigInt(webKey.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := decodeBigInt(webKey.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+	if _, err := publicKey.ECDH(); err != nil {
+		return nil, errors.New("ec point is not on the curve")
+	}
+
+	return publicKey, nil
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(bytes) == 0 {
+		return nil, errors.New("key parameter is not valid base64url")
+	}
+
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -0,0 +1,249 @@
+package gateways_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func rsaJwk(kid string, publicKey *rsa.PublicKey) string {
+	return fmt.Sprintf(`{"kty": "RSA", "kid": "%s", "use": "sig", "alg": "RS256", "n": "%s", "e": "%s"}`, kid,
+		base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()))
+}
+
+func ecdsaJwk(kid string, publicKey *ecdsa.PublicKey) string {
+	return fmt.Sprintf(`{"kty": "EC", "kid": "%s", "alg": "ES256", "crv": "P-256", "x": "%s", "y": "%s"}`, kid,
+		base64.RawURLEncoding.EncodeToString(publicKey.X.FillBytes(make([]byte, 32))),
+		base64.RawURLEncoding.EncodeToString(publicKey.Y.FillBytes(make([]byte, 32))))
+}
+
+func writeJwks(t *testing.T, path string, keys ...string) {
+	err := os.WriteFile(path, []byte(`{"keys": [`+strings.Join(keys, ",")+`]}`), 0o600)
+	assert.NoError(t, err)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnKnownKeyIds_ReturnsRsaAndEcdsaKeys(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecdsaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, rsaJwk("rsa-key", &rsaKey.PublicKey), ecdsaJwk("ec-key", &ecdsaKey.PublicKey))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+
+	rsaPublicKey, err := sut.FindPublicKey(context.Background(), "rsa-key")
+	assert.NoError(t, err)
+	ecdsaPublicKey, err := sut.FindPublicKey(context.Background(), "ec-key")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "RS256", rsaPublicKey.Algorithm)
+	assert.True(t, rsaKey.PublicKey.Equal(rsaPublicKey.Key))
+	assert.Equal(t, "ES256", ecdsaPublicKey.Algorithm)
+	assert.True(t, ecdsaKey.PublicKey.Equal(ecdsaPublicKey.Key))
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnRotatedKeySet_KeepsBothKeysUntilTheOldOneIsRemoved(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	newKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, ecdsaJwk("old-key", &oldKey.PublicKey))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+	sut.FindPublicKey(context.Background(), "old-key")
+
+	writeJwks(t, path, ecdsaJwk("old-key", &oldKey.PublicKey), ecdsaJwk("new-key", &newKey.PublicKey))
+	clockGateway.Advance(gateways.JwksMinRefreshInterval)
+	newPublicKey, _ := sut.FindPublicKey(context.Background(), "new-key")
+	oldPublicKey, _ := sut.FindPublicKey(context.Background(), "old-key")
+
+	assert.NotNil(t, newPublicKey)
+	assert.NotNil(t, oldPublicKey)
+
+	writeJwks(t, path, ecdsaJwk("new-key", &newKey.PublicKey))
+	clockGateway.Advance(time.Hour)
+	oldPublicKey, err := sut.FindPublicKey(context.Background(), "old-key")
+
+	assert.NoError(t, err)
+	assert.Nil(t, oldPublicKey)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnUnknownKeyIdWithinMinRefreshInterval_DoesNotReload(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	newKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, ecdsaJwk("old-key", &oldKey.PublicKey))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+	sut.FindPublicKey(context.Background(), "old-key")
+
+	writeJwks(t, path, ecdsaJwk("new-key", &newKey.PublicKey))
+	clockGateway.Advance(gateways.JwksMinRefreshInterval - time.Second)
+	newPublicKey, err := sut.FindPublicKey(context.Background(), "new-key")
+
+	assert.NoError(t, err)
+	assert.Nil(t, newPublicKey)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnRefreshFailure_KeepsServingTheLastKeySet(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, ecdsaJwk("key", &key.PublicKey))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+	sut.FindPublicKey(context.Background(), "key")
+
+	os.WriteFile(path, []byte(`not json`), 0o600)
+	clockGateway.Advance(time.Hour)
+	publicKey, err := sut.FindPublicKey(context.Background(), "key")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, publicKey)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnInvalidKeySets_ReturnsError(t *testing.T) {
+	contents := []string{
+		`not json`,
+		`{"keys": []}`,
+		`{"keys": [{"kty": "RSA", "kid": "key", "n": "AQAB", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "kid": "key", "crv": "P-256", "x": "AQAB", "y": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "kid": "key", "crv": "secp256k1", "x": "AQAB", "y": "AQAB"}]}`,
+	}
+
+	for _, content := range contents {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		os.WriteFile(path, []byte(content), 0o600)
+		clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+		sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+
+		_, err := sut.FindPublicKey(context.Background(), "key")
+
+		assert.Error(t, err, content)
+	}
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnInvalidKeyNextToValidKey_SkipsTheInvalidKey(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, `{"kty": "RSA", "kid": "invalid-key", "n": "AQAB", "e": "AQAB"}`,
+		`{"kty": "OKP", "kid": "unsupported-key", "crv": "Ed25519", "x": "AQAB"}`, ecdsaJwk("key", &key.PublicKey))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+
+	publicKey, err := sut.FindPublicKey(context.Background(), "key")
+	assert.NoError(t, err)
+	invalidPublicKey, err := sut.FindPublicKey(context.Background(), "invalid-key")
+	assert.NoError(t, err)
+
+	assert.True(t, key.PublicKey.Equal(publicKey.Key))
+	assert.Nil(t, invalidPublicKey)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnEncryptionKey_IgnoresIt(t *testing.T) {
+	signingKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	encryptionKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, path, ecdsaJwk("signing-key", &signingKey.PublicKey),
+		strings.Replace(rsaJwk("encryption-key", &encryptionKey.PublicKey), `"use": "sig"`, `"use": "enc"`, 1))
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewFileJwksPublicKeyGateway(path, clockGateway, time.Hour)
+
+	publicKey, err := sut.FindPublicKey(context.Background(), "encryption-key")
+
+	assert.NoError(t, err)
+	assert.Nil(t, publicKey)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnUrl_LoadsKeySetOverHttp(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys": [` + ecdsaJwk("key", &key.PublicKey) + `]}`))
+	}))
+	defer server.Close()
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewUrlJwksPublicKeyGateway(server.URL, server.Client(), clockGateway, time.Hour)
+
+	publicKey, err := sut.FindPublicKey(context.Background(), "key")
+
+	assert.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(publicKey.Key))
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnUrlFailure_ReturnsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewUrlJwksPublicKeyGateway(server.URL, server.Client(), clockGateway, time.Hour)
+
+	_, err := sut.FindPublicKey(context.Background(), "key")
+
+	assert.EqualError(t, err, "key set request failed with status 503")
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnCancelledContext_DoesNotWaitForTheKeySet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewUrlJwksPublicKeyGateway(server.URL, server.Client(), clockGateway, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := sut.FindPublicKey(ctx, "key")
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestJwksPublicKeyGateway_FindPublicKey_OnConcurrentCallers_LoadsTheKeySetOnce(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"keys": [` + ecdsaJwk("key", &key.PublicKey) + `]}`))
+	}))
+	defer server.Close()
+	clockGateway := gateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	sut := gateways.NewUrlJwksPublicKeyGateway(server.URL, server.Client(), clockGateway, time.Hour)
+
+	var waitGroup sync.WaitGroup
+	publicKeys := make(chan *ecdsa.PublicKey, 10)
+	for range 10 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			publicKey, err := sut.FindPublicKey(context.Background(), "key")
+			assert.NoError(t, err)
+			publicKeys <- publicKey.Key.(*ecdsa.PublicKey)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	waitGroup.Wait()
+	close(publicKeys)
+
+	assert.Equal(t, int32(1), requests.Load())
+	for publicKey := range publicKeys {
+		assert.True(t, key.PublicKey.Equal(publicKey))
+	}
+}
@@ -0,0 +1,36 @@
+package gateways
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type JwtAccessTokenGateway struct {
+	SecretManagerGateway gateways.ISecretManagerGateway
+	Issuer               string
+	Audience             string
+}
+
+func (j *JwtAccessTokenGateway) Issue(ctx context.Context, claims gateways.AccessTokenClaimsDTO) (string, error) {
+	authAccessToken, err := j.SecretManagerGateway.Get(ctx, "AUTH_ACCESS_TOKEN")
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":        uuid.NewString(),
+		"iss":        j.Issuer,
+		"aud":        j.Audience,
+		"customerId": claims.CustomerId.String(),
+		"roles":      claims.Roles,
+		"scope":      strings.Join(claims.Scopes, " "),
+		"iat":        claims.IssuedAt.Unix(),
+		"exp":        claims.ExpiresAt.Unix(),
+	})
+
+	return token.SignedString([]byte(authAccessToken))
+}
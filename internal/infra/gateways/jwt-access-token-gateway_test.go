@@ -0,0 +1,92 @@
+package gateways_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	applicationgateways "github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type SecretManagerGatewayMock struct {
+	mock.Mock
+}
+
+func (s *SecretManagerGatewayMock) Get(ctx context.Context, key string) (string, error) {
+	args := s.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+func TestJwtAccessTokenGateway_Issue_OnSecret_ReturnsSignedTokenWithCustomerClaims(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "AUTH_ACCESS_TOKEN").Return("secret", nil)
+	sut := gateways.JwtAccessTokenGateway{
+		SecretManagerGateway: &secretManagerGatewayMock,
+		Issuer:               "ecommerce-go",
+		Audience:             "ecommerce-go-api",
+	}
+	customerId := uuid.New()
+	issuedAt := time.Now().Truncate(time.Second)
+
+	rawToken, err := sut.Issue(context.Background(), applicationgateways.AccessTokenClaimsDTO{
+		CustomerId: customerId,
+		Roles:      []string{"admin"},
+		Scopes:     []string{"catalog", "orders"},
+		IssuedAt:   issuedAt,
+		ExpiresAt:  issuedAt.Add(15 * time.Minute),
+	})
+
+	assert.NoError(t, err)
+	token, err := jwt.Parse(rawToken, func(token *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "HS256", token.Method.Alg())
+	claims := token.Claims.(jwt.MapClaims)
+	assert.Equal(t, customerId.String(), claims["customerId"])
+	assert.Equal(t, []interface{}{"admin"}, claims["roles"])
+	assert.Equal(t, "catalog orders", claims["scope"])
+	assert.Equal(t, "ecommerce-go", claims["iss"])
+	assert.Equal(t, "ecommerce-go-api", claims["aud"])
+	assert.NotEmpty(t, claims["jti"])
+	assert.Equal(t, float64(issuedAt.Unix()), claims["iat"])
+	assert.Equal(t, float64(issuedAt.Add(15*time.Minute).Unix()), claims["exp"])
+}
+
+func TestJwtAccessTokenGateway_Issue_OnSecretManagerFailure_ReturnsError(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "AUTH_ACCESS_TOKEN").Return("", errors.New("secret not found"))
+	sut := gateways.JwtAccessTokenGateway{SecretManagerGateway: &secretManagerGatewayMock}
+
+	_, err := sut.Issue(context.Background(), applicationgateways.AccessTokenClaimsDTO{
+		CustomerId: uuid.New(),
+		IssuedAt:   time.Now(),
+		ExpiresAt:  time.Now().Add(15 * time.Minute),
+	})
+
+	assert.EqualError(t, err, "secret not found")
+}
+
+func TestJwtAccessTokenGateway_Issue_OnEachCall_ReturnsTokenWithUniqueId(t *testing.T) {
+	secretManagerGatewayMock := SecretManagerGatewayMock{}
+	secretManagerGatewayMock.On("Get", mock.Anything, "AUTH_ACCESS_TOKEN").Return("secret", nil)
+	sut := gateways.JwtAccessTokenGateway{SecretManagerGateway: &secretManagerGatewayMock}
+	customerId := uuid.New()
+	issuedAt := time.Now()
+
+	claims := applicationgateways.AccessTokenClaimsDTO{CustomerId: customerId, IssuedAt: issuedAt, ExpiresAt: issuedAt.Add(15 * time.Minute)}
+	firstToken, _ := sut.Issue(context.Background(), claims)
+	secondToken, _ := sut.Issue(context.Background(), claims)
+
+	firstClaims := jwt.MapClaims{}
+	secondClaims := jwt.MapClaims{}
+	jwt.ParseWithClaims(firstToken, firstClaims, func(token *jwt.Token) (interface{}, error) { return []byte("secret"), nil })
+	jwt.ParseWithClaims(secondToken, secondClaims, func(token *jwt.Token) (interface{}, error) { return []byte("secret"), nil })
+	assert.NotEqual(t, firstClaims["jti"], secondClaims["jti"])
+}
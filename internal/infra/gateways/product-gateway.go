@@ -2,33 +2,48 @@ package gateways
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
 	"github.com/jackc/pgx/v5"
 )
 
 type ProductGateway struct {
-	Conn *pgx.Conn
+	Conn database.IConn
 }
 
-func (p *ProductGateway) FindOneById(id uuid.UUID) (*gateways.ProductDTO, error) {
+func (p *ProductGateway) FindOneById(ctx context.Context, id uuid.UUID) (*gateways.ProductDTO, error) {
 	productSchema := struct {
-		id    uuid.UUID
-		price int64
+		id          uuid.UUID
+		price       int64
+		currency    string
+		taxClass    string
+		weightGrams int32
+		lengthCm    int32
+		widthCm     int32
+		heightCm    int32
 	}{}
 
-	err := p.Conn.QueryRow(context.Background(), "SELECT id, price FROM products WHERE id = $1", id).
-		Scan(&productSchema.id, &productSchema.price)
+	err := p.Conn.QueryRow(ctx, "SELECT id, price, currency, tax_class, weight_grams, length_cm, width_cm, height_cm FROM products WHERE id = $1 AND active = TRUE", id).
+		Scan(&productSchema.id, &productSchema.price, &productSchema.currency, &productSchema.taxClass, &productSchema.weightGrams,
+			&productSchema.lengthCm, &productSchema.widthCm, &productSchema.heightCm)
 
 	if err == nil {
 		return &gateways.ProductDTO{
-			Id:    productSchema.id,
-			Price: productSchema.price,
+			Id:          productSchema.id,
+			Price:       productSchema.price,
+			Currency:    productSchema.currency,
+			TaxClass:    productSchema.taxClass,
+			WeightGrams: productSchema.weightGrams,
+			LengthCm:    productSchema.lengthCm,
+			WidthCm:     productSchema.widthCm,
+			HeightCm:    productSchema.heightCm,
 		}, nil
 	}
 
-	if err.Error() == "no rows in result set" {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
 
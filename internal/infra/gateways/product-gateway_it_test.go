@@ -54,6 +54,13 @@ func (p *ProductGatewaySuite) SetupTest() {
 		CREATE TABLE IF NOT EXISTS products (
 			id UUID PRIMARY KEY,
 			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
+			active BOOLEAN NOT NULL DEFAULT TRUE,
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		)
   `)
@@ -75,7 +82,7 @@ func (p *ProductGatewaySuite) TestProductGateway_FindOneById_OnProductExists_Ret
 	_, err := p.conn.Exec(context.Background(), "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
 	p.Require().NoError(err)
 
-	sut, err := p.productGateway.FindOneById(productId)
+	sut, err := p.productGateway.FindOneById(context.Background(), productId)
 
 	p.NoError(err)
 	p.Equal(productId, sut.Id)
@@ -85,7 +92,18 @@ func (p *ProductGatewaySuite) TestProductGateway_FindOneById_OnProductExists_Ret
 func (p *ProductGatewaySuite) TestProductGateway_FindOneById_OnProductNotExists_ReturnsNil() {
 	productId := uuid.New()
 
-	sut, err := p.productGateway.FindOneById(productId)
+	sut, err := p.productGateway.FindOneById(context.Background(), productId)
+
+	p.NoError(err)
+	p.Nil(sut)
+}
+
+func (p *ProductGatewaySuite) TestProductGateway_FindOneById_OnProductArchived_ReturnsNil() {
+	productId := uuid.New()
+	_, err := p.conn.Exec(context.Background(), "INSERT INTO products (id, price, active) VALUES ($1, $2, $3)", productId, 2550, false)
+	p.Require().NoError(err)
+
+	sut, err := p.productGateway.FindOneById(context.Background(), productId)
 
 	p.NoError(err)
 	p.Nil(sut)
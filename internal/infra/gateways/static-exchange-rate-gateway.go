@@ -0,0 +1,60 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+type StaticExchangeRateGateway struct {
+	base  string
+	asOf  time.Time
+	rates map[string]*big.Rat
+}
+
+func NewStaticExchangeRateGateway(base string, asOf time.Time, rates map[string]string) (*StaticExchangeRateGateway, error) {
+	parsedRates := map[string]*big.Rat{}
+	for code, rate := range rates {
+		parsedRate, ok := new(big.Rat).SetString(rate)
+		if !ok || parsedRate.Sign() <= 0 {
+			return nil, errors.New("exchange rate table has an invalid rate for " + code)
+		}
+
+		parsedRates[strings.ToUpper(code)] = parsedRate
+	}
+
+	base = strings.ToUpper(base)
+	parsedRates[base] = big.NewRat(1, 1)
+
+	return &StaticExchangeRateGateway{
+		base:  base,
+		asOf:  asOf,
+		rates: parsedRates,
+	}, nil
+}
+
+func (s *StaticExchangeRateGateway) FindRate(ctx context.Context, from string, to string) (*gateways.ExchangeRateDTO, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+
+	fromRate, ok := s.rates[from]
+	if !ok {
+		return nil, nil
+	}
+
+	toRate, ok := s.rates[to]
+	if !ok {
+		return nil, nil
+	}
+
+	return &gateways.ExchangeRateDTO{
+		From: from,
+		To:   to,
+		Rate: new(big.Rat).Quo(toRate, fromRate).RatString(),
+		AsOf: s.asOf,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package gateways_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticExchangeRateGateway_FindRate_OnBaseToQuote_ReturnsTableRate(t *testing.T) {
+	asOf := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	sut, _ := infragateways.NewStaticExchangeRateGateway("BRL", asOf, map[string]string{"USD": "0.18", "EUR": "0.17"})
+
+	rate, err := sut.FindRate(context.Background(), "BRL", "USD")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &gateways.ExchangeRateDTO{From: "BRL", To: "USD", Rate: "9/50", AsOf: asOf}, rate)
+}
+
+func TestStaticExchangeRateGateway_FindRate_OnCrossPair_ReturnsRateThroughBase(t *testing.T) {
+	asOf := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	sut, _ := infragateways.NewStaticExchangeRateGateway("BRL", asOf, map[string]string{"USD": "0.18", "EUR": "0.17"})
+
+	rate, err := sut.FindRate(context.Background(), "usd", "eur")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &gateways.ExchangeRateDTO{From: "USD", To: "EUR", Rate: "17/18", AsOf: asOf}, rate)
+}
+
+func TestStaticExchangeRateGateway_FindRate_OnUnknownCurrency_ReturnsNil(t *testing.T) {
+	sut, _ := infragateways.NewStaticExchangeRateGateway("BRL", time.Now(), map[string]string{"USD": "0.18"})
+
+	rate, err := sut.FindRate(context.Background(), "BRL", "EUR")
+
+	assert.NoError(t, err)
+	assert.Nil(t, rate)
+}
+
+func TestStaticExchangeRateGateway_NewStaticExchangeRateGateway_OnInvalidRate_ReturnsError(t *testing.T) {
+	_, err := infragateways.NewStaticExchangeRateGateway("BRL", time.Now(), map[string]string{"USD": "-1"})
+
+	assert.EqualError(t, err, "exchange rate table has an invalid rate for USD")
+}
@@ -0,0 +1,9 @@
+package gateways
+
+import "time"
+
+type SystemClockGateway struct{}
+
+func (s *SystemClockGateway) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,61 @@
+package gateways
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+)
+
+var DefaultShippingMethods = []gateways.ShippingMethodDTO{
+	{
+		Code:     "STANDARD",
+		Name:     "Standard delivery",
+		RateType: "WEIGHT_BAND",
+		Currency: "BRL",
+		WeightBands: []gateways.ShippingWeightBandDTO{
+			{MaxWeightGrams: 1000, Rate: 1990},
+			{MaxWeightGrams: 5000, Rate: 2990},
+			{MaxWeightGrams: 30000, Rate: 5990},
+		},
+		VolumetricDivisor: 6000,
+	},
+	{
+		Code:              "EXPRESS",
+		Name:              "Express delivery",
+		RateType:          "FLAT",
+		Currency:          "BRL",
+		Rate:              4990,
+		VolumetricDivisor: 6000,
+	},
+	{
+		Code:              "ECONOMY",
+		Name:              "Economy delivery",
+		RateType:          "FREE_OVER_THRESHOLD",
+		Currency:          "BRL",
+		Rate:              1490,
+		FreeOverThreshold: 29900,
+		VolumetricDivisor: 6000,
+	},
+}
+
+type TableShippingRateGateway struct {
+	Methods []gateways.ShippingMethodDTO
+}
+
+func (t *TableShippingRateGateway) FindAll(ctx context.Context) ([]gateways.ShippingMethodDTO, error) {
+	methods := []gateways.ShippingMethodDTO{}
+	methods = append(methods, t.Methods...)
+
+	return methods, nil
+}
+
+func (t *TableShippingRateGateway) FindOneByCode(ctx context.Context, code string) (*gateways.ShippingMethodDTO, error) {
+	for _, method := range t.Methods {
+		if strings.EqualFold(method.Code, strings.TrimSpace(code)) {
+			return &method, nil
+		}
+	}
+
+	return nil, nil
+}
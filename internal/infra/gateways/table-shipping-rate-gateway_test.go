@@ -0,0 +1,38 @@
+package gateways_test
+
+import (
+	"context"
+	"testing"
+
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableShippingRateGateway_FindOneByCode_OnCodeInAnyCase_ReturnsMethod(t *testing.T) {
+	sut := infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods}
+
+	method, err := sut.FindOneByCode(context.Background(), " express ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EXPRESS", method.Code)
+	assert.Equal(t, "FLAT", method.RateType)
+	assert.Equal(t, int64(4990), method.Rate)
+}
+
+func TestTableShippingRateGateway_FindOneByCode_OnUnknownCode_ReturnsNil(t *testing.T) {
+	sut := infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods}
+
+	method, err := sut.FindOneByCode(context.Background(), "DRONE")
+
+	assert.NoError(t, err)
+	assert.Nil(t, method)
+}
+
+func TestTableShippingRateGateway_FindAll_OnTable_ReturnsMethodsInTableOrder(t *testing.T) {
+	sut := infragateways.TableShippingRateGateway{Methods: infragateways.DefaultShippingMethods}
+
+	methods, err := sut.FindAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"STANDARD", "EXPRESS", "ECONOMY"}, []string{methods[0].Code, methods[1].Code, methods[2].Code})
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddAddressHandlerInput struct {
+	RecipientName   *string `json:"recipientName" validate:"required"`
+	Line1           *string `json:"line1" validate:"required"`
+	Line2           *string `json:"line2"`
+	City            *string `json:"city" validate:"required"`
+	Region          *string `json:"region"`
+	PostalCode      *string `json:"postalCode" validate:"required"`
+	Country         *string `json:"country" validate:"required"`
+	DefaultShipping *bool   `json:"defaultShipping"`
+	DefaultBilling  *bool   `json:"defaultBilling"`
+}
+
+type AddAddressHandlerOutput struct {
+	AddressId string `json:"addressId"`
+}
+
+type AddAddressHandler struct {
+	Validator  infra.Validator
+	AddAddress usecases.IAddAddress
+}
+
+func (a *AddAddressHandler) Handle(c echo.Context) error {
+	handlerInput := AddAddressHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := a.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	line2 := ""
+	if handlerInput.Line2 != nil {
+		line2 = *handlerInput.Line2
+	}
+
+	region := ""
+	if handlerInput.Region != nil {
+		region = *handlerInput.Region
+	}
+
+	output, err := a.AddAddress.Execute(c.Request().Context(), usecases.AddAddressInput{
+		CustomerId:      customerId,
+		RecipientName:   *handlerInput.RecipientName,
+		Line1:           *handlerInput.Line1,
+		Line2:           line2,
+		City:            *handlerInput.City,
+		Region:          region,
+		PostalCode:      *handlerInput.PostalCode,
+		Country:         *handlerInput.Country,
+		DefaultShipping: handlerInput.DefaultShipping != nil && *handlerInput.DefaultShipping,
+		DefaultBilling:  handlerInput.DefaultBilling != nil && *handlerInput.DefaultBilling,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, AddAddressHandlerOutput{
+		AddressId: output.AddressId.String(),
+	})
+}
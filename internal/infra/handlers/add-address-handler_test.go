@@ -0,0 +1,215 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddAddressMock struct {
+	mock.Mock
+}
+
+func (a *AddAddressMock) Execute(ctx context.Context, input usecases.AddAddressInput) (usecases.AddAddressOutput, error) {
+	args := a.Called(ctx, input)
+	return args.Get(0).(usecases.AddAddressOutput), args.Error(1)
+}
+
+type AddAddressHandlerSuite struct {
+	suite.Suite
+	addAddressMock    AddAddressMock
+	addAddressHandler handlers.AddAddressHandler
+}
+
+func (a *AddAddressHandlerSuite) SetupTest() {
+	a.addAddressMock = AddAddressMock{}
+	a.addAddressHandler = handlers.AddAddressHandler{
+		Validator:  infra.NewValidator(),
+		AddAddress: &a.addAddressMock,
+	}
+}
+
+func (a *AddAddressHandlerSuite) TestAddAddressHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	a.addAddressMock.On("Execute", mock.Anything, usecases.AddAddressInput{
+		CustomerId:      uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		RecipientName:   "John Doe",
+		Line1:           "Av. Paulista, 1000",
+		Line2:           "Apt 12",
+		City:            "São Paulo",
+		Region:          "SP",
+		PostalCode:      "01310-100",
+		Country:         "BR",
+		DefaultShipping: true,
+		DefaultBilling:  false,
+	}).Return(usecases.AddAddressOutput{
+		AddressId: uuid.MustParse("9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+	{
+		"recipientName": "John Doe",
+		"line1": "Av. Paulista, 1000",
+		"line2": "Apt 12",
+		"city": "São Paulo",
+		"region": "SP",
+		"postalCode": "01310-100",
+		"country": "BR",
+		"defaultShipping": true
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.addAddressHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (a *AddAddressHandlerSuite) TestAddAddressHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		address.ErrAddressCountryNotSupported: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "We don't deliver to this country yet. Please use another address.",
+		},
+		address.ErrAddressBookFull: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "You can save up to 10 addresses. Please remove one before adding a new address.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		a.SetupTest()
+		e := echo.New()
+		a.addAddressMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddAddressOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(
+			`{"recipientName": "John Doe", "line1": "1-1 Chiyoda", "city": "Tokyo", "postalCode": "100-0001", "country": "JP"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		a.addAddressHandler.Handle(context)
+
+		a.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		a.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (a *AddAddressHandlerSuite) TestAddAddressHandler_Handle_OnAddressValidationErrors_ReturnsBadRequest() {
+	errorsAndMessages := map[error]map[string]string{
+		address.ErrAddressRecipientNameEmpty: {
+			"errors": `["recipientName cannot be empty"]`,
+		},
+		address.ErrAddressLineEmpty: {
+			"errors": `["line1 cannot be empty"]`,
+		},
+		address.ErrAddressCityEmpty: {
+			"errors": `["city cannot be empty"]`,
+		},
+		address.ErrAddressRegionEmpty: {
+			"errors": `["region is required for this country"]`,
+		},
+		address.ErrAddressPostalCodeInvalid: {
+			"errors": `["postalCode is not valid for this country"]`,
+		},
+	}
+
+	for useCaseError, errorAndMessages := range errorsAndMessages {
+		a.SetupTest()
+		e := echo.New()
+		a.addAddressMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddAddressOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(
+			`{"recipientName": " ", "line1": " ", "city": " ", "postalCode": "123", "country": "BR"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		a.addAddressHandler.Handle(context)
+
+		a.Equal(400, recorder.Code)
+		a.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, errorAndMessages["errors"]), recorder.Body.String())
+	}
+}
+
+func (a *AddAddressHandlerSuite) TestAddAddressHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["recipientName is required", "line1 is required", "city is required", "postalCode is required", "country is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		a.addAddressHandler.Handle(context)
+
+		a.Equal(400, recorder.Code)
+		a.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestAddAddressHandler(t *testing.T) {
+	suite.Run(t, new(AddAddressHandlerSuite))
+}
@@ -1,8 +1,6 @@
 package handlers
 
 import (
-	"fmt"
-
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra"
@@ -36,29 +34,24 @@ func (a *AddProductToCartHandler) Handle(c echo.Context) error {
 		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
 	}
 
-	if c.Get("customerId") == nil {
-		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
-	}
-
-	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	customerId, err := optionalCustomerId(c)
 	if err != nil {
 		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
 	}
 
-	err = a.AddProductToCart.Execute(usecases.AddProductToCartInput{
+	output, err := a.AddProductToCart.Execute(c.Request().Context(), usecases.AddProductToCartInput{
 		CustomerId: customerId,
 		ProductId:  productId,
 		Quantity:   int32(*handlerInput.Quantity),
+		CartToken:  readCartToken(c),
 	})
 
 	if err != nil {
-		switch err.Error() {
-		case "product not found":
-			return webhttp.NewNotFound(c, fmt.Sprintf(`We couldn't find a product with the ID '%s'. Please check the product ID and try again.`,
-				*handlerInput.ProductId))
-		}
+		return webhttp.NewErrorResponse(c, err)
+	}
 
-		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	if output.CartToken != "" {
+		writeCartToken(c, output.CartToken)
 	}
 
 	return webhttp.NewOk(c, nil)
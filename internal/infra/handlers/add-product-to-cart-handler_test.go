@@ -1,13 +1,18 @@
 package handlers_test
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
 	"github.com/labstack/echo/v4"
@@ -19,9 +24,9 @@ type AddProductToCartMock struct {
 	mock.Mock
 }
 
-func (a *AddProductToCartMock) Execute(input usecases.AddProductToCartInput) error {
-	args := a.Called(input)
-	return args.Error(0)
+func (a *AddProductToCartMock) Execute(ctx context.Context, input usecases.AddProductToCartInput) (usecases.AddProductToCartOutput, error) {
+	args := a.Called(ctx, input)
+	return args.Get(0).(usecases.AddProductToCartOutput), args.Error(1)
 }
 
 type AddProductToCartHandlerSuite struct {
@@ -40,7 +45,7 @@ func (a *AddProductToCartHandlerSuite) SetupTest() {
 
 func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnNoErrors_ReturnsOk() {
 	e := echo.New()
-	a.addProductToCartMock.On("Execute", mock.Anything).Return(nil)
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, nil)
 	request := httptest.NewRequest("POST", "/", strings.NewReader(`
 		{
 			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
@@ -65,9 +70,83 @@ func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnNoEr
 	`, recorder.Body.String())
 }
 
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnGuestWithoutCart_SetsCartTokenCookie() {
+	e := echo.New()
+	a.addProductToCartMock.On("Execute", mock.Anything, usecases.AddProductToCartInput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+		Quantity:  4,
+	}).Return(usecases.AddProductToCartOutput{CartToken: "cart-token"}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 4
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	cookies := recorder.Result().Cookies()
+	a.Require().Len(cookies, 1)
+	a.Equal("cart_token", cookies[0].Name)
+	a.Equal("cart-token", cookies[0].Value)
+	a.Equal("/", cookies[0].Path)
+	a.Equal(2592000, cookies[0].MaxAge)
+	a.True(cookies[0].HttpOnly)
+	a.True(cookies[0].Secure)
+	a.Equal(http.SameSiteLaxMode, cookies[0].SameSite)
+}
+
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnGuestWithCartTokenCookie_PassesCartToken() {
+	e := echo.New()
+	a.addProductToCartMock.On("Execute", mock.Anything, usecases.AddProductToCartInput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+		Quantity:  4,
+		CartToken: "cart-token",
+	}).Return(usecases.AddProductToCartOutput{}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 4
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	request.AddCookie(&http.Cookie{Name: "cart_token", Value: "cart-token"})
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	a.Empty(recorder.Result().Cookies())
+}
+
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnRequest_PassesTheRequestContextToTheUseCase() {
+	e := echo.New()
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 4
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	a.addProductToCartMock.On("Execute", request.Context(), mock.Anything).Return(usecases.AddProductToCartOutput{}, nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	a.addProductToCartMock.AssertExpectations(a.T())
+}
+
 func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnProductNotFound_ReturnsNotFound() {
 	e := echo.New()
-	a.addProductToCartMock.On("Execute", mock.Anything).Return(errors.New("product not found"))
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, usecases.ErrProductNotFound)
 	request := httptest.NewRequest("POST", "/", strings.NewReader(`
 		{
 			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
@@ -87,13 +166,94 @@ func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnProd
 		"status": "ERROR",
 		"statusCode": 404,
 		"statusText": "NOT_FOUND",
-		"error": "We couldn't find a product with the ID '632ef70b-4184-4704-ad7d-8b8f5dd534d9'. Please check the product ID and try again."
+		"error": "We couldn't find this product. Please check the product ID and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnInsufficientStock_ReturnsConflict() {
+	e := echo.New()
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, inventory.ErrInsufficientStock)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 10000
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(409, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "There is not enough stock for one of the products. Please review your cart and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnCurrencyMismatch_ReturnsConflict() {
+	e := echo.New()
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, cart.ErrCartCannotMixCurrencies)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 1
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(409, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "This product is priced in a different currency than the products already in your cart."
+	}
+	`, recorder.Body.String())
+}
+
+func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnCartVersionConflict_ReturnsConflict() {
+	e := echo.New()
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, &repositories.CartVersionConflictError{})
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 1
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.addProductToCartHandler.Handle(context)
+
+	a.Equal(409, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "Your cart was changed by another request at the same time. Please try again."
 	}
 	`, recorder.Body.String())
 }
 
 func (a *AddProductToCartHandlerSuite) TestAddProductToCartHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
-	a.addProductToCartMock.On("Execute", mock.Anything).Return(nil)
+	a.addProductToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.AddProductToCartOutput{}, nil)
 	bodiesAndErrors := []map[string]string{
 		{
 			"body":   `abc`,
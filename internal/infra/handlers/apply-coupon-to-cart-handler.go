@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ApplyCouponToCartHandlerInput struct {
+	CouponCode *string `json:"couponCode" validate:"required"`
+}
+
+type ApplyCouponToCartHandlerOutput struct {
+	CouponCode   string `json:"couponCode"`
+	Currency     string `json:"currency"`
+	Subtotal     int64  `json:"subtotal"`
+	Discount     int64  `json:"discount"`
+	Total        int64  `json:"total"`
+	FreeShipping bool   `json:"freeShipping"`
+}
+
+type ApplyCouponToCartHandler struct {
+	Validator         infra.Validator
+	ApplyCouponToCart usecases.IApplyCouponToCart
+}
+
+func (a *ApplyCouponToCartHandler) Handle(c echo.Context) error {
+	handlerInput := ApplyCouponToCartHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := a.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	output, err := a.ApplyCouponToCart.Execute(c.Request().Context(), usecases.ApplyCouponToCartInput{
+		CustomerId: customerId,
+		CouponCode: *handlerInput.CouponCode,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, ApplyCouponToCartHandlerOutput{
+		CouponCode:   output.CouponCode,
+		Currency:     output.Currency,
+		Subtotal:     output.Subtotal,
+		Discount:     output.Discount,
+		Total:        output.Total,
+		FreeShipping: output.FreeShipping,
+	})
+}
@@ -0,0 +1,192 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ApplyCouponToCartMock struct {
+	mock.Mock
+}
+
+func (a *ApplyCouponToCartMock) Execute(ctx context.Context, input usecases.ApplyCouponToCartInput) (usecases.ApplyCouponToCartOutput, error) {
+	args := a.Called(ctx, input)
+	return args.Get(0).(usecases.ApplyCouponToCartOutput), args.Error(1)
+}
+
+type ApplyCouponToCartHandlerSuite struct {
+	suite.Suite
+	applyCouponToCartMock    ApplyCouponToCartMock
+	applyCouponToCartHandler handlers.ApplyCouponToCartHandler
+}
+
+func (a *ApplyCouponToCartHandlerSuite) SetupTest() {
+	a.applyCouponToCartMock = ApplyCouponToCartMock{}
+	a.applyCouponToCartHandler = handlers.ApplyCouponToCartHandler{
+		Validator:         infra.NewValidator(),
+		ApplyCouponToCart: &a.applyCouponToCartMock,
+	}
+}
+
+func (a *ApplyCouponToCartHandlerSuite) TestApplyCouponToCartHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	a.applyCouponToCartMock.On("Execute", mock.Anything, usecases.ApplyCouponToCartInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		CouponCode: "save20",
+	}).Return(usecases.ApplyCouponToCartOutput{
+		CouponCode:   "SAVE20",
+		Currency:     "BRL",
+		Subtotal:     10000,
+		Discount:     2000,
+		Total:        8000,
+		FreeShipping: false,
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"couponCode": "save20"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	a.applyCouponToCartHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"couponCode": "SAVE20",
+			"currency": "BRL",
+			"subtotal": 10000,
+			"discount": 2000,
+			"total": 8000,
+			"freeShipping": false
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (a *ApplyCouponToCartHandlerSuite) TestApplyCouponToCartHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrCartNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find a cart for your account. Please add a product to your cart first.",
+		},
+		usecases.ErrCouponNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this coupon. Please check the code and try again.",
+		},
+		cart.ErrCartEmpty: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Your cart is empty. Please add a product to your cart first.",
+		},
+		promotion.ErrCouponNotActiveYet: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "This coupon is not active yet. Please try again later.",
+		},
+		promotion.ErrCouponExpired: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "This coupon has expired.",
+		},
+		promotion.ErrCouponUsageLimitReached: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "This coupon has reached its usage limit.",
+		},
+		promotion.ErrCouponMinimumSpendNotMet: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "Your cart does not meet the minimum spend required by this coupon.",
+		},
+		promotion.ErrCouponNotApplicable: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "Your cart does not contain the products required by this coupon.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		a.SetupTest()
+		e := echo.New()
+		a.applyCouponToCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ApplyCouponToCartOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"couponCode": "SAVE20"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		a.applyCouponToCartHandler.Handle(context)
+
+		a.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		a.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (a *ApplyCouponToCartHandlerSuite) TestApplyCouponToCartHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["couponCode is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		a.applyCouponToCartHandler.Handle(context)
+
+		a.Equal(400, recorder.Code)
+		a.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestApplyCouponToCartHandler(t *testing.T) {
+	suite.Run(t, new(ApplyCouponToCartHandlerSuite))
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ArchiveProductHandlerInput struct {
+	ProductId *string `json:"productId" validate:"required,uuid4"`
+}
+
+type ArchiveProductHandler struct {
+	Validator      infra.Validator
+	ArchiveProduct usecases.IArchiveProduct
+}
+
+func (h *ArchiveProductHandler) Handle(c echo.Context) error {
+	handlerInput := ArchiveProductHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	productId, err := uuid.Parse(*handlerInput.ProductId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = h.ArchiveProduct.Execute(c.Request().Context(), usecases.ArchiveProductInput{
+		ProductId: productId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
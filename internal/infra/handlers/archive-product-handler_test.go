@@ -0,0 +1,116 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ArchiveProductMock struct {
+	mock.Mock
+}
+
+func (a *ArchiveProductMock) Execute(ctx context.Context, input usecases.ArchiveProductInput) error {
+	args := a.Called(ctx, input)
+	return args.Error(0)
+}
+
+type ArchiveProductHandlerSuite struct {
+	suite.Suite
+	archiveProductMock    ArchiveProductMock
+	archiveProductHandler handlers.ArchiveProductHandler
+}
+
+func (a *ArchiveProductHandlerSuite) SetupTest() {
+	a.archiveProductMock = ArchiveProductMock{}
+	a.archiveProductHandler = handlers.ArchiveProductHandler{
+		Validator:      infra.NewValidator(),
+		ArchiveProduct: &a.archiveProductMock,
+	}
+}
+
+func (a *ArchiveProductHandlerSuite) TestArchiveProductHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	a.archiveProductMock.On("Execute", mock.Anything, usecases.ArchiveProductInput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+	}).Return(nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	a.archiveProductHandler.Handle(context)
+
+	a.Equal(200, recorder.Code)
+	a.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (a *ArchiveProductHandlerSuite) TestArchiveProductHandler_Handle_OnErrors_ReturnsErrorResponse() {
+	errorsAndResponses := map[error]string{
+		usecases.ErrProductNotFound: `
+		{
+			"status": "ERROR",
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "We couldn't find this product. Please check the product ID and try again."
+		}`,
+		product.ErrProductAlreadyArchived: `
+		{
+			"status": "ERROR",
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "This product is already archived."
+		}`,
+		errors.New("connection refused"): `
+		{
+			"status": "ERROR",
+			"statusCode": 500,
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"error": "Something went wrong. Please try again later."
+		}`,
+	}
+
+	for useCaseError, response := range errorsAndResponses {
+		a.SetupTest()
+		e := echo.New()
+		a.archiveProductMock.On("Execute", mock.Anything, mock.Anything).Return(useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`
+			{
+				"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+			}
+		`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		a.archiveProductHandler.Handle(context)
+
+		a.JSONEq(response, recorder.Body.String())
+	}
+}
+
+func TestArchiveProductHandler(t *testing.T) {
+	suite.Run(t, new(ArchiveProductHandlerSuite))
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import "slices"
+
+type AuthorizationPolicy struct {
+	Roles  []string
+	Scopes []string
+}
+
+func (a AuthorizationPolicy) Allows(roles []string, scopes []string) bool {
+	if len(a.Roles) > 0 && !slices.ContainsFunc(a.Roles, func(role string) bool { return slices.Contains(roles, role) }) {
+		return false
+	}
+
+	for _, scope := range a.Scopes {
+		if !slices.Contains(scopes, scope) {
+			return false
+		}
+	}
+
+	return true
+}
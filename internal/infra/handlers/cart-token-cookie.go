@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	CartTokenCookieName   = "cart_token"
+	CartTokenCookieMaxAge = 30 * 24 * time.Hour
+)
+
+func optionalCustomerId(c echo.Context) (uuid.UUID, error) {
+	if c.Get("customerId") == nil {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(c.Get("customerId").(string))
+}
+
+func readCartToken(c echo.Context) string {
+	cookie, err := c.Cookie(CartTokenCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+func writeCartToken(c echo.Context, cartToken string) {
+	c.SetCookie(&http.Cookie{
+		Name:     CartTokenCookieName,
+		Value:    cartToken,
+		Path:     "/",
+		MaxAge:   int(CartTokenCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearCartToken(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     CartTokenCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
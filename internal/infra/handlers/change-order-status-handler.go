@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ChangeOrderStatusHandlerInput struct {
+	OrderId *string `json:"orderId" validate:"required,uuid4"`
+	Status  *string `json:"status" validate:"required"`
+}
+
+type ChangeOrderStatusHandler struct {
+	Validator         infra.Validator
+	ChangeOrderStatus usecases.IChangeOrderStatus
+}
+
+func (h *ChangeOrderStatusHandler) Handle(c echo.Context) error {
+	handlerInput := ChangeOrderStatusHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	orderId, err := uuid.Parse(*handlerInput.OrderId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = h.ChangeOrderStatus.Execute(c.Request().Context(), usecases.ChangeOrderStatusInput{
+		OrderId: orderId,
+		Status:  *handlerInput.Status,
+		Actor:   c.Get("customerId").(string),
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
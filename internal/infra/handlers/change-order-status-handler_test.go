@@ -0,0 +1,169 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeOrderStatusMock struct {
+	mock.Mock
+}
+
+func (c *ChangeOrderStatusMock) Execute(ctx context.Context, input usecases.ChangeOrderStatusInput) error {
+	args := c.Called(ctx, input)
+	return args.Error(0)
+}
+
+type ChangeOrderStatusHandlerSuite struct {
+	suite.Suite
+	changeOrderStatusMock    ChangeOrderStatusMock
+	changeOrderStatusHandler handlers.ChangeOrderStatusHandler
+}
+
+func (c *ChangeOrderStatusHandlerSuite) SetupTest() {
+	c.changeOrderStatusMock = ChangeOrderStatusMock{}
+	c.changeOrderStatusHandler = handlers.ChangeOrderStatusHandler{
+		Validator:         infra.NewValidator(),
+		ChangeOrderStatus: &c.changeOrderStatusMock,
+	}
+}
+
+func (c *ChangeOrderStatusHandlerSuite) TestChangeOrderStatusHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	c.changeOrderStatusMock.On("Execute", mock.Anything, usecases.ChangeOrderStatusInput{
+		OrderId: uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"),
+		Status:  "SHIPPED",
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	}).Return(nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11",
+			"status": "SHIPPED"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	c.changeOrderStatusHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (c *ChangeOrderStatusHandlerSuite) TestChangeOrderStatusHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		order.ErrOrderStatusInvalid: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "This is not a valid order status.",
+		},
+		usecases.ErrOrderNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this order. Please check the order ID and try again.",
+		},
+		usecases.ErrOrderRefundRequired: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Paid orders can only be cancelled through the refund endpoint.",
+		},
+		order.ErrOrderStatusTransitionNotAllowed: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "The order cannot be moved to this status from its current status.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		c.SetupTest()
+		e := echo.New()
+		c.changeOrderStatusMock.On("Execute", mock.Anything, mock.Anything).Return(useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`
+			{
+				"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11",
+				"status": "SHIPPED"
+			}
+		`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		c.changeOrderStatusHandler.Handle(context)
+
+		c.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		c.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (c *ChangeOrderStatusHandlerSuite) TestChangeOrderStatusHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["orderId is required", "status is required"]`,
+		},
+		{
+			"body": `{
+				"orderId": "abc",
+				"status": "PAID"
+			}`,
+			"errors": `["orderId must be uuidv4"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(inputAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		c.changeOrderStatusHandler.Handle(context)
+
+		c.Equal(400, recorder.Code)
+		c.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, inputAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestChangeOrderStatusHandler(t *testing.T) {
+	suite.Run(t, new(ChangeOrderStatusHandlerSuite))
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CheckoutHandlerInput struct {
+	CardNumber *string `json:"cardNumber" validate:"required,credit_card"`
+	AddressId  *string `json:"addressId" validate:"omitempty,uuid4"`
+}
+
+type CheckoutHandlerOutput struct {
+	OrderId string `json:"orderId"`
+}
+
+type CheckoutHandler struct {
+	Validator infra.Validator
+	Checkout  usecases.ICheckout
+}
+
+func (h *CheckoutHandler) Handle(c echo.Context) error {
+	handlerInput := CheckoutHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	addressId := uuid.Nil
+	if handlerInput.AddressId != nil {
+		addressId, err = uuid.Parse(*handlerInput.AddressId)
+		if err != nil {
+			return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+		}
+	}
+
+	output, err := h.Checkout.Execute(c.Request().Context(), usecases.CheckoutInput{
+		CustomerId: customerId,
+		CardNumber: *handlerInput.CardNumber,
+		AddressId:  addressId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, CheckoutHandlerOutput{
+		OrderId: output.OrderId.String(),
+	})
+}
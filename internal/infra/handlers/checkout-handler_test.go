@@ -0,0 +1,296 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/inventory"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CheckoutMock struct {
+	mock.Mock
+}
+
+func (c *CheckoutMock) Execute(ctx context.Context, input usecases.CheckoutInput) (usecases.CheckoutOutput, error) {
+	args := c.Called(ctx, input)
+	return args.Get(0).(usecases.CheckoutOutput), args.Error(1)
+}
+
+type CheckoutHandlerSuite struct {
+	suite.Suite
+	checkoutMock    CheckoutMock
+	checkoutHandler handlers.CheckoutHandler
+}
+
+func (c *CheckoutHandlerSuite) SetupTest() {
+	c.checkoutMock = CheckoutMock{}
+	c.checkoutHandler = handlers.CheckoutHandler{
+		Validator: infra.NewValidator(),
+		Checkout:  &c.checkoutMock,
+	}
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnRegionInBody_IgnoresIt() {
+	e := echo.New()
+	c.checkoutMock.On("Execute", mock.Anything, usecases.CheckoutInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		CardNumber: "4242424242424242",
+	}).Return(usecases.CheckoutOutput{
+		OrderId: uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"cardNumber": "4242424242424242", "region": "SP"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	c.checkoutHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+	c.checkoutMock.AssertExpectations(c.T())
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnAddressId_PassesAddressToCheckout() {
+	e := echo.New()
+	c.checkoutMock.On("Execute", mock.Anything, usecases.CheckoutInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		CardNumber: "4242424242424242",
+		AddressId:  uuid.MustParse("9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"),
+	}).Return(usecases.CheckoutOutput{
+		OrderId: uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/",
+		strings.NewReader(`{"cardNumber": "4242424242424242", "addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	c.checkoutHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+	c.checkoutMock.AssertExpectations(c.T())
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnTaxRuleNotFoundForAddressRegion_ReturnsNotFound() {
+	e := echo.New()
+	c.checkoutMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.CheckoutOutput{}, tax.ErrTaxRuleNotFound)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"cardNumber": "4242424242424242"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	c.checkoutHandler.Handle(context)
+
+	c.Equal(404, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We can't calculate taxes for your shipping address. Please check its country and region and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	c.checkoutMock.On("Execute", mock.Anything, usecases.CheckoutInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		CardNumber: "4242424242424242",
+	}).Return(usecases.CheckoutOutput{
+		OrderId: uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"cardNumber": "4242424242424242"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	c.checkoutHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrCartNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find a cart for your account. Please add a product to your cart first.",
+		},
+		cart.ErrCartEmpty: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Your cart is empty. Please add a product to your cart first.",
+		},
+		usecases.ErrProductNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this product. Please check the product ID and try again.",
+		},
+		address.ErrAddressNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this address in your address book.",
+		},
+		usecases.ErrShippingAddressRequired: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "Please add a shipping address to your account or choose one before checking out.",
+		},
+		inventory.ErrInsufficientStock: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "There is not enough stock for one of the products. Please review your cart and try again.",
+		},
+		gateways.ErrStockReservationExpired: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Your checkout took too long and the reserved stock was released. Please try again.",
+		},
+		promotion.ErrCouponExpired: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "This coupon has expired.",
+		},
+		promotion.ErrCouponUsageLimitReached: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "This coupon has reached its usage limit.",
+		},
+		promotion.ErrCouponMinimumSpendNotMet: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "Your cart does not meet the minimum spend required by this coupon.",
+		},
+		shipping.ErrShippingWeightNotSupported: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "This delivery method can't ship a cart of this size or weight. Please choose another method.",
+		},
+		order.ErrOrderCannotMixCurrencies: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "The products in your cart are priced in different currencies. Please review your cart and try again.",
+		},
+		gateways.ErrPaymentDeclined: {
+			"statusCode": "402",
+			"statusText": "PAYMENT_REQUIRED",
+			"message":    "Your payment was declined. Please use a different card and try again.",
+		},
+		gateways.ErrPaymentProviderTimeout: {
+			"statusCode": "503",
+			"statusText": "SERVICE_UNAVAILABLE",
+			"message":    "Our payment provider is not responding. Please try again in a few minutes.",
+		},
+		repositories.ErrCartVersionConflict: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Your cart was changed by another request at the same time. Please try again.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		c.SetupTest()
+		e := echo.New()
+		c.checkoutMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.CheckoutOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"cardNumber": "4242424242424242"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		c.checkoutHandler.Handle(context)
+
+		c.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		c.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (c *CheckoutHandlerSuite) TestCheckoutHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["cardNumber is required"]`,
+		},
+		{
+			"body":   `{"cardNumber": "1234"}`,
+			"errors": `["cardNumber must be a valid card number"]`,
+		},
+		{
+			"body":   `{"cardNumber": "4242424242424242", "addressId": "abc"}`,
+			"errors": `["addressId must be uuidv4"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(inputAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		c.checkoutHandler.Handle(context)
+
+		c.Equal(400, recorder.Code)
+		c.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, inputAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestCheckoutHandler(t *testing.T) {
+	suite.Run(t, new(CheckoutHandlerSuite))
+}
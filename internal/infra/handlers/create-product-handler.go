@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ProductDimensionsHandlerInput struct {
+	WeightGrams *int32 `json:"weightGrams" validate:"required,gte=0"`
+	LengthCm    *int32 `json:"lengthCm" validate:"required,gte=0"`
+	WidthCm     *int32 `json:"widthCm" validate:"required,gte=0"`
+	HeightCm    *int32 `json:"heightCm" validate:"required,gte=0"`
+}
+
+type CreateProductHandlerInput struct {
+	Name         *string                        `json:"name" validate:"required"`
+	Description  *string                        `json:"description"`
+	Sku          *string                        `json:"sku" validate:"required"`
+	Price        *int64                         `json:"price" validate:"required,gte=0"`
+	Currency     *string                        `json:"currency"`
+	TaxClass     *string                        `json:"taxClass"`
+	Dimensions   *ProductDimensionsHandlerInput `json:"dimensions"`
+	InitialStock *int32                         `json:"initialStock" validate:"omitempty,gte=0"`
+}
+
+type CreateProductHandlerOutput struct {
+	ProductId string `json:"productId"`
+}
+
+type CreateProductHandler struct {
+	Validator     infra.Validator
+	CreateProduct usecases.ICreateProduct
+}
+
+func (h *CreateProductHandler) Handle(c echo.Context) error {
+	handlerInput := CreateProductHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	description := ""
+	if handlerInput.Description != nil {
+		description = *handlerInput.Description
+	}
+
+	currency := ""
+	if handlerInput.Currency != nil {
+		currency = *handlerInput.Currency
+	}
+
+	taxClass := ""
+	if handlerInput.TaxClass != nil {
+		taxClass = *handlerInput.TaxClass
+	}
+
+	var dimensions *usecases.ProductDimensionsInput
+	if handlerInput.Dimensions != nil {
+		dimensions = &usecases.ProductDimensionsInput{
+			WeightGrams: *handlerInput.Dimensions.WeightGrams,
+			LengthCm:    *handlerInput.Dimensions.LengthCm,
+			WidthCm:     *handlerInput.Dimensions.WidthCm,
+			HeightCm:    *handlerInput.Dimensions.HeightCm,
+		}
+	}
+
+	initialStock := int32(0)
+	if handlerInput.InitialStock != nil {
+		initialStock = *handlerInput.InitialStock
+	}
+
+	output, err := h.CreateProduct.Execute(c.Request().Context(), usecases.CreateProductInput{
+		Name:         *handlerInput.Name,
+		Description:  description,
+		Sku:          *handlerInput.Sku,
+		Price:        *handlerInput.Price,
+		Currency:     currency,
+		TaxClass:     taxClass,
+		Dimensions:   dimensions,
+		InitialStock: initialStock,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, CreateProductHandlerOutput{
+		ProductId: output.ProductId.String(),
+	})
+}
@@ -0,0 +1,291 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateProductMock struct {
+	mock.Mock
+}
+
+func (c *CreateProductMock) Execute(ctx context.Context, input usecases.CreateProductInput) (usecases.CreateProductOutput, error) {
+	args := c.Called(ctx, input)
+	return args.Get(0).(usecases.CreateProductOutput), args.Error(1)
+}
+
+type CreateProductHandlerSuite struct {
+	suite.Suite
+	createProductMock    CreateProductMock
+	createProductHandler handlers.CreateProductHandler
+}
+
+func (c *CreateProductHandlerSuite) SetupTest() {
+	c.createProductMock = CreateProductMock{}
+	c.createProductHandler = handlers.CreateProductHandler{
+		Validator:     infra.NewValidator(),
+		CreateProduct: &c.createProductMock,
+	}
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, usecases.CreateProductInput{
+		Name:        "Mechanical Keyboard",
+		Description: "Hot-swappable switches",
+		Sku:         "KB-001",
+		Price:       45990,
+	}).Return(usecases.CreateProductOutput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"description": "Hot-swappable switches",
+			"sku": "KB-001",
+			"price": 45990
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnUnsupportedCurrency_ReturnsBadRequest() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, mock.MatchedBy(func(input usecases.CreateProductInput) bool {
+		return input.Currency == "JPY"
+	})).Return(usecases.CreateProductOutput{}, models.ErrCurrencyNotSupported)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"sku": "KB-001",
+			"price": 45990,
+			"currency": "JPY"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(400, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 400,
+		"statusText": "BAD_REQUEST",
+		"errors": ["currency must be one of BRL, USD or EUR"]
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnBlankTaxClass_ReturnsBadRequest() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, mock.MatchedBy(func(input usecases.CreateProductInput) bool {
+		return input.TaxClass == " "
+	})).Return(usecases.CreateProductOutput{}, product.ErrProductTaxClassEmpty)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"sku": "KB-001",
+			"price": 45990,
+			"taxClass": " "
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(400, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 400,
+		"statusText": "BAD_REQUEST",
+		"errors": ["taxClass cannot be empty"]
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnDimensions_PassesDimensionsToUseCase() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, usecases.CreateProductInput{
+		Name:       "Mechanical Keyboard",
+		Sku:        "KB-001",
+		Price:      45990,
+		Dimensions: &usecases.ProductDimensionsInput{WeightGrams: 1200, LengthCm: 45, WidthCm: 15, HeightCm: 4},
+	}).Return(usecases.CreateProductOutput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"sku": "KB-001",
+			"price": 45990,
+			"dimensions": {
+				"weightGrams": 1200,
+				"lengthCm": 45,
+				"widthCm": 15,
+				"heightCm": 4
+			}
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnInitialStock_PassesInitialStockToUseCase() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, usecases.CreateProductInput{
+		Name:         "Mechanical Keyboard",
+		Sku:          "KB-001",
+		Price:        45990,
+		InitialStock: 25,
+	}).Return(usecases.CreateProductOutput{
+		ProductId: uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"sku": "KB-001",
+			"price": 45990,
+			"initialStock": 25
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(200, recorder.Code)
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnSkuAlreadyExists_ReturnsConflict() {
+	e := echo.New()
+	c.createProductMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.CreateProductOutput{}, usecases.ErrProductSkuAlreadyExists)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+		{
+			"name": "Mechanical Keyboard",
+			"sku": "KB-001",
+			"price": 45990
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	c.createProductHandler.Handle(context)
+
+	c.Equal(409, recorder.Code)
+	c.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "A product with this SKU already exists. Please use a different SKU."
+	}
+	`, recorder.Body.String())
+}
+
+func (c *CreateProductHandlerSuite) TestCreateProductHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	c.createProductMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.CreateProductOutput{}, product.ErrProductNameEmpty)
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["name is required", "sku is required", "price is required"]`,
+		},
+		{
+			"body": `{
+				"name": "Mechanical Keyboard",
+				"sku": "KB-001",
+				"price": -1
+			}`,
+			"errors": `["price must be greater than or equal to 0"]`,
+		},
+		{
+			"body": `{
+				"name": "Mechanical Keyboard",
+				"sku": "KB-001",
+				"price": 45990,
+				"dimensions": {"weightGrams": -1, "lengthCm": 45, "widthCm": 15}
+			}`,
+			"errors": `["weightGrams must be greater than or equal to 0", "heightCm is required"]`,
+		},
+		{
+			"body": `{
+				"name": " ",
+				"sku": "KB-001",
+				"price": 45990
+			}`,
+			"errors": `["name cannot be empty"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		body := inputAndError["body"]
+		errorMessage := inputAndError["errors"]
+
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		c.createProductHandler.Handle(context)
+
+		c.Equal(400, recorder.Code)
+		c.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, errorMessage), recorder.Body.String())
+	}
+}
+
+func TestCreateProductHandler(t *testing.T) {
+	suite.Run(t, new(CreateProductHandlerSuite))
+}
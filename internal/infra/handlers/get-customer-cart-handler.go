@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetCustomerCartHandlerInput struct {
+	Currency *string `query:"currency"`
+	Country  *string `query:"country"`
+	Region   *string `query:"region"`
+}
+
+type GetCustomerCartConvertedItemHandlerOutput struct {
+	ProductId  string `json:"productId"`
+	UnitPrice  int64  `json:"unitPrice"`
+	TotalPrice int64  `json:"totalPrice"`
+}
+
+type GetCustomerCartConvertedHandlerOutput struct {
+	Currency string                                      `json:"currency"`
+	Items    []GetCustomerCartConvertedItemHandlerOutput `json:"items"`
+	Subtotal int64                                       `json:"subtotal"`
+	Discount int64                                       `json:"discount"`
+	Total    int64                                       `json:"total"`
+}
+
+type GetCustomerCartTaxLineHandlerOutput struct {
+	ProductId       string `json:"productId"`
+	TaxClass        string `json:"taxClass"`
+	RateBasisPoints int64  `json:"rateBasisPoints"`
+	Inclusive       bool   `json:"inclusive"`
+	Net             int64  `json:"net"`
+	Tax             int64  `json:"tax"`
+	Gross           int64  `json:"gross"`
+}
+
+type GetCustomerCartTaxHandlerOutput struct {
+	Country string                                `json:"country"`
+	Region  string                                `json:"region"`
+	Lines   []GetCustomerCartTaxLineHandlerOutput `json:"lines"`
+	Net     int64                                 `json:"net"`
+	Tax     int64                                 `json:"tax"`
+	Gross   int64                                 `json:"gross"`
+}
+
+type GetCustomerCartShippingHandlerOutput struct {
+	ShippingMethod string `json:"shippingMethod"`
+	Name           string `json:"name"`
+	Cost           int64  `json:"cost"`
+}
+
+type GetCustomerCartItemHandlerOutput struct {
+	ProductId  string `json:"productId"`
+	Quantity   int32  `json:"quantity"`
+	UnitPrice  int64  `json:"unitPrice"`
+	TotalPrice int64  `json:"totalPrice"`
+}
+
+type GetCustomerCartHandlerOutput struct {
+	Items         []GetCustomerCartItemHandlerOutput     `json:"items"`
+	TotalQuantity int32                                  `json:"totalQuantity"`
+	TotalPrice    int64                                  `json:"totalPrice"`
+	Currency      string                                 `json:"currency"`
+	CouponCode    string                                 `json:"couponCode"`
+	Subtotal      int64                                  `json:"subtotal"`
+	Discount      int64                                  `json:"discount"`
+	Total         int64                                  `json:"total"`
+	FreeShipping  bool                                   `json:"freeShipping"`
+	Converted     *GetCustomerCartConvertedHandlerOutput `json:"converted,omitempty"`
+	Tax           *GetCustomerCartTaxHandlerOutput       `json:"tax,omitempty"`
+	Shipping      *GetCustomerCartShippingHandlerOutput  `json:"shipping,omitempty"`
+}
+
+type GetCustomerCartHandler struct {
+	GetCustomerCart usecases.IGetCustomerCart
+}
+
+func (g *GetCustomerCartHandler) Handle(c echo.Context) error {
+	customerId, err := optionalCustomerId(c)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	handlerInput := GetCustomerCartHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"currency must be one of BRL, USD or EUR"})
+	}
+
+	currency := ""
+	if handlerInput.Currency != nil {
+		currency = *handlerInput.Currency
+	}
+
+	country := ""
+	if handlerInput.Country != nil {
+		country = *handlerInput.Country
+	}
+
+	region := ""
+	if handlerInput.Region != nil {
+		region = *handlerInput.Region
+	}
+
+	output, err := g.GetCustomerCart.Execute(c.Request().Context(), usecases.GetCustomerCartInput{
+		CustomerId: customerId,
+		Currency:   currency,
+		Country:    country,
+		Region:     region,
+		CartToken:  readCartToken(c),
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	items := []GetCustomerCartItemHandlerOutput{}
+	for _, item := range output.Items {
+		items = append(items, GetCustomerCartItemHandlerOutput{
+			ProductId:  item.ProductId.String(),
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice,
+			TotalPrice: item.TotalPrice,
+		})
+	}
+
+	var converted *GetCustomerCartConvertedHandlerOutput
+	if output.Converted != nil {
+		convertedItems := []GetCustomerCartConvertedItemHandlerOutput{}
+		for _, item := range output.Converted.Items {
+			convertedItems = append(convertedItems, GetCustomerCartConvertedItemHandlerOutput{
+				ProductId:  item.ProductId.String(),
+				UnitPrice:  item.UnitPrice,
+				TotalPrice: item.TotalPrice,
+			})
+		}
+
+		converted = &GetCustomerCartConvertedHandlerOutput{
+			Currency: output.Converted.Currency,
+			Items:    convertedItems,
+			Subtotal: output.Converted.Subtotal,
+			Discount: output.Converted.Discount,
+			Total:    output.Converted.Total,
+		}
+	}
+
+	var taxOutput *GetCustomerCartTaxHandlerOutput
+	if output.Tax != nil {
+		taxLines := []GetCustomerCartTaxLineHandlerOutput{}
+		for _, line := range output.Tax.Lines {
+			taxLines = append(taxLines, GetCustomerCartTaxLineHandlerOutput{
+				ProductId:       line.ProductId.String(),
+				TaxClass:        line.TaxClass,
+				RateBasisPoints: line.RateBasisPoints,
+				Inclusive:       line.Inclusive,
+				Net:             line.Net,
+				Tax:             line.Tax,
+				Gross:           line.Gross,
+			})
+		}
+
+		taxOutput = &GetCustomerCartTaxHandlerOutput{
+			Country: output.Tax.Country,
+			Region:  output.Tax.Region,
+			Lines:   taxLines,
+			Net:     output.Tax.Net,
+			Tax:     output.Tax.Tax,
+			Gross:   output.Tax.Gross,
+		}
+	}
+
+	var shippingOutput *GetCustomerCartShippingHandlerOutput
+	if output.Shipping != nil {
+		shippingOutput = &GetCustomerCartShippingHandlerOutput{
+			ShippingMethod: output.Shipping.MethodCode,
+			Name:           output.Shipping.MethodName,
+			Cost:           output.Shipping.Cost,
+		}
+	}
+
+	return webhttp.NewOk(c, GetCustomerCartHandlerOutput{
+		Items:         items,
+		TotalQuantity: output.TotalQuantity,
+		TotalPrice:    output.TotalPrice,
+		Currency:      output.Currency,
+		CouponCode:    output.CouponCode,
+		Subtotal:      output.Subtotal,
+		Discount:      output.Discount,
+		Total:         output.Total,
+		FreeShipping:  output.FreeShipping,
+		Converted:     converted,
+		Tax:           taxOutput,
+		Shipping:      shippingOutput,
+	})
+}
@@ -0,0 +1,434 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/tax"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCustomerCartMock struct {
+	mock.Mock
+}
+
+func (g *GetCustomerCartMock) Execute(ctx context.Context, input usecases.GetCustomerCartInput) (usecases.GetCustomerCartOutput, error) {
+	args := g.Called(ctx, input)
+	return args.Get(0).(usecases.GetCustomerCartOutput), args.Error(1)
+}
+
+type GetCustomerCartHandlerSuite struct {
+	suite.Suite
+	getCustomerCartMock    GetCustomerCartMock
+	getCustomerCartHandler handlers.GetCustomerCartHandler
+}
+
+func (g *GetCustomerCartHandlerSuite) SetupTest() {
+	g.getCustomerCartMock = GetCustomerCartMock{}
+	g.getCustomerCartHandler = handlers.GetCustomerCartHandler{
+		GetCustomerCart: &g.getCustomerCartMock,
+	}
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnExistingCart_ReturnsOk() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, usecases.GetCustomerCartInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+	}).Return(usecases.GetCustomerCartOutput{
+		Items: []usecases.GetCustomerCartItemOutput{
+			{
+				ProductId:  uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+				Quantity:   2,
+				UnitPrice:  3550,
+				TotalPrice: 7100,
+			},
+		},
+		TotalQuantity: 2,
+		TotalPrice:    7100,
+		Currency:      "BRL",
+		CouponCode:    "SAVE10",
+		Subtotal:      7100,
+		Discount:      710,
+		Total:         6390,
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"items": [
+				{
+					"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+					"quantity": 2,
+					"unitPrice": 3550,
+					"totalPrice": 7100
+				}
+			],
+			"totalQuantity": 2,
+			"totalPrice": 7100,
+			"currency": "BRL",
+			"couponCode": "SAVE10",
+			"subtotal": 7100,
+			"discount": 710,
+			"total": 6390,
+			"freeShipping": false
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnEmptyCart_ReturnsOk() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{
+		Items:         []usecases.GetCustomerCartItemOutput{},
+		TotalQuantity: 0,
+		TotalPrice:    0,
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"items": [],
+			"totalQuantity": 0,
+			"totalPrice": 0,
+			"currency": "",
+			"couponCode": "",
+			"subtotal": 0,
+			"discount": 0,
+			"total": 0,
+			"freeShipping": false
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnSelectedShippingMethod_ReturnsOkWithShipping() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{
+		Items:    []usecases.GetCustomerCartItemOutput{},
+		Currency: "BRL",
+		Shipping: &usecases.GetCustomerCartShippingOutput{
+			MethodCode: "EXPRESS",
+			MethodName: "Express delivery",
+			Cost:       4990,
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"items": [],
+			"totalQuantity": 0,
+			"totalPrice": 0,
+			"currency": "BRL",
+			"couponCode": "",
+			"subtotal": 0,
+			"discount": 0,
+			"total": 0,
+			"freeShipping": false,
+			"shipping": {
+				"shippingMethod": "EXPRESS",
+				"name": "Express delivery",
+				"cost": 4990
+			}
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnCurrency_ReturnsOkWithConvertedAmounts() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, usecases.GetCustomerCartInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		Currency:   "USD",
+	}).Return(usecases.GetCustomerCartOutput{
+		Items: []usecases.GetCustomerCartItemOutput{
+			{
+				ProductId:  uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+				Quantity:   2,
+				UnitPrice:  3550,
+				TotalPrice: 7100,
+			},
+		},
+		TotalQuantity: 2,
+		TotalPrice:    7100,
+		Currency:      "BRL",
+		Subtotal:      7100,
+		Total:         7100,
+		Converted: &usecases.GetCustomerCartConvertedOutput{
+			Currency: "USD",
+			Items: []usecases.GetCustomerCartConvertedItemOutput{
+				{
+					ProductId:  uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+					UnitPrice:  639,
+					TotalPrice: 1278,
+				},
+			},
+			Subtotal: 1278,
+			Total:    1278,
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/?currency=USD", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"items": [
+				{
+					"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+					"quantity": 2,
+					"unitPrice": 3550,
+					"totalPrice": 7100
+				}
+			],
+			"totalQuantity": 2,
+			"totalPrice": 7100,
+			"currency": "BRL",
+			"couponCode": "",
+			"subtotal": 7100,
+			"discount": 0,
+			"total": 7100,
+			"freeShipping": false,
+			"converted": {
+				"currency": "USD",
+				"items": [
+					{
+						"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+						"unitPrice": 639,
+						"totalPrice": 1278
+					}
+				],
+				"subtotal": 1278,
+				"discount": 0,
+				"total": 1278
+			}
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnRegion_ReturnsOkWithTax() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, usecases.GetCustomerCartInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		Country:    "BR",
+		Region:     "SP",
+	}).Return(usecases.GetCustomerCartOutput{
+		Items: []usecases.GetCustomerCartItemOutput{
+			{
+				ProductId:  uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+				Quantity:   1,
+				UnitPrice:  11800,
+				TotalPrice: 11800,
+			},
+		},
+		TotalQuantity: 1,
+		TotalPrice:    11800,
+		Currency:      "BRL",
+		Subtotal:      11800,
+		Total:         11800,
+		Tax: &usecases.GetCustomerCartTaxOutput{
+			Country: "BR",
+			Region:  "SP",
+			Lines: []usecases.GetCustomerCartTaxLineOutput{
+				{
+					ProductId:       uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+					TaxClass:        "standard",
+					RateBasisPoints: 1800,
+					Inclusive:       true,
+					Net:             10000,
+					Tax:             1800,
+					Gross:           11800,
+				},
+			},
+			Net:   10000,
+			Tax:   1800,
+			Gross: 11800,
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/?country=BR&region=SP", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"items": [
+				{
+					"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+					"quantity": 1,
+					"unitPrice": 11800,
+					"totalPrice": 11800
+				}
+			],
+			"totalQuantity": 1,
+			"totalPrice": 11800,
+			"currency": "BRL",
+			"couponCode": "",
+			"subtotal": 11800,
+			"discount": 0,
+			"total": 11800,
+			"freeShipping": false,
+			"tax": {
+				"country": "BR",
+				"region": "SP",
+				"lines": [
+					{
+						"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+						"taxClass": "standard",
+						"rateBasisPoints": 1800,
+						"inclusive": true,
+						"net": 10000,
+						"tax": 1800,
+						"gross": 11800
+					}
+				],
+				"net": 10000,
+				"tax": 1800,
+				"gross": 11800
+			}
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnTaxRuleNotFound_ReturnsNotFound() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{}, tax.ErrTaxRuleNotFound)
+	request := httptest.NewRequest("GET", "/?country=BR&region=AM", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(404, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We can't calculate taxes for your shipping address. Please check its country and region and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnUnsupportedCurrency_ReturnsBadRequest() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{}, models.ErrCurrencyNotSupported)
+	request := httptest.NewRequest("GET", "/?currency=JPY", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(400, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 400,
+		"statusText": "BAD_REQUEST",
+		"errors": ["currency must be one of BRL, USD or EUR"]
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnExchangeRateNotFound_ReturnsServiceUnavailable() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{}, usecases.ErrExchangeRateNotFound)
+	request := httptest.NewRequest("GET", "/?currency=EUR", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(503, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 503,
+		"statusText": "SERVICE_UNAVAILABLE",
+		"error": "We couldn't convert prices to this currency right now. Please try again later."
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetCustomerCartHandlerSuite) TestGetCustomerCartHandler_Handle_OnUseCaseError_ReturnsInternalServerError() {
+	e := echo.New()
+	g.getCustomerCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.GetCustomerCartOutput{}, errors.New("connection refused"))
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	g.getCustomerCartHandler.Handle(context)
+
+	g.Equal(500, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 500,
+		"statusText": "INTERNAL_SERVER_ERROR",
+		"error": "Something went wrong. Please try again later."
+	}
+	`, recorder.Body.String())
+}
+
+func TestGetCustomerCartHandler(t *testing.T) {
+	suite.Run(t, new(GetCustomerCartHandlerSuite))
+}
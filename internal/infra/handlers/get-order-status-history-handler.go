@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GetOrderStatusHistoryHandlerInput struct {
+	OrderId *string `query:"orderId" validate:"required,uuid4"`
+}
+
+type GetOrderStatusHistoryEntryHandlerOutput struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Actor     string `json:"actor"`
+	ChangedAt string `json:"changedAt"`
+}
+
+type GetOrderStatusHistoryHandlerOutput struct {
+	OrderId string                                    `json:"orderId"`
+	Status  string                                    `json:"status"`
+	History []GetOrderStatusHistoryEntryHandlerOutput `json:"history"`
+}
+
+type GetOrderStatusHistoryHandler struct {
+	Validator             infra.Validator
+	GetOrderStatusHistory usecases.IGetOrderStatusHistory
+}
+
+func (h *GetOrderStatusHistoryHandler) Handle(c echo.Context) error {
+	handlerInput := GetOrderStatusHistoryHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"orderId must be uuidv4"})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	orderId, err := uuid.Parse(*handlerInput.OrderId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	output, err := h.GetOrderStatusHistory.Execute(c.Request().Context(), usecases.GetOrderStatusHistoryInput{
+		OrderId: orderId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	history := []GetOrderStatusHistoryEntryHandlerOutput{}
+	for _, entry := range output.History {
+		history = append(history, GetOrderStatusHistoryEntryHandlerOutput{
+			From:      entry.From,
+			To:        entry.To,
+			Actor:     entry.Actor,
+			ChangedAt: entry.ChangedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return webhttp.NewOk(c, GetOrderStatusHistoryHandlerOutput{
+		OrderId: output.OrderId.String(),
+		Status:  output.Status,
+		History: history,
+	})
+}
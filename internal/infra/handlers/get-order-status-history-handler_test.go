@@ -0,0 +1,134 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetOrderStatusHistoryMock struct {
+	mock.Mock
+}
+
+func (g *GetOrderStatusHistoryMock) Execute(ctx context.Context, input usecases.GetOrderStatusHistoryInput) (usecases.GetOrderStatusHistoryOutput, error) {
+	args := g.Called(ctx, input)
+	return args.Get(0).(usecases.GetOrderStatusHistoryOutput), args.Error(1)
+}
+
+type GetOrderStatusHistoryHandlerSuite struct {
+	suite.Suite
+	getOrderStatusHistoryMock    GetOrderStatusHistoryMock
+	getOrderStatusHistoryHandler handlers.GetOrderStatusHistoryHandler
+}
+
+func (g *GetOrderStatusHistoryHandlerSuite) SetupTest() {
+	g.getOrderStatusHistoryMock = GetOrderStatusHistoryMock{}
+	g.getOrderStatusHistoryHandler = handlers.GetOrderStatusHistoryHandler{
+		Validator:             infra.NewValidator(),
+		GetOrderStatusHistory: &g.getOrderStatusHistoryMock,
+	}
+}
+
+func (g *GetOrderStatusHistoryHandlerSuite) TestGetOrderStatusHistoryHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	orderId := uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11")
+	g.getOrderStatusHistoryMock.On("Execute", mock.Anything, usecases.GetOrderStatusHistoryInput{OrderId: orderId}).
+		Return(usecases.GetOrderStatusHistoryOutput{
+			OrderId: orderId,
+			Status:  "PAID",
+			History: []usecases.GetOrderStatusHistoryEntryOutput{
+				{
+					From:      "PENDING_PAYMENT",
+					To:        "PAID",
+					Actor:     "payment-provider",
+					ChangedAt: time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC),
+				},
+			},
+		}, nil)
+	request := httptest.NewRequest("GET", "/?orderId=0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	g.getOrderStatusHistoryHandler.Handle(context)
+
+	g.Equal(200, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11",
+			"status": "PAID",
+			"history": [
+				{
+					"from": "PENDING_PAYMENT",
+					"to": "PAID",
+					"actor": "payment-provider",
+					"changedAt": "2024-11-20T10:00:00Z"
+				}
+			]
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetOrderStatusHistoryHandlerSuite) TestGetOrderStatusHistoryHandler_Handle_OnOrderNotFound_ReturnsNotFound() {
+	e := echo.New()
+	g.getOrderStatusHistoryMock.On("Execute", mock.Anything, mock.Anything).
+		Return(usecases.GetOrderStatusHistoryOutput{}, usecases.ErrOrderNotFound)
+	request := httptest.NewRequest("GET", "/?orderId=0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	g.getOrderStatusHistoryHandler.Handle(context)
+
+	g.Equal(404, recorder.Code)
+	g.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find this order. Please check the order ID and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (g *GetOrderStatusHistoryHandlerSuite) TestGetOrderStatusHistoryHandler_Handle_OnInvalidOrderId_ReturnsBadRequest() {
+	queriesAndErrors := map[string]string{
+		"/":             `["orderId is required"]`,
+		"/?orderId=abc": `["orderId must be uuidv4"]`,
+	}
+
+	for target, errorMessages := range queriesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("GET", target, nil)
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		g.getOrderStatusHistoryHandler.Handle(context)
+
+		g.Equal(400, recorder.Code)
+		g.JSONEq(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": `+errorMessages+`
+		}
+		`, recorder.Body.String())
+	}
+}
+
+func TestGetOrderStatusHistoryHandler(t *testing.T) {
+	suite.Run(t, new(GetOrderStatusHistoryHandlerSuite))
+}
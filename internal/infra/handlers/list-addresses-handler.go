@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ListAddressesItemHandlerOutput struct {
+	AddressId       string `json:"addressId"`
+	RecipientName   string `json:"recipientName"`
+	Line1           string `json:"line1"`
+	Line2           string `json:"line2"`
+	City            string `json:"city"`
+	Region          string `json:"region"`
+	PostalCode      string `json:"postalCode"`
+	Country         string `json:"country"`
+	DefaultShipping bool   `json:"defaultShipping"`
+	DefaultBilling  bool   `json:"defaultBilling"`
+}
+
+type ListAddressesHandler struct {
+	ListAddresses usecases.IListAddresses
+}
+
+func (l *ListAddressesHandler) Handle(c echo.Context) error {
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	output, err := l.ListAddresses.Execute(c.Request().Context(), usecases.ListAddressesInput{
+		CustomerId: customerId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	addresses := []ListAddressesItemHandlerOutput{}
+	for _, address := range output.Addresses {
+		addresses = append(addresses, ListAddressesItemHandlerOutput{
+			AddressId:       address.AddressId.String(),
+			RecipientName:   address.RecipientName,
+			Line1:           address.Line1,
+			Line2:           address.Line2,
+			City:            address.City,
+			Region:          address.Region,
+			PostalCode:      address.PostalCode,
+			Country:         address.Country,
+			DefaultShipping: address.DefaultShipping,
+			DefaultBilling:  address.DefaultBilling,
+		})
+	}
+
+	return webhttp.NewOk(c, addresses)
+}
@@ -0,0 +1,126 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListAddressesMock struct {
+	mock.Mock
+}
+
+func (l *ListAddressesMock) Execute(ctx context.Context, input usecases.ListAddressesInput) (usecases.ListAddressesOutput, error) {
+	args := l.Called(ctx, input)
+	return args.Get(0).(usecases.ListAddressesOutput), args.Error(1)
+}
+
+type ListAddressesHandlerSuite struct {
+	suite.Suite
+	listAddressesMock    ListAddressesMock
+	listAddressesHandler handlers.ListAddressesHandler
+}
+
+func (l *ListAddressesHandlerSuite) SetupTest() {
+	l.listAddressesMock = ListAddressesMock{}
+	l.listAddressesHandler = handlers.ListAddressesHandler{
+		ListAddresses: &l.listAddressesMock,
+	}
+}
+
+func (l *ListAddressesHandlerSuite) TestListAddressesHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	l.listAddressesMock.On("Execute", mock.Anything, usecases.ListAddressesInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+	}).Return(usecases.ListAddressesOutput{
+		Addresses: []usecases.ListAddressesItemOutput{
+			{
+				AddressId:       uuid.MustParse("9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"),
+				RecipientName:   "John Doe",
+				Line1:           "Av. Paulista, 1000",
+				Line2:           "Apt 12",
+				City:            "São Paulo",
+				Region:          "SP",
+				PostalCode:      "01310-100",
+				Country:         "BR",
+				DefaultShipping: true,
+				DefaultBilling:  true,
+			},
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listAddressesHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": [
+			{
+				"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f",
+				"recipientName": "John Doe",
+				"line1": "Av. Paulista, 1000",
+				"line2": "Apt 12",
+				"city": "São Paulo",
+				"region": "SP",
+				"postalCode": "01310-100",
+				"country": "BR",
+				"defaultShipping": true,
+				"defaultBilling": true
+			}
+		]
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListAddressesHandlerSuite) TestListAddressesHandler_Handle_OnCustomerNotFound_ReturnsNotFound() {
+	e := echo.New()
+	l.listAddressesMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ListAddressesOutput{}, usecases.ErrCustomerNotFound)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listAddressesHandler.Handle(context)
+
+	l.Equal(404, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "Customer not found."
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListAddressesHandlerSuite) TestListAddressesHandler_Handle_OnUnexpectedError_ReturnsInternalServerError() {
+	e := echo.New()
+	l.listAddressesMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ListAddressesOutput{}, errors.New("connection refused"))
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listAddressesHandler.Handle(context)
+
+	l.Equal(500, recorder.Code)
+}
+
+func TestListAddressesHandler(t *testing.T) {
+	suite.Run(t, new(ListAddressesHandlerSuite))
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ListProductsHandlerInput struct {
+	Currency *string `query:"currency"`
+}
+
+type ListProductsConvertedPriceHandlerOutput struct {
+	Currency string `json:"currency"`
+	Price    int64  `json:"price"`
+}
+
+type ListProductsItemHandlerOutput struct {
+	Id          string                                   `json:"id"`
+	Name        string                                   `json:"name"`
+	Description string                                   `json:"description"`
+	Sku         string                                   `json:"sku"`
+	Price       int64                                    `json:"price"`
+	Currency    string                                   `json:"currency"`
+	Active      bool                                     `json:"active"`
+	Converted   *ListProductsConvertedPriceHandlerOutput `json:"converted,omitempty"`
+}
+
+type ListProductsHandler struct {
+	ListProducts usecases.IListProducts
+}
+
+func (l *ListProductsHandler) Handle(c echo.Context) error {
+	handlerInput := ListProductsHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"currency must be one of BRL, USD or EUR"})
+	}
+
+	currency := ""
+	if handlerInput.Currency != nil {
+		currency = *handlerInput.Currency
+	}
+
+	output, err := l.ListProducts.Execute(c.Request().Context(), usecases.ListProductsInput{
+		Currency: currency,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	products := []ListProductsItemHandlerOutput{}
+	for _, product := range output.Products {
+		var converted *ListProductsConvertedPriceHandlerOutput
+		if product.Converted != nil {
+			converted = &ListProductsConvertedPriceHandlerOutput{
+				Currency: product.Converted.Currency,
+				Price:    product.Converted.Price,
+			}
+		}
+
+		products = append(products, ListProductsItemHandlerOutput{
+			Id:          product.Id.String(),
+			Name:        product.Name,
+			Description: product.Description,
+			Sku:         product.Sku,
+			Price:       product.Price,
+			Currency:    product.Currency,
+			Active:      product.Active,
+			Converted:   converted,
+		})
+	}
+
+	return webhttp.NewOk(c, products)
+}
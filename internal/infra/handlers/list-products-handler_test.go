@@ -0,0 +1,191 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListProductsMock struct {
+	mock.Mock
+}
+
+func (l *ListProductsMock) Execute(ctx context.Context, input usecases.ListProductsInput) (usecases.ListProductsOutput, error) {
+	args := l.Called(ctx, input)
+	return args.Get(0).(usecases.ListProductsOutput), args.Error(1)
+}
+
+type ListProductsHandlerSuite struct {
+	suite.Suite
+	listProductsMock    ListProductsMock
+	listProductsHandler handlers.ListProductsHandler
+}
+
+func (l *ListProductsHandlerSuite) SetupTest() {
+	l.listProductsMock = ListProductsMock{}
+	l.listProductsHandler = handlers.ListProductsHandler{
+		ListProducts: &l.listProductsMock,
+	}
+}
+
+func (l *ListProductsHandlerSuite) TestListProductsHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	l.listProductsMock.On("Execute", mock.Anything, usecases.ListProductsInput{}).Return(usecases.ListProductsOutput{
+		Products: []usecases.ListProductsItemOutput{
+			{
+				Id:          uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+				Name:        "Mechanical Keyboard",
+				Description: "Hot-swappable switches",
+				Sku:         "KB-001",
+				Price:       45990,
+				Currency:    "BRL",
+				Active:      false,
+			},
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.listProductsHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": [
+			{
+				"id": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+				"name": "Mechanical Keyboard",
+				"description": "Hot-swappable switches",
+				"sku": "KB-001",
+				"price": 45990,
+				"currency": "BRL",
+				"active": false
+			}
+		]
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListProductsHandlerSuite) TestListProductsHandler_Handle_OnCurrency_ReturnsOkWithConvertedPrices() {
+	e := echo.New()
+	l.listProductsMock.On("Execute", mock.Anything, usecases.ListProductsInput{Currency: "USD"}).Return(usecases.ListProductsOutput{
+		Products: []usecases.ListProductsItemOutput{
+			{
+				Id:          uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+				Name:        "Mechanical Keyboard",
+				Description: "Hot-swappable switches",
+				Sku:         "KB-001",
+				Price:       45990,
+				Currency:    "BRL",
+				Active:      true,
+				Converted:   &usecases.ListProductsConvertedPriceOutput{Currency: "USD", Price: 8278},
+			},
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/?currency=USD", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.listProductsHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": [
+			{
+				"id": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+				"name": "Mechanical Keyboard",
+				"description": "Hot-swappable switches",
+				"sku": "KB-001",
+				"price": 45990,
+				"currency": "BRL",
+				"active": true,
+				"converted": {
+					"currency": "USD",
+					"price": 8278
+				}
+			}
+		]
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListProductsHandlerSuite) TestListProductsHandler_Handle_OnUnsupportedCurrency_ReturnsBadRequest() {
+	e := echo.New()
+	l.listProductsMock.On("Execute", mock.Anything, usecases.ListProductsInput{Currency: "JPY"}).Return(usecases.ListProductsOutput{}, models.ErrCurrencyNotSupported)
+	request := httptest.NewRequest("GET", "/?currency=JPY", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.listProductsHandler.Handle(context)
+
+	l.Equal(400, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 400,
+		"statusText": "BAD_REQUEST",
+		"errors": ["currency must be one of BRL, USD or EUR"]
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListProductsHandlerSuite) TestListProductsHandler_Handle_OnExchangeRateNotFound_ReturnsServiceUnavailable() {
+	e := echo.New()
+	l.listProductsMock.On("Execute", mock.Anything, usecases.ListProductsInput{Currency: "EUR"}).Return(usecases.ListProductsOutput{}, usecases.ErrExchangeRateNotFound)
+	request := httptest.NewRequest("GET", "/?currency=EUR", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.listProductsHandler.Handle(context)
+
+	l.Equal(503, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 503,
+		"statusText": "SERVICE_UNAVAILABLE",
+		"error": "We couldn't convert prices to this currency right now. Please try again later."
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListProductsHandlerSuite) TestListProductsHandler_Handle_OnUseCaseError_ReturnsInternalServerError() {
+	e := echo.New()
+	l.listProductsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ListProductsOutput{}, errors.New("connection refused"))
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.listProductsHandler.Handle(context)
+
+	l.Equal(500, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 500,
+		"statusText": "INTERNAL_SERVER_ERROR",
+		"error": "Something went wrong. Please try again later."
+	}
+	`, recorder.Body.String())
+}
+
+func TestListProductsHandler(t *testing.T) {
+	suite.Run(t, new(ListProductsHandlerSuite))
+}
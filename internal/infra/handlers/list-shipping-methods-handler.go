@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ListShippingMethodsItemHandlerOutput struct {
+	ShippingMethod string `json:"shippingMethod"`
+	Name           string `json:"name"`
+	Currency       string `json:"currency"`
+	Cost           int64  `json:"cost"`
+	Selected       bool   `json:"selected"`
+}
+
+type ListShippingMethodsHandler struct {
+	ListShippingMethods usecases.IListShippingMethods
+}
+
+func (l *ListShippingMethodsHandler) Handle(c echo.Context) error {
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	output, err := l.ListShippingMethods.Execute(c.Request().Context(), usecases.ListShippingMethodsInput{
+		CustomerId: customerId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	methods := []ListShippingMethodsItemHandlerOutput{}
+	for _, method := range output.Methods {
+		methods = append(methods, ListShippingMethodsItemHandlerOutput{
+			ShippingMethod: method.MethodCode,
+			Name:           method.MethodName,
+			Currency:       method.Currency,
+			Cost:           method.Cost,
+			Selected:       method.Selected,
+		})
+	}
+
+	return webhttp.NewOk(c, methods)
+}
@@ -0,0 +1,107 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListShippingMethodsMock struct {
+	mock.Mock
+}
+
+func (l *ListShippingMethodsMock) Execute(ctx context.Context, input usecases.ListShippingMethodsInput) (usecases.ListShippingMethodsOutput, error) {
+	args := l.Called(ctx, input)
+	return args.Get(0).(usecases.ListShippingMethodsOutput), args.Error(1)
+}
+
+type ListShippingMethodsHandlerSuite struct {
+	suite.Suite
+	listShippingMethodsMock    ListShippingMethodsMock
+	listShippingMethodsHandler handlers.ListShippingMethodsHandler
+}
+
+func (l *ListShippingMethodsHandlerSuite) SetupTest() {
+	l.listShippingMethodsMock = ListShippingMethodsMock{}
+	l.listShippingMethodsHandler = handlers.ListShippingMethodsHandler{
+		ListShippingMethods: &l.listShippingMethodsMock,
+	}
+}
+
+func (l *ListShippingMethodsHandlerSuite) TestListShippingMethodsHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	l.listShippingMethodsMock.On("Execute", mock.Anything, usecases.ListShippingMethodsInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+	}).Return(usecases.ListShippingMethodsOutput{
+		Methods: []usecases.ListShippingMethodsItemOutput{
+			{MethodCode: "STANDARD", MethodName: "Standard delivery", Currency: "BRL", Cost: 1990, Selected: false},
+			{MethodCode: "EXPRESS", MethodName: "Express delivery", Currency: "BRL", Cost: 4990, Selected: true},
+		},
+	}, nil)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listShippingMethodsHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": [
+			{"shippingMethod": "STANDARD", "name": "Standard delivery", "currency": "BRL", "cost": 1990, "selected": false},
+			{"shippingMethod": "EXPRESS", "name": "Express delivery", "currency": "BRL", "cost": 4990, "selected": true}
+		]
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListShippingMethodsHandlerSuite) TestListShippingMethodsHandler_Handle_OnCartEmpty_ReturnsConflict() {
+	e := echo.New()
+	l.listShippingMethodsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ListShippingMethodsOutput{}, cart.ErrCartEmpty)
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listShippingMethodsHandler.Handle(context)
+
+	l.Equal(409, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "Your cart is empty. Please add a product to your cart first."
+	}
+	`, recorder.Body.String())
+}
+
+func (l *ListShippingMethodsHandlerSuite) TestListShippingMethodsHandler_Handle_OnUnexpectedError_ReturnsInternalServerError() {
+	e := echo.New()
+	l.listShippingMethodsMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ListShippingMethodsOutput{}, errors.New("connection refused"))
+	request := httptest.NewRequest("GET", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	l.listShippingMethodsHandler.Handle(context)
+
+	l.Equal(500, recorder.Code)
+}
+
+func TestListShippingMethodsHandler(t *testing.T) {
+	suite.Run(t, new(ListShippingMethodsHandlerSuite))
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"log"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type LoginHandlerInput struct {
+	Email    *string `json:"email" validate:"required"`
+	Password *string `json:"password" validate:"required"`
+}
+
+type SessionHandlerOutput struct {
+	TokenType             string `json:"tokenType"`
+	AccessToken           string `json:"accessToken"`
+	AccessTokenExpiresAt  string `json:"accessTokenExpiresAt"`
+	RefreshToken          string `json:"refreshToken"`
+	RefreshTokenExpiresAt string `json:"refreshTokenExpiresAt"`
+}
+
+type LoginHandler struct {
+	Validator infra.Validator
+	Login     usecases.ILogin
+}
+
+func (l *LoginHandler) Handle(c echo.Context) error {
+	handlerInput := LoginHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := l.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	output, err := l.Login.Execute(c.Request().Context(), usecases.LoginInput{
+		Email:     *handlerInput.Email,
+		Password:  *handlerInput.Password,
+		CartToken: readCartToken(c),
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	if output.GuestCartMergeError != nil {
+		log.Printf("failed to merge guest cart into the cart of customer %s: %v", output.CustomerId, output.GuestCartMergeError)
+	}
+
+	if output.GuestCartMerged {
+		clearCartToken(c)
+	}
+
+	return webhttp.NewOk(c, SessionHandlerOutput{
+		TokenType:             "Bearer",
+		AccessToken:           output.AccessToken,
+		AccessTokenExpiresAt:  output.AccessTokenExpiresAt.UTC().Format(time.RFC3339),
+		RefreshToken:          output.RefreshToken,
+		RefreshTokenExpiresAt: output.RefreshTokenExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
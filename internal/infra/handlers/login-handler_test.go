@@ -0,0 +1,214 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginMock struct {
+	mock.Mock
+}
+
+func (l *LoginMock) Execute(ctx context.Context, input usecases.LoginInput) (usecases.LoginOutput, error) {
+	args := l.Called(ctx, input)
+	return args.Get(0).(usecases.LoginOutput), args.Error(1)
+}
+
+type LoginHandlerSuite struct {
+	suite.Suite
+	loginMock    LoginMock
+	loginHandler handlers.LoginHandler
+}
+
+func (l *LoginHandlerSuite) SetupTest() {
+	l.loginMock = LoginMock{}
+	l.loginHandler = handlers.LoginHandler{
+		Validator: infra.NewValidator(),
+		Login:     &l.loginMock,
+	}
+}
+
+func (l *LoginHandlerSuite) TestLoginHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	l.loginMock.On("Execute", mock.Anything, usecases.LoginInput{
+		Email:    "john.doe@example.com",
+		Password: "s3cret-password",
+	}).Return(usecases.LoginOutput{
+		CustomerId:            uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		AccessToken:           "access-token",
+		AccessTokenExpiresAt:  time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC),
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+	{
+		"email": "john.doe@example.com",
+		"password": "s3cret-password"
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.loginHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"tokenType": "Bearer",
+			"accessToken": "access-token",
+			"accessTokenExpiresAt": "2024-11-20T10:15:00Z",
+			"refreshToken": "refresh-token",
+			"refreshTokenExpiresAt": "2024-12-20T10:00:00Z"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (l *LoginHandlerSuite) TestLoginHandler_Handle_OnGuestCartMerged_ClearsCartTokenCookie() {
+	e := echo.New()
+	l.loginMock.On("Execute", mock.Anything, usecases.LoginInput{
+		Email:     "john.doe@example.com",
+		Password:  "s3cret-password",
+		CartToken: "cart-token",
+	}).Return(usecases.LoginOutput{
+		AccessToken:     "access-token",
+		RefreshToken:    "refresh-token",
+		GuestCartMerged: true,
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+	{
+		"email": "john.doe@example.com",
+		"password": "s3cret-password"
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	request.AddCookie(&http.Cookie{Name: "cart_token", Value: "cart-token"})
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.loginHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	cookies := recorder.Result().Cookies()
+	l.Require().Len(cookies, 1)
+	l.Equal("cart_token", cookies[0].Name)
+	l.Equal("", cookies[0].Value)
+	l.Equal(-1, cookies[0].MaxAge)
+}
+
+func (l *LoginHandlerSuite) TestLoginHandler_Handle_OnGuestCartMergeError_ReturnsOkAndKeepsCartTokenCookie() {
+	e := echo.New()
+	l.loginMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.LoginOutput{
+		AccessToken:         "access-token",
+		RefreshToken:        "refresh-token",
+		GuestCartMergeError: errors.New("connection refused"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+	{
+		"email": "john.doe@example.com",
+		"password": "s3cret-password"
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	request.AddCookie(&http.Cookie{Name: "cart_token", Value: "cart-token"})
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.loginHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.Empty(recorder.Result().Cookies())
+}
+
+func (l *LoginHandlerSuite) TestLoginHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrInvalidCredentials: {
+			"statusCode": "401",
+			"statusText": "UNAUTHORIZED",
+			"message":    "Email or password is incorrect.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		l.SetupTest()
+		e := echo.New()
+		l.loginMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.LoginOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"email": "john.doe@example.com", "password": "wrong-password"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		l.loginHandler.Handle(context)
+
+		l.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		l.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (l *LoginHandlerSuite) TestLoginHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["email is required", "password is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		l.loginHandler.Handle(context)
+
+		l.Equal(400, recorder.Code)
+		l.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestLoginHandler(t *testing.T) {
+	suite.Run(t, new(LoginHandlerSuite))
+}
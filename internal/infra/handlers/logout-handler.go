@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type LogoutHandlerInput struct {
+	RefreshToken *string `json:"refreshToken" validate:"required"`
+}
+
+type LogoutHandler struct {
+	Validator infra.Validator
+	Logout    usecases.ILogout
+}
+
+func (l *LogoutHandler) Handle(c echo.Context) error {
+	handlerInput := LogoutHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := l.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	err := l.Logout.Execute(c.Request().Context(), usecases.LogoutInput{
+		RefreshToken: *handlerInput.RefreshToken,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
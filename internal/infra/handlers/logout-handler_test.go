@@ -0,0 +1,86 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogoutMock struct {
+	mock.Mock
+}
+
+func (l *LogoutMock) Execute(ctx context.Context, input usecases.LogoutInput) error {
+	args := l.Called(ctx, input)
+	return args.Error(0)
+}
+
+type LogoutHandlerSuite struct {
+	suite.Suite
+	logoutMock    LogoutMock
+	logoutHandler handlers.LogoutHandler
+}
+
+func (l *LogoutHandlerSuite) SetupTest() {
+	l.logoutMock = LogoutMock{}
+	l.logoutHandler = handlers.LogoutHandler{
+		Validator: infra.NewValidator(),
+		Logout:    &l.logoutMock,
+	}
+}
+
+func (l *LogoutHandlerSuite) TestLogoutHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	l.logoutMock.On("Execute", mock.Anything, usecases.LogoutInput{
+		RefreshToken: "refresh-token",
+	}).Return(nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"refreshToken": "refresh-token"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.logoutHandler.Handle(context)
+
+	l.Equal(200, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (l *LogoutHandlerSuite) TestLogoutHandler_Handle_OnInvalidToken_ReturnsUnauthorized() {
+	e := echo.New()
+	l.logoutMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ErrRefreshTokenInvalid)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"refreshToken": "unknown"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	l.logoutHandler.Handle(context)
+
+	l.Equal(401, recorder.Code)
+	l.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 401,
+		"statusText": "UNAUTHORIZED",
+		"error": "Refresh token is invalid."
+	}
+	`, recorder.Body.String())
+}
+
+func TestLogoutHandler(t *testing.T) {
+	suite.Run(t, new(LogoutHandlerSuite))
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RefreshSessionHandlerInput struct {
+	RefreshToken *string `json:"refreshToken" validate:"required"`
+}
+
+type RefreshSessionHandler struct {
+	Validator      infra.Validator
+	RefreshSession usecases.IRefreshSession
+}
+
+func (r *RefreshSessionHandler) Handle(c echo.Context) error {
+	handlerInput := RefreshSessionHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := r.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	output, err := r.RefreshSession.Execute(c.Request().Context(), usecases.RefreshSessionInput{
+		RefreshToken: *handlerInput.RefreshToken,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, SessionHandlerOutput{
+		TokenType:             "Bearer",
+		AccessToken:           output.AccessToken,
+		AccessTokenExpiresAt:  output.AccessTokenExpiresAt.UTC().Format(time.RFC3339),
+		RefreshToken:          output.RefreshToken,
+		RefreshTokenExpiresAt: output.RefreshTokenExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
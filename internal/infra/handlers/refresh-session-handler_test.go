@@ -0,0 +1,160 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshSessionMock struct {
+	mock.Mock
+}
+
+func (r *RefreshSessionMock) Execute(ctx context.Context, input usecases.RefreshSessionInput) (usecases.RefreshSessionOutput, error) {
+	args := r.Called(ctx, input)
+	return args.Get(0).(usecases.RefreshSessionOutput), args.Error(1)
+}
+
+type RefreshSessionHandlerSuite struct {
+	suite.Suite
+	refreshSessionMock    RefreshSessionMock
+	refreshSessionHandler handlers.RefreshSessionHandler
+}
+
+func (r *RefreshSessionHandlerSuite) SetupTest() {
+	r.refreshSessionMock = RefreshSessionMock{}
+	r.refreshSessionHandler = handlers.RefreshSessionHandler{
+		Validator:      infra.NewValidator(),
+		RefreshSession: &r.refreshSessionMock,
+	}
+}
+
+func (r *RefreshSessionHandlerSuite) TestRefreshSessionHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.refreshSessionMock.On("Execute", mock.Anything, usecases.RefreshSessionInput{
+		RefreshToken: "refresh-token",
+	}).Return(usecases.RefreshSessionOutput{
+		AccessToken:           "next-access-token",
+		AccessTokenExpiresAt:  time.Date(2024, 11, 20, 10, 15, 0, 0, time.UTC),
+		RefreshToken:          "next-refresh-token",
+		RefreshTokenExpiresAt: time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"refreshToken": "refresh-token"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	r.refreshSessionHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"tokenType": "Bearer",
+			"accessToken": "next-access-token",
+			"accessTokenExpiresAt": "2024-11-20T10:15:00Z",
+			"refreshToken": "next-refresh-token",
+			"refreshTokenExpiresAt": "2024-12-20T10:00:00Z"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RefreshSessionHandlerSuite) TestRefreshSessionHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrRefreshTokenInvalid: {
+			"statusCode": "401",
+			"statusText": "UNAUTHORIZED",
+			"message":    "Refresh token is invalid.",
+		},
+		usecases.ErrRefreshTokenExpired: {
+			"statusCode": "401",
+			"statusText": "UNAUTHORIZED",
+			"message":    "Refresh token has expired. Please log in again.",
+		},
+		repositories.ErrRefreshTokenReused: {
+			"statusCode": "401",
+			"statusText": "UNAUTHORIZED",
+			"message":    "Refresh token was already used. Please log in again.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		r.SetupTest()
+		e := echo.New()
+		r.refreshSessionMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.RefreshSessionOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"refreshToken": "refresh-token"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		r.refreshSessionHandler.Handle(context)
+
+		r.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (r *RefreshSessionHandlerSuite) TestRefreshSessionHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["refreshToken is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		r.refreshSessionHandler.Handle(context)
+
+		r.Equal(400, recorder.Code)
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestRefreshSessionHandler(t *testing.T) {
+	suite.Run(t, new(RefreshSessionHandlerSuite))
+}
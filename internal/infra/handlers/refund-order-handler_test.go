@@ -0,0 +1,114 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefundOrderMock struct {
+	mock.Mock
+}
+
+func (r *RefundOrderMock) Execute(ctx context.Context, input usecases.RefundOrderInput) error {
+	args := r.Called(ctx, input)
+	return args.Error(0)
+}
+
+type RefundOrderHandlerSuite struct {
+	suite.Suite
+	refundOrderMock    RefundOrderMock
+	refundOrderHandler handlers.RefundOrderHandler
+}
+
+func (r *RefundOrderHandlerSuite) SetupTest() {
+	r.refundOrderMock = RefundOrderMock{}
+	r.refundOrderHandler = handlers.RefundOrderHandler{
+		Validator:   infra.NewValidator(),
+		RefundOrder: &r.refundOrderMock,
+	}
+}
+
+func (r *RefundOrderHandlerSuite) TestRefundOrderHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.refundOrderMock.On("Execute", mock.Anything, usecases.RefundOrderInput{
+		OrderId: uuid.MustParse("0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"),
+		Actor:   "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+	}).Return(nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.refundOrderHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RefundOrderHandlerSuite) TestRefundOrderHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrOrderNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this order. Please check the order ID and try again.",
+		},
+		order.ErrOrderStatusTransitionNotAllowed: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "The order cannot be moved to this status from its current status.",
+		},
+		gateways.ErrPaymentProviderTimeout: {
+			"statusCode": "503",
+			"statusText": "SERVICE_UNAVAILABLE",
+			"message":    "Our payment provider is not responding. Please try again in a few minutes.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		r.SetupTest()
+		e := echo.New()
+		r.refundOrderMock.On("Execute", mock.Anything, mock.Anything).Return(useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"orderId": "0b5cd4a4-5f4b-4c5e-b0f4-0b4d3f8e8a11"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		r.refundOrderHandler.Handle(context)
+
+		r.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func TestRefundOrderHandler(t *testing.T) {
+	suite.Run(t, new(RefundOrderHandlerSuite))
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveAddressHandlerInput struct {
+	AddressId *string `json:"addressId" validate:"required,uuid4"`
+}
+
+type RemoveAddressHandler struct {
+	Validator     infra.Validator
+	RemoveAddress usecases.IRemoveAddress
+}
+
+func (r *RemoveAddressHandler) Handle(c echo.Context) error {
+	handlerInput := RemoveAddressHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := r.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	addressId, err := uuid.Parse(*handlerInput.AddressId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = r.RemoveAddress.Execute(c.Request().Context(), usecases.RemoveAddressInput{
+		CustomerId: customerId,
+		AddressId:  addressId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
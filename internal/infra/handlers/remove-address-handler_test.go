@@ -0,0 +1,122 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveAddressMock struct {
+	mock.Mock
+}
+
+func (r *RemoveAddressMock) Execute(ctx context.Context, input usecases.RemoveAddressInput) error {
+	args := r.Called(ctx, input)
+	return args.Error(0)
+}
+
+type RemoveAddressHandlerSuite struct {
+	suite.Suite
+	removeAddressMock    RemoveAddressMock
+	removeAddressHandler handlers.RemoveAddressHandler
+}
+
+func (r *RemoveAddressHandlerSuite) SetupTest() {
+	r.removeAddressMock = RemoveAddressMock{}
+	r.removeAddressHandler = handlers.RemoveAddressHandler{
+		Validator:     infra.NewValidator(),
+		RemoveAddress: &r.removeAddressMock,
+	}
+}
+
+func (r *RemoveAddressHandlerSuite) TestRemoveAddressHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.removeAddressMock.On("Execute", mock.Anything, usecases.RemoveAddressInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		AddressId:  uuid.MustParse("9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"),
+	}).Return(nil)
+	request := httptest.NewRequest("DELETE", "/", strings.NewReader(`{"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeAddressHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.removeAddressMock.AssertExpectations(r.T())
+}
+
+func (r *RemoveAddressHandlerSuite) TestRemoveAddressHandler_Handle_OnAddressNotFound_ReturnsNotFound() {
+	e := echo.New()
+	r.removeAddressMock.On("Execute", mock.Anything, mock.Anything).Return(address.ErrAddressNotFound)
+	request := httptest.NewRequest("DELETE", "/", strings.NewReader(`{"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeAddressHandler.Handle(context)
+
+	r.Equal(404, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find this address in your address book."
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveAddressHandlerSuite) TestRemoveAddressHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["addressId is required"]`,
+		},
+		{
+			"body":   `{"addressId": "abc"}`,
+			"errors": `["addressId must be uuidv4"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("DELETE", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		r.removeAddressHandler.Handle(context)
+
+		r.Equal(400, recorder.Code)
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestRemoveAddressHandler(t *testing.T) {
+	suite.Run(t, new(RemoveAddressHandlerSuite))
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveCouponFromCartHandler struct {
+	RemoveCouponFromCart usecases.IRemoveCouponFromCart
+}
+
+func (r *RemoveCouponFromCartHandler) Handle(c echo.Context) error {
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = r.RemoveCouponFromCart.Execute(c.Request().Context(), usecases.RemoveCouponFromCartInput{
+		CustomerId: customerId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveCouponFromCartMock struct {
+	mock.Mock
+}
+
+func (r *RemoveCouponFromCartMock) Execute(ctx context.Context, input usecases.RemoveCouponFromCartInput) error {
+	args := r.Called(ctx, input)
+	return args.Error(0)
+}
+
+type RemoveCouponFromCartHandlerSuite struct {
+	suite.Suite
+	removeCouponFromCartMock    RemoveCouponFromCartMock
+	removeCouponFromCartHandler handlers.RemoveCouponFromCartHandler
+}
+
+func (r *RemoveCouponFromCartHandlerSuite) SetupTest() {
+	r.removeCouponFromCartMock = RemoveCouponFromCartMock{}
+	r.removeCouponFromCartHandler = handlers.RemoveCouponFromCartHandler{
+		RemoveCouponFromCart: &r.removeCouponFromCartMock,
+	}
+}
+
+func (r *RemoveCouponFromCartHandlerSuite) TestRemoveCouponFromCartHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.removeCouponFromCartMock.On("Execute", mock.Anything, usecases.RemoveCouponFromCartInput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+	}).Return(nil)
+	request := httptest.NewRequest("DELETE", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeCouponFromCartHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveCouponFromCartHandlerSuite) TestRemoveCouponFromCartHandler_Handle_OnCartNotFound_ReturnsNotFound() {
+	e := echo.New()
+	r.removeCouponFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ErrCartNotFound)
+	request := httptest.NewRequest("DELETE", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeCouponFromCartHandler.Handle(context)
+
+	r.Equal(404, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find a cart for your account. Please add a product to your cart first."
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveCouponFromCartHandlerSuite) TestRemoveCouponFromCartHandler_Handle_OnCartWithoutCoupon_ReturnsConflict() {
+	e := echo.New()
+	r.removeCouponFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(cart.ErrCartHasNoCoupon)
+	request := httptest.NewRequest("DELETE", "/", nil)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeCouponFromCartHandler.Handle(context)
+
+	r.Equal(409, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "There is no coupon applied to your cart."
+	}
+	`, recorder.Body.String())
+}
+
+func TestRemoveCouponFromCartHandler(t *testing.T) {
+	suite.Run(t, new(RemoveCouponFromCartHandlerSuite))
+}
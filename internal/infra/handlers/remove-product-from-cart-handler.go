@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveProductFromCartHandlerInput struct {
+	ProductId *string `json:"productId" validate:"required,uuid4"`
+}
+
+type RemoveProductFromCartHandler struct {
+	Validator             infra.Validator
+	RemoveProductFromCart usecases.IRemoveProductFromCart
+}
+
+func (r *RemoveProductFromCartHandler) Handle(c echo.Context) error {
+	handlerInput := RemoveProductFromCartHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := r.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	productId, err := uuid.Parse(*handlerInput.ProductId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := optionalCustomerId(c)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = r.RemoveProductFromCart.Execute(c.Request().Context(), usecases.RemoveProductFromCartInput{
+		CustomerId: customerId,
+		ProductId:  productId,
+		CartToken:  readCartToken(c),
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
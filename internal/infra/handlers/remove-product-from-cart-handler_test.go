@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveProductFromCartMock struct {
+	mock.Mock
+}
+
+func (r *RemoveProductFromCartMock) Execute(ctx context.Context, input usecases.RemoveProductFromCartInput) error {
+	args := r.Called(ctx, input)
+	return args.Error(0)
+}
+
+type RemoveProductFromCartHandlerSuite struct {
+	suite.Suite
+	removeProductFromCartMock    RemoveProductFromCartMock
+	removeProductFromCartHandler handlers.RemoveProductFromCartHandler
+}
+
+func (r *RemoveProductFromCartHandlerSuite) SetupTest() {
+	r.removeProductFromCartMock = RemoveProductFromCartMock{}
+	r.removeProductFromCartHandler = handlers.RemoveProductFromCartHandler{
+		Validator:             infra.NewValidator(),
+		RemoveProductFromCart: &r.removeProductFromCartMock,
+	}
+}
+
+func (r *RemoveProductFromCartHandlerSuite) TestRemoveProductFromCartHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.removeProductFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(nil)
+	request := httptest.NewRequest("DELETE", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeProductFromCartHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveProductFromCartHandlerSuite) TestRemoveProductFromCartHandler_Handle_OnCartNotFound_ReturnsNotFound() {
+	e := echo.New()
+	r.removeProductFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ErrCartNotFound)
+	request := httptest.NewRequest("DELETE", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeProductFromCartHandler.Handle(context)
+
+	r.Equal(404, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find a cart for your account. Please add a product to your cart first."
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveProductFromCartHandlerSuite) TestRemoveProductFromCartHandler_Handle_OnProductNotInCart_ReturnsNotFound() {
+	e := echo.New()
+	r.removeProductFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(cart.ErrProductNotInCart)
+	request := httptest.NewRequest("DELETE", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9"
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	r.removeProductFromCartHandler.Handle(context)
+
+	r.Equal(404, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find this product in your cart. Please check the product ID and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RemoveProductFromCartHandlerSuite) TestRemoveProductFromCartHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	r.removeProductFromCartMock.On("Execute", mock.Anything, mock.Anything).Return(nil)
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["productId is required"]`,
+		},
+		{
+			"body": `{
+				"productId": null
+			}`,
+			"errors": `["productId is required"]`,
+		},
+		{
+			"body": `{
+				"productId": "abc"
+			}`,
+			"errors": `["productId must be uuidv4"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		body := inputAndError["body"]
+		errorMessage := inputAndError["errors"]
+
+		e := echo.New()
+		request := httptest.NewRequest("DELETE", "/", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		r.removeProductFromCartHandler.Handle(context)
+
+		r.Equal(400, recorder.Code)
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, errorMessage), recorder.Body.String())
+	}
+}
+
+func TestRemoveProductFromCartHandler(t *testing.T) {
+	suite.Run(t, new(RemoveProductFromCartHandlerSuite))
+}
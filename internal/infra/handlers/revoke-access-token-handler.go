@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RevokeAccessTokenHandlerInput struct {
+	TokenId *string `json:"tokenId" validate:"required"`
+}
+
+type RevokeAccessTokenHandler struct {
+	Validator         infra.Validator
+	RevokeAccessToken usecases.IRevokeAccessToken
+}
+
+func (r *RevokeAccessTokenHandler) Handle(c echo.Context) error {
+	handlerInput := RevokeAccessTokenHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := r.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	err := r.RevokeAccessToken.Execute(c.Request().Context(), usecases.RevokeAccessTokenInput{
+		TokenId: *handlerInput.TokenId,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RevokeAccessTokenMock struct {
+	mock.Mock
+}
+
+func (r *RevokeAccessTokenMock) Execute(ctx context.Context, input usecases.RevokeAccessTokenInput) error {
+	args := r.Called(ctx, input)
+	return args.Error(0)
+}
+
+type RevokeAccessTokenHandlerSuite struct {
+	suite.Suite
+	revokeAccessTokenMock    RevokeAccessTokenMock
+	revokeAccessTokenHandler handlers.RevokeAccessTokenHandler
+}
+
+func (r *RevokeAccessTokenHandlerSuite) SetupTest() {
+	r.revokeAccessTokenMock = RevokeAccessTokenMock{}
+	r.revokeAccessTokenHandler = handlers.RevokeAccessTokenHandler{
+		Validator:         infra.NewValidator(),
+		RevokeAccessToken: &r.revokeAccessTokenMock,
+	}
+}
+
+func (r *RevokeAccessTokenHandlerSuite) TestRevokeAccessTokenHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	r.revokeAccessTokenMock.On("Execute", mock.Anything, usecases.RevokeAccessTokenInput{
+		TokenId: "1f0b8f7e-54a4-4a8e-a6f2-3f5c2d1e0b9a",
+	}).Return(nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"tokenId": "1f0b8f7e-54a4-4a8e-a6f2-3f5c2d1e0b9a"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	r.revokeAccessTokenHandler.Handle(context)
+
+	r.Equal(200, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RevokeAccessTokenHandlerSuite) TestRevokeAccessTokenHandler_Handle_OnUseCaseError_ReturnsInternalServerError() {
+	e := echo.New()
+	r.revokeAccessTokenMock.On("Execute", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"tokenId": "1f0b8f7e-54a4-4a8e-a6f2-3f5c2d1e0b9a"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	r.revokeAccessTokenHandler.Handle(context)
+
+	r.Equal(500, recorder.Code)
+	r.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 500,
+		"statusText": "INTERNAL_SERVER_ERROR",
+		"error": "Something went wrong. Please try again later."
+	}
+	`, recorder.Body.String())
+}
+
+func (r *RevokeAccessTokenHandlerSuite) TestRevokeAccessTokenHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["tokenId is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		r.revokeAccessTokenHandler.Handle(context)
+
+		r.Equal(400, recorder.Code)
+		r.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestRevokeAccessTokenHandler(t *testing.T) {
+	suite.Run(t, new(RevokeAccessTokenHandlerSuite))
+}
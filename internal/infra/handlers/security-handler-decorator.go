@@ -1,8 +1,10 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
@@ -11,18 +13,25 @@ import (
 )
 
 type SecurityHandlerDecorator struct {
-	HttpHandler          IHttpHandler
-	SecretManagerGateway gateways.ISecretManagerGateway
+	HttpHandler                IHttpHandler
+	Policy                     AuthorizationPolicy
+	SecretManagerGateway       gateways.ISecretManagerGateway
+	PublicKeyGateway           gateways.IPublicKeyGateway
+	AccessTokenDenylistGateway gateways.IAccessTokenDenylistGateway
+	ClockGateway               gateways.IClockGateway
+	Issuer                     string
+	Audience                   string
+	Leeway                     time.Duration
+	AllowAnonymous             bool
 }
 
 func (a *SecurityHandlerDecorator) Handle(c echo.Context) error {
-	authAccessToken, err := a.SecretManagerGateway.Get("AUTH_ACCESS_TOKEN")
-	if err != nil {
-		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
-	}
-
 	authorizationToken := c.Request().Header.Get("Authorization")
 	if authorizationToken == "" {
+		if a.AllowAnonymous {
+			return a.HttpHandler.Handle(c)
+		}
+
 		return webhttp.NewUnauthorizedRequest(c, "Authorization token is missing.")
 	}
 
@@ -33,22 +42,118 @@ func (a *SecurityHandlerDecorator) Handle(c echo.Context) error {
 
 	rawToken := parts[1]
 
-	token, err := jwt.Parse(rawToken, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	var keyLookupErr error
+	parser := jwt.Parser{SkipClaimsValidation: true}
+	token, err := parser.Parse(rawToken, func(token *jwt.Token) (interface{}, error) {
+		key, err := a.findVerificationKey(c.Request().Context(), token)
+		if err != nil {
+			keyLookupErr = err
+			return nil, err
 		}
-		return []byte(authAccessToken), nil
+
+		if key == nil {
+			return nil, errors.New("authorization token cannot be verified")
+		}
+
+		return key, nil
 	})
 
+	if keyLookupErr != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
 	if err != nil {
+		if validationError, ok := err.(*jwt.ValidationError); ok && validationError.Errors&jwt.ValidationErrorMalformed != 0 {
+			return webhttp.NewUnauthorizedRequest(c, "Authorization token is malformed.")
+		}
+
 		return webhttp.NewUnauthorizedRequest(c, "Authorization token is invalid.")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || claims["customerId"] == nil {
+	if !ok {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token is invalid.")
+	}
+
+	tokenId, _ := claims["jti"].(string)
+	if tokenId == "" || claims["exp"] == nil {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token is missing required claims.")
+	}
+
+	now := a.ClockGateway.Now()
+	if !claims.VerifyExpiresAt(now.Add(-a.Leeway).Unix(), true) {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token has expired.")
+	}
+
+	if !claims.VerifyNotBefore(now.Add(a.Leeway).Unix(), false) {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token is not valid yet.")
+	}
+
+	if (a.Issuer != "" && !claims.VerifyIssuer(a.Issuer, true)) || (a.Audience != "" && !claims.VerifyAudience(a.Audience, true)) {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token was not issued for this service.")
+	}
+
+	revoked, err := a.AccessTokenDenylistGateway.IsRevoked(c.Request().Context(), tokenId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	if revoked {
+		return webhttp.NewUnauthorizedRequest(c, "Authorization token has been revoked.")
+	}
+
+	if claims["customerId"] == nil || !a.Policy.Allows(claimValues(claims, "roles"), claimValues(claims, "scope")) {
 		return webhttp.NewForbiddenRequest(c, "You do not have permission to access this resource.")
 	}
 
 	c.Set("customerId", claims["customerId"])
 	return a.HttpHandler.Handle(c)
 }
+
+func (a *SecurityHandlerDecorator) findVerificationKey(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		authAccessToken, err := a.SecretManagerGateway.Get(ctx, "AUTH_ACCESS_TOKEN")
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(authAccessToken), nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+		keyId, _ := token.Header["kid"].(string)
+		if a.PublicKeyGateway == nil || keyId == "" {
+			return nil, nil
+		}
+
+		publicKey, err := a.PublicKeyGateway.FindPublicKey(ctx, keyId)
+		if err != nil {
+			return nil, err
+		}
+
+		if publicKey == nil || (publicKey.Algorithm != "" && publicKey.Algorithm != token.Method.Alg()) {
+			return nil, nil
+		}
+
+		return publicKey.Key, nil
+	}
+
+	return nil, nil
+}
+
+func claimValues(claims jwt.MapClaims, name string) []string {
+	switch claim := claims[name].(type) {
+	case string:
+		return strings.Fields(claim)
+	case []interface{}:
+		values := []string{}
+		for _, value := range claim {
+			if value, ok := value.(string); ok {
+				values = append(values, value)
+			}
+		}
+
+		return values
+	}
+
+	return []string{}
+}
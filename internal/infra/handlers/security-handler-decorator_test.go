@@ -0,0 +1,395 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/gateways"
+	infragateways "github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SecretManagerGatewayMock struct {
+	mock.Mock
+}
+
+func (s *SecretManagerGatewayMock) Get(ctx context.Context, key string) (string, error) {
+	args := s.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+type AccessTokenDenylistGatewayMock struct {
+	mock.Mock
+}
+
+func (a *AccessTokenDenylistGatewayMock) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	args := a.Called(ctx, tokenId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (a *AccessTokenDenylistGatewayMock) Revoke(ctx context.Context, tokenId string, expiresAt time.Time) error {
+	args := a.Called(ctx, tokenId, expiresAt)
+	return args.Error(0)
+}
+
+type PublicKeyGatewayMock struct {
+	mock.Mock
+}
+
+func (p *PublicKeyGatewayMock) FindPublicKey(ctx context.Context, keyId string) (*gateways.PublicKeyDTO, error) {
+	args := p.Called(ctx, keyId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*gateways.PublicKeyDTO), args.Error(1)
+}
+
+type HttpHandlerMock struct {
+	mock.Mock
+}
+
+func (h *HttpHandlerMock) Handle(c echo.Context) error {
+	h.Called(c)
+	return webhttp.NewOk(c, c.Get("customerId"))
+}
+
+type SecurityHandlerDecoratorSuite struct {
+	suite.Suite
+	clockGateway                   *infragateways.FakeClockGateway
+	secretManagerGatewayMock       SecretManagerGatewayMock
+	accessTokenDenylistGatewayMock AccessTokenDenylistGatewayMock
+	publicKeyGatewayMock           PublicKeyGatewayMock
+	httpHandlerMock                HttpHandlerMock
+	securityHandlerDecorator       handlers.SecurityHandlerDecorator
+}
+
+func (s *SecurityHandlerDecoratorSuite) SetupTest() {
+	s.clockGateway = infragateways.NewFakeClockGateway(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	s.secretManagerGatewayMock = SecretManagerGatewayMock{}
+	s.accessTokenDenylistGatewayMock = AccessTokenDenylistGatewayMock{}
+	s.publicKeyGatewayMock = PublicKeyGatewayMock{}
+	s.httpHandlerMock = HttpHandlerMock{}
+	s.secretManagerGatewayMock.On("Get", mock.Anything, "AUTH_ACCESS_TOKEN").Return("secret", nil)
+	s.httpHandlerMock.On("Handle", mock.Anything)
+
+	s.securityHandlerDecorator = handlers.SecurityHandlerDecorator{
+		HttpHandler:                &s.httpHandlerMock,
+		SecretManagerGateway:       &s.secretManagerGatewayMock,
+		PublicKeyGateway:           &s.publicKeyGatewayMock,
+		AccessTokenDenylistGateway: &s.accessTokenDenylistGatewayMock,
+		ClockGateway:               s.clockGateway,
+		Issuer:                     "ecommerce-go",
+		Audience:                   "ecommerce-go-api",
+		Leeway:                     30 * time.Second,
+	}
+}
+
+func (s *SecurityHandlerDecoratorSuite) validClaims() jwt.MapClaims {
+	now := s.clockGateway.Now()
+	return jwt.MapClaims{
+		"jti":        "token-id",
+		"iss":        "ecommerce-go",
+		"aud":        "ecommerce-go-api",
+		"customerId": "5ad98fc5-6b0f-45fd-a886-d6a15a63c833",
+		"iat":        now.Unix(),
+		"exp":        now.Add(15 * time.Minute).Unix(),
+	}
+}
+
+func (s *SecurityHandlerDecoratorSuite) sign(claims jwt.MapClaims) string {
+	rawToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	s.Require().NoError(err)
+	return rawToken
+}
+
+func (s *SecurityHandlerDecoratorSuite) signWithKey(method jwt.SigningMethod, keyId string, key interface{}) string {
+	token := jwt.NewWithClaims(method, s.validClaims())
+	token.Header["kid"] = keyId
+	rawToken, err := token.SignedString(key)
+	s.Require().NoError(err)
+	return rawToken
+}
+
+func (s *SecurityHandlerDecoratorSuite) handle(authorization string) *httptest.ResponseRecorder {
+	e := echo.New()
+	request := httptest.NewRequest("GET", "/", nil)
+	request.Header.Set("Authorization", authorization)
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	s.securityHandlerDecorator.Handle(context)
+
+	return recorder
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnValidToken_CallsHandlerWithCustomerId() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+
+	recorder := s.handle("Bearer " + s.sign(s.validClaims()))
+
+	s.Equal(200, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": "5ad98fc5-6b0f-45fd-a886-d6a15a63c833"
+	}
+	`, recorder.Body.String())
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnTokenExpiredWithinLeeway_CallsHandler() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	claims := s.validClaims()
+	claims["exp"] = s.clockGateway.Now().Add(-20 * time.Second).Unix()
+
+	recorder := s.handle("Bearer " + s.sign(claims))
+
+	s.Equal(200, recorder.Code)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnRejectedTokens_ReturnsUnauthorized() {
+	expired := s.validClaims()
+	expired["exp"] = s.clockGateway.Now().Add(-time.Minute).Unix()
+	withoutExpiration := s.validClaims()
+	delete(withoutExpiration, "exp")
+	withoutTokenId := s.validClaims()
+	delete(withoutTokenId, "jti")
+	notYetValid := s.validClaims()
+	notYetValid["nbf"] = s.clockGateway.Now().Add(time.Minute).Unix()
+	wrongIssuer := s.validClaims()
+	wrongIssuer["iss"] = "someone-else"
+	wrongAudience := s.validClaims()
+	wrongAudience["aud"] = "another-api"
+	wrongSignature, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, s.validClaims()).SignedString([]byte("another-secret"))
+
+	tokensAndMessages := []map[string]string{
+		{"authorization": "", "message": "Authorization token is missing."},
+		{"authorization": "Token abc", "message": "Invalid authorization token format."},
+		{"authorization": "Bearer abc", "message": "Authorization token is malformed."},
+		{"authorization": "Bearer " + wrongSignature, "message": "Authorization token is invalid."},
+		{"authorization": "Bearer " + s.sign(expired), "message": "Authorization token has expired."},
+		{"authorization": "Bearer " + s.sign(withoutExpiration), "message": "Authorization token is missing required claims."},
+		{"authorization": "Bearer " + s.sign(withoutTokenId), "message": "Authorization token is missing required claims."},
+		{"authorization": "Bearer " + s.sign(notYetValid), "message": "Authorization token is not valid yet."},
+		{"authorization": "Bearer " + s.sign(wrongIssuer), "message": "Authorization token was not issued for this service."},
+		{"authorization": "Bearer " + s.sign(wrongAudience), "message": "Authorization token was not issued for this service."},
+	}
+
+	for _, tokenAndMessage := range tokensAndMessages {
+		recorder := s.handle(tokenAndMessage["authorization"])
+
+		s.Equal(401, recorder.Code)
+		s.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "%s"
+		}
+		`, tokenAndMessage["message"]), recorder.Body.String())
+	}
+
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnRevokedToken_ReturnsUnauthorized() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(true, nil)
+
+	recorder := s.handle("Bearer " + s.sign(s.validClaims()))
+
+	s.Equal(401, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 401,
+		"statusText": "UNAUTHORIZED",
+		"error": "Authorization token has been revoked."
+	}
+	`, recorder.Body.String())
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnDenylistFailure_ReturnsInternalServerError() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, errors.New("connection refused"))
+
+	recorder := s.handle("Bearer " + s.sign(s.validClaims()))
+
+	s.Equal(500, recorder.Code)
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnTokenGrantedByPolicy_CallsHandler() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	s.securityHandlerDecorator.Policy = handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}}
+	stringClaims := s.validClaims()
+	stringClaims["roles"] = "admin"
+	stringClaims["scope"] = "catalog orders"
+	listClaims := s.validClaims()
+	listClaims["roles"] = []string{"customer", "admin"}
+	listClaims["scope"] = []string{"orders", "catalog"}
+
+	stringRecorder := s.handle("Bearer " + s.sign(stringClaims))
+	listRecorder := s.handle("Bearer " + s.sign(listClaims))
+
+	s.Equal(200, stringRecorder.Code)
+	s.Equal(200, listRecorder.Code)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnTokenDeniedByPolicy_ReturnsForbidden() {
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	s.securityHandlerDecorator.Policy = handlers.AuthorizationPolicy{Roles: []string{"admin"}, Scopes: []string{"catalog"}}
+	customerRole := s.validClaims()
+	customerRole["roles"] = []string{"customer"}
+	customerRole["scope"] = "catalog"
+	missingScope := s.validClaims()
+	missingScope["roles"] = []string{"admin"}
+	missingScope["scope"] = "orders"
+	withoutRolesAndScopes := s.validClaims()
+	withoutCustomerId := s.validClaims()
+	withoutCustomerId["roles"] = []string{"admin"}
+	withoutCustomerId["scope"] = "catalog"
+	delete(withoutCustomerId, "customerId")
+
+	for _, claims := range []jwt.MapClaims{customerRole, missingScope, withoutRolesAndScopes, withoutCustomerId} {
+		recorder := s.handle("Bearer " + s.sign(claims))
+
+		s.Equal(403, recorder.Code)
+		s.JSONEq(`
+		{
+			"status": "ERROR",
+			"statusCode": 403,
+			"statusText": "FORBIDDEN",
+			"error": "You do not have permission to access this resource."
+		}
+		`, recorder.Body.String())
+	}
+
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnAsymmetricTokens_VerifiesAgainstTheKeySet() {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecdsaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.accessTokenDenylistGatewayMock.On("IsRevoked", mock.Anything, "token-id").Return(false, nil)
+	s.publicKeyGatewayMock.On("FindPublicKey", mock.Anything, "rsa-key").Return(&gateways.PublicKeyDTO{
+		KeyId: "rsa-key", Algorithm: "RS256", Key: &rsaKey.PublicKey,
+	}, nil)
+	s.publicKeyGatewayMock.On("FindPublicKey", mock.Anything, "ec-key").Return(&gateways.PublicKeyDTO{
+		KeyId: "ec-key", Algorithm: "ES256", Key: &ecdsaKey.PublicKey,
+	}, nil)
+
+	rsaRecorder := s.handle("Bearer " + s.signWithKey(jwt.SigningMethodRS256, "rsa-key", rsaKey))
+	ecdsaRecorder := s.handle("Bearer " + s.signWithKey(jwt.SigningMethodES256, "ec-key", ecdsaKey))
+
+	s.Equal(200, rsaRecorder.Code)
+	s.Equal(200, ecdsaRecorder.Code)
+	s.secretManagerGatewayMock.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnUnverifiableAsymmetricTokens_ReturnsUnauthorized() {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherRsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	s.publicKeyGatewayMock.On("FindPublicKey", mock.Anything, "rsa-key").Return(&gateways.PublicKeyDTO{
+		KeyId: "rsa-key", Algorithm: "RS256", Key: &rsaKey.PublicKey,
+	}, nil)
+	s.publicKeyGatewayMock.On("FindPublicKey", mock.Anything, "unknown-key").Return(nil, nil)
+
+	rawTokens := []string{
+		s.signWithKey(jwt.SigningMethodRS256, "unknown-key", rsaKey),
+		s.signWithKey(jwt.SigningMethodRS256, "", rsaKey),
+		s.signWithKey(jwt.SigningMethodRS512, "rsa-key", rsaKey),
+		s.signWithKey(jwt.SigningMethodRS256, "rsa-key", otherRsaKey),
+	}
+
+	for _, rawToken := range rawTokens {
+		recorder := s.handle("Bearer " + rawToken)
+
+		s.Equal(401, recorder.Code)
+		s.JSONEq(`
+		{
+			"status": "ERROR",
+			"statusCode": 401,
+			"statusText": "UNAUTHORIZED",
+			"error": "Authorization token is invalid."
+		}
+		`, recorder.Body.String())
+	}
+
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnAsymmetricTokenWithoutKeySet_ReturnsUnauthorized() {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	s.securityHandlerDecorator.PublicKeyGateway = nil
+
+	recorder := s.handle("Bearer " + s.signWithKey(jwt.SigningMethodRS256, "rsa-key", rsaKey))
+
+	s.Equal(401, recorder.Code)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnKeyLookupFailures_ReturnsInternalServerError() {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	s.publicKeyGatewayMock.On("FindPublicKey", mock.Anything, "rsa-key").Return(nil, errors.New("key set is not available"))
+
+	recorder := s.handle("Bearer " + s.signWithKey(jwt.SigningMethodRS256, "rsa-key", rsaKey))
+
+	s.Equal(500, recorder.Code)
+
+	s.SetupTest()
+	s.secretManagerGatewayMock = SecretManagerGatewayMock{}
+	s.secretManagerGatewayMock.On("Get", mock.Anything, "AUTH_ACCESS_TOKEN").Return("", errors.New("secret not found"))
+	s.securityHandlerDecorator.SecretManagerGateway = &s.secretManagerGatewayMock
+
+	recorder = s.handle("Bearer " + s.sign(s.validClaims()))
+
+	s.Equal(500, recorder.Code)
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnAnonymousRequest_HandlesByAllowAnonymous() {
+	recorder := s.handle("")
+
+	s.Equal(401, recorder.Code)
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+
+	s.securityHandlerDecorator.AllowAnonymous = true
+	recorder = s.handle("")
+
+	s.Equal(200, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (s *SecurityHandlerDecoratorSuite) TestSecurityHandlerDecorator_Handle_OnAllowAnonymousAndInvalidToken_ReturnsUnauthorized() {
+	s.securityHandlerDecorator.AllowAnonymous = true
+
+	recorder := s.handle("Bearer invalid-token")
+
+	s.Equal(401, recorder.Code)
+	s.httpHandlerMock.AssertNotCalled(s.T(), "Handle", mock.Anything)
+}
+
+func TestSecurityHandlerDecorator(t *testing.T) {
+	suite.Run(t, new(SecurityHandlerDecoratorSuite))
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SelectShippingMethodHandlerInput struct {
+	ShippingMethod *string `json:"shippingMethod" validate:"required"`
+}
+
+type SelectShippingMethodHandlerOutput struct {
+	ShippingMethod string `json:"shippingMethod"`
+	Name           string `json:"name"`
+	Currency       string `json:"currency"`
+	Cost           int64  `json:"cost"`
+	Total          int64  `json:"total"`
+}
+
+type SelectShippingMethodHandler struct {
+	Validator            infra.Validator
+	SelectShippingMethod usecases.ISelectShippingMethod
+}
+
+func (s *SelectShippingMethodHandler) Handle(c echo.Context) error {
+	handlerInput := SelectShippingMethodHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := s.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	if c.Get("customerId") == nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := uuid.Parse(c.Get("customerId").(string))
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	output, err := s.SelectShippingMethod.Execute(c.Request().Context(), usecases.SelectShippingMethodInput{
+		CustomerId:         customerId,
+		ShippingMethodCode: *handlerInput.ShippingMethod,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, SelectShippingMethodHandlerOutput{
+		ShippingMethod: output.MethodCode,
+		Name:           output.MethodName,
+		Currency:       output.Currency,
+		Cost:           output.Cost,
+		Total:          output.Total,
+	})
+}
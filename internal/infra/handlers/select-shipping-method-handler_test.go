@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/shipping"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SelectShippingMethodMock struct {
+	mock.Mock
+}
+
+func (s *SelectShippingMethodMock) Execute(ctx context.Context, input usecases.SelectShippingMethodInput) (usecases.SelectShippingMethodOutput, error) {
+	args := s.Called(ctx, input)
+	return args.Get(0).(usecases.SelectShippingMethodOutput), args.Error(1)
+}
+
+type SelectShippingMethodHandlerSuite struct {
+	suite.Suite
+	selectShippingMethodMock    SelectShippingMethodMock
+	selectShippingMethodHandler handlers.SelectShippingMethodHandler
+}
+
+func (s *SelectShippingMethodHandlerSuite) SetupTest() {
+	s.selectShippingMethodMock = SelectShippingMethodMock{}
+	s.selectShippingMethodHandler = handlers.SelectShippingMethodHandler{
+		Validator:            infra.NewValidator(),
+		SelectShippingMethod: &s.selectShippingMethodMock,
+	}
+}
+
+func (s *SelectShippingMethodHandlerSuite) TestSelectShippingMethodHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	s.selectShippingMethodMock.On("Execute", mock.Anything, usecases.SelectShippingMethodInput{
+		CustomerId:         uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		ShippingMethodCode: "standard",
+	}).Return(usecases.SelectShippingMethodOutput{
+		MethodCode: "STANDARD",
+		MethodName: "Standard delivery",
+		Currency:   "BRL",
+		Cost:       2990,
+		Total:      12990,
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"shippingMethod": "standard"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	s.selectShippingMethodHandler.Handle(context)
+
+	s.Equal(200, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"shippingMethod": "STANDARD",
+			"name": "Standard delivery",
+			"currency": "BRL",
+			"cost": 2990,
+			"total": 12990
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (s *SelectShippingMethodHandlerSuite) TestSelectShippingMethodHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		usecases.ErrCartNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find a cart for your account. Please add a product to your cart first.",
+		},
+		cart.ErrCartEmpty: {
+			"statusCode": "409",
+			"statusText": "CONFLICT",
+			"message":    "Your cart is empty. Please add a product to your cart first.",
+		},
+		usecases.ErrShippingMethodNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this delivery method. Please choose one of the available methods.",
+		},
+		shipping.ErrShippingWeightNotSupported: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "This delivery method can't ship a cart of this size or weight. Please choose another method.",
+		},
+		shipping.ErrShippingMethodCurrencyMismatch: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "This delivery method is not available for the currency of your cart. Please choose another method.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		s.SetupTest()
+		e := echo.New()
+		s.selectShippingMethodMock.On("Execute", mock.Anything, mock.Anything).
+			Return(usecases.SelectShippingMethodOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"shippingMethod": "DRONE"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		s.selectShippingMethodHandler.Handle(context)
+
+		s.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		s.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (s *SelectShippingMethodHandlerSuite) TestSelectShippingMethodHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["shippingMethod is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		s.selectShippingMethodHandler.Handle(context)
+
+		s.Equal(400, recorder.Code)
+		s.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestSelectShippingMethodHandler(t *testing.T) {
+	suite.Run(t, new(SelectShippingMethodHandlerSuite))
+}
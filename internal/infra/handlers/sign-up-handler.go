@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SignUpHandlerInput struct {
+	Email    *string `json:"email" validate:"required,email"`
+	Password *string `json:"password" validate:"required"`
+}
+
+type SignUpHandlerOutput struct {
+	CustomerId string `json:"customerId"`
+}
+
+type SignUpHandler struct {
+	Validator infra.Validator
+	SignUp    usecases.ISignUp
+}
+
+func (s *SignUpHandler) Handle(c echo.Context) error {
+	handlerInput := SignUpHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := s.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	output, err := s.SignUp.Execute(c.Request().Context(), usecases.SignUpInput{
+		Email:    *handlerInput.Email,
+		Password: *handlerInput.Password,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, SignUpHandlerOutput{
+		CustomerId: output.CustomerId.String(),
+	})
+}
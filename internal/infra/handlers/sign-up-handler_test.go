@@ -0,0 +1,170 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignUpMock struct {
+	mock.Mock
+}
+
+func (s *SignUpMock) Execute(ctx context.Context, input usecases.SignUpInput) (usecases.SignUpOutput, error) {
+	args := s.Called(ctx, input)
+	return args.Get(0).(usecases.SignUpOutput), args.Error(1)
+}
+
+type SignUpHandlerSuite struct {
+	suite.Suite
+	signUpMock    SignUpMock
+	signUpHandler handlers.SignUpHandler
+}
+
+func (s *SignUpHandlerSuite) SetupTest() {
+	s.signUpMock = SignUpMock{}
+	s.signUpHandler = handlers.SignUpHandler{
+		Validator: infra.NewValidator(),
+		SignUp:    &s.signUpMock,
+	}
+}
+
+func (s *SignUpHandlerSuite) TestSignUpHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	s.signUpMock.On("Execute", mock.Anything, usecases.SignUpInput{
+		Email:    "john.doe@example.com",
+		Password: "s3cret-password",
+	}).Return(usecases.SignUpOutput{
+		CustomerId: uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+	}, nil)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`
+	{
+		"email": "john.doe@example.com",
+		"password": "s3cret-password"
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	s.signUpHandler.Handle(context)
+
+	s.Equal(200, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": {
+			"customerId": "5ad98fc5-6b0f-45fd-a886-d6a15a63c833"
+		}
+	}
+	`, recorder.Body.String())
+}
+
+func (s *SignUpHandlerSuite) TestSignUpHandler_Handle_OnEmailAlreadyInUse_ReturnsConflict() {
+	e := echo.New()
+	s.signUpMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.SignUpOutput{}, repositories.ErrCustomerEmailAlreadyInUse)
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"email": "john.doe@example.com", "password": "s3cret-password"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	s.signUpHandler.Handle(context)
+
+	s.Equal(409, recorder.Code)
+	s.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 409,
+		"statusText": "CONFLICT",
+		"error": "An account with this email already exists. Please log in instead."
+	}
+	`, recorder.Body.String())
+}
+
+func (s *SignUpHandlerSuite) TestSignUpHandler_Handle_OnPasswordValidationErrors_ReturnsBadRequest() {
+	errorsAndMessages := map[error]map[string]string{
+		customer.ErrCustomerPasswordTooShort: {
+			"message": "password must have at least 8 characters",
+		},
+		customer.ErrCustomerPasswordTooLong: {
+			"message": "password must have at most 72 characters",
+		},
+	}
+
+	for useCaseError, errorAndMessage := range errorsAndMessages {
+		s.SetupTest()
+		e := echo.New()
+		s.signUpMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.SignUpOutput{}, useCaseError)
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"email": "john.doe@example.com", "password": "abc"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		s.signUpHandler.Handle(context)
+
+		s.Equal(400, recorder.Code)
+		s.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": ["%s"]
+		}
+		`, errorAndMessage["message"]), recorder.Body.String())
+	}
+}
+
+func (s *SignUpHandlerSuite) TestSignUpHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["email is required", "password is required"]`,
+		},
+		{
+			"body":   `{"email": "john.doe", "password": "s3cret-password"}`,
+			"errors": `["email must be a valid email"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("POST", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		s.signUpHandler.Handle(context)
+
+		s.Equal(400, recorder.Code)
+		s.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestSignUpHandler(t *testing.T) {
+	suite.Run(t, new(SignUpHandlerSuite))
+}
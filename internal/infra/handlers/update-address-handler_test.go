@@ -0,0 +1,172 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateAddressMock struct {
+	mock.Mock
+}
+
+func (u *UpdateAddressMock) Execute(ctx context.Context, input usecases.UpdateAddressInput) error {
+	args := u.Called(ctx, input)
+	return args.Error(0)
+}
+
+type UpdateAddressHandlerSuite struct {
+	suite.Suite
+	updateAddressMock    UpdateAddressMock
+	updateAddressHandler handlers.UpdateAddressHandler
+}
+
+func (u *UpdateAddressHandlerSuite) SetupTest() {
+	u.updateAddressMock = UpdateAddressMock{}
+	u.updateAddressHandler = handlers.UpdateAddressHandler{
+		Validator:     infra.NewValidator(),
+		UpdateAddress: &u.updateAddressMock,
+	}
+}
+
+func (u *UpdateAddressHandlerSuite) TestUpdateAddressHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	u.updateAddressMock.On("Execute", mock.Anything, usecases.UpdateAddressInput{
+		CustomerId:     uuid.MustParse("5ad98fc5-6b0f-45fd-a886-d6a15a63c833"),
+		AddressId:      uuid.MustParse("9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"),
+		RecipientName:  "Jane Doe",
+		Line1:          "Rua Augusta, 500",
+		City:           "São Paulo",
+		Region:         "SP",
+		PostalCode:     "01305-000",
+		Country:        "BR",
+		DefaultBilling: true,
+	}).Return(nil)
+	request := httptest.NewRequest("PUT", "/", strings.NewReader(`
+	{
+		"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f",
+		"recipientName": "Jane Doe",
+		"line1": "Rua Augusta, 500",
+		"city": "São Paulo",
+		"region": "SP",
+		"postalCode": "01305-000",
+		"country": "BR",
+		"defaultBilling": true
+	}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	u.updateAddressHandler.Handle(context)
+
+	u.Equal(200, recorder.Code)
+	u.updateAddressMock.AssertExpectations(u.T())
+}
+
+func (u *UpdateAddressHandlerSuite) TestUpdateAddressHandler_Handle_OnUseCaseErrors_ReturnsMappedResponse() {
+	errorsAndResponses := map[error]map[string]string{
+		address.ErrAddressNotFound: {
+			"statusCode": "404",
+			"statusText": "NOT_FOUND",
+			"message":    "We couldn't find this address in your address book.",
+		},
+		address.ErrAddressCountryNotSupported: {
+			"statusCode": "400",
+			"statusText": "BAD_REQUEST",
+			"message":    "We don't deliver to this country yet. Please use another address.",
+		},
+		errors.New("connection refused"): {
+			"statusCode": "500",
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"message":    "Something went wrong. Please try again later.",
+		},
+	}
+
+	for useCaseError, errorAndResponse := range errorsAndResponses {
+		u.SetupTest()
+		e := echo.New()
+		u.updateAddressMock.On("Execute", mock.Anything, mock.Anything).Return(useCaseError)
+		request := httptest.NewRequest("PUT", "/", strings.NewReader(`
+		{
+			"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f",
+			"recipientName": "John Doe",
+			"line1": "1-1 Chiyoda",
+			"city": "Tokyo",
+			"postalCode": "100-0001",
+			"country": "JP"
+		}
+		`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		u.updateAddressHandler.Handle(context)
+
+		u.Equal(errorAndResponse["statusCode"], fmt.Sprint(recorder.Code))
+		u.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": %s,
+			"statusText": "%s",
+			"error": "%s"
+		}
+		`, errorAndResponse["statusCode"], errorAndResponse["statusText"], errorAndResponse["message"]), recorder.Body.String())
+	}
+}
+
+func (u *UpdateAddressHandlerSuite) TestUpdateAddressHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body": `{"addressId": "abc", "recipientName": "John Doe", "line1": "Rua Augusta, 500", "city": "São Paulo",
+				"postalCode": "01305-000", "country": "BR"}`,
+			"errors": `["addressId must be uuidv4"]`,
+		},
+		{
+			"body":   `{"addressId": "9c1f3a52-2d6e-4f0a-8b7e-3f2a1c5d6e7f"}`,
+			"errors": `["recipientName is required", "line1 is required", "city is required", "postalCode is required", "country is required"]`,
+		},
+	}
+
+	for _, bodyAndError := range bodiesAndErrors {
+		e := echo.New()
+		request := httptest.NewRequest("PUT", "/", strings.NewReader(bodyAndError["body"]))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		u.updateAddressHandler.Handle(context)
+
+		u.Equal(400, recorder.Code)
+		u.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, bodyAndError["errors"]), recorder.Body.String())
+	}
+}
+
+func TestUpdateAddressHandler(t *testing.T) {
+	suite.Run(t, new(UpdateAddressHandlerSuite))
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateCartItemQuantityHandlerInput struct {
+	ProductId *string `json:"productId" validate:"required,uuid4"`
+	Quantity  *int    `json:"quantity" validate:"required,gte=0"`
+}
+
+type UpdateCartItemQuantityHandler struct {
+	Validator              infra.Validator
+	UpdateCartItemQuantity usecases.IUpdateCartItemQuantity
+}
+
+func (u *UpdateCartItemQuantityHandler) Handle(c echo.Context) error {
+	handlerInput := UpdateCartItemQuantityHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := u.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	productId, err := uuid.Parse(*handlerInput.ProductId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	customerId, err := optionalCustomerId(c)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	err = u.UpdateCartItemQuantity.Execute(c.Request().Context(), usecases.UpdateCartItemQuantityInput{
+		CustomerId: customerId,
+		ProductId:  productId,
+		Quantity:   int32(*handlerInput.Quantity),
+		CartToken:  readCartToken(c),
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateCartItemQuantityMock struct {
+	mock.Mock
+}
+
+func (u *UpdateCartItemQuantityMock) Execute(ctx context.Context, input usecases.UpdateCartItemQuantityInput) error {
+	args := u.Called(ctx, input)
+	return args.Error(0)
+}
+
+type UpdateCartItemQuantityHandlerSuite struct {
+	suite.Suite
+	updateCartItemQuantityMock    UpdateCartItemQuantityMock
+	updateCartItemQuantityHandler handlers.UpdateCartItemQuantityHandler
+}
+
+func (u *UpdateCartItemQuantityHandlerSuite) SetupTest() {
+	u.updateCartItemQuantityMock = UpdateCartItemQuantityMock{}
+	u.updateCartItemQuantityHandler = handlers.UpdateCartItemQuantityHandler{
+		Validator:              infra.NewValidator(),
+		UpdateCartItemQuantity: &u.updateCartItemQuantityMock,
+	}
+}
+
+func (u *UpdateCartItemQuantityHandlerSuite) TestUpdateCartItemQuantityHandler_Handle_OnNoErrors_ReturnsOk() {
+	for _, quantity := range []int{0, 2} {
+		u.SetupTest()
+		e := echo.New()
+		u.updateCartItemQuantityMock.On("Execute", mock.Anything, mock.Anything).Return(nil)
+		request := httptest.NewRequest("PATCH", "/", strings.NewReader(fmt.Sprintf(`
+			{
+				"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+				"quantity": %d
+			}
+		`, quantity)))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+		context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+		u.updateCartItemQuantityHandler.Handle(context)
+
+		u.Equal(200, recorder.Code)
+		u.JSONEq(`
+		{
+			"status": "SUCCESS",
+			"statusCode": 200,
+			"statusText": "OK",
+			"data": null
+		}
+		`, recorder.Body.String())
+		u.updateCartItemQuantityMock.AssertCalled(u.T(), "Execute", mock.Anything, mock.MatchedBy(func(input usecases.UpdateCartItemQuantityInput) bool {
+			return input.Quantity == int32(quantity)
+		}))
+	}
+}
+
+func (u *UpdateCartItemQuantityHandlerSuite) TestUpdateCartItemQuantityHandler_Handle_OnCartNotFound_ReturnsNotFound() {
+	e := echo.New()
+	u.updateCartItemQuantityMock.On("Execute", mock.Anything, mock.Anything).Return(usecases.ErrCartNotFound)
+	request := httptest.NewRequest("PATCH", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 2
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	u.updateCartItemQuantityHandler.Handle(context)
+
+	u.Equal(404, recorder.Code)
+	u.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find a cart for your account. Please add a product to your cart first."
+	}
+	`, recorder.Body.String())
+}
+
+func (u *UpdateCartItemQuantityHandlerSuite) TestUpdateCartItemQuantityHandler_Handle_OnProductNotInCart_ReturnsNotFound() {
+	e := echo.New()
+	u.updateCartItemQuantityMock.On("Execute", mock.Anything, mock.Anything).Return(cart.ErrProductNotInCart)
+	request := httptest.NewRequest("PATCH", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"quantity": 2
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+	context.Set("customerId", "5ad98fc5-6b0f-45fd-a886-d6a15a63c833")
+
+	u.updateCartItemQuantityHandler.Handle(context)
+
+	u.Equal(404, recorder.Code)
+	u.JSONEq(`
+	{
+		"status": "ERROR",
+		"statusCode": 404,
+		"statusText": "NOT_FOUND",
+		"error": "We couldn't find this product in your cart. Please check the product ID and try again."
+	}
+	`, recorder.Body.String())
+}
+
+func (u *UpdateCartItemQuantityHandlerSuite) TestUpdateCartItemQuantityHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	u.updateCartItemQuantityMock.On("Execute", mock.Anything, mock.Anything).Return(nil)
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["productId is required", "quantity is required"]`,
+		},
+		{
+			"body": `{
+				"productId": "abc",
+				"quantity": null
+			}`,
+			"errors": `["productId must be uuidv4", "quantity is required"]`,
+		},
+		{
+			"body": `{
+				"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+				"quantity": -1
+			}`,
+			"errors": `["quantity must be greater than or equal to 0"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		body := inputAndError["body"]
+		errorMessage := inputAndError["errors"]
+
+		e := echo.New()
+		request := httptest.NewRequest("PATCH", "/", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		u.updateCartItemQuantityHandler.Handle(context)
+
+		u.Equal(400, recorder.Code)
+		u.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, errorMessage), recorder.Body.String())
+	}
+}
+
+func TestUpdateCartItemQuantityHandler(t *testing.T) {
+	suite.Run(t, new(UpdateCartItemQuantityHandlerSuite))
+}
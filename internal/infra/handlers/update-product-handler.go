@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateProductHandlerInput struct {
+	ProductId   *string                        `json:"productId" validate:"required,uuid4"`
+	Name        *string                        `json:"name" validate:"required"`
+	Description *string                        `json:"description"`
+	Sku         *string                        `json:"sku" validate:"required"`
+	Price       *int64                         `json:"price" validate:"required,gte=0"`
+	Currency    *string                        `json:"currency"`
+	TaxClass    *string                        `json:"taxClass"`
+	Dimensions  *ProductDimensionsHandlerInput `json:"dimensions"`
+}
+
+type UpdateProductHandler struct {
+	Validator     infra.Validator
+	UpdateProduct usecases.IUpdateProduct
+}
+
+func (h *UpdateProductHandler) Handle(c echo.Context) error {
+	handlerInput := UpdateProductHandlerInput{}
+	if err := c.Bind(&handlerInput); err != nil {
+		return webhttp.NewBadRequestValidation(c, []string{"content-type must be application/json."})
+	}
+
+	errorsMessages := h.Validator.Validate(handlerInput)
+	if len(errorsMessages) > 0 {
+		return webhttp.NewBadRequestValidation(c, errorsMessages)
+	}
+
+	productId, err := uuid.Parse(*handlerInput.ProductId)
+	if err != nil {
+		return webhttp.NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	description := ""
+	if handlerInput.Description != nil {
+		description = *handlerInput.Description
+	}
+
+	currency := ""
+	if handlerInput.Currency != nil {
+		currency = *handlerInput.Currency
+	}
+
+	taxClass := ""
+	if handlerInput.TaxClass != nil {
+		taxClass = *handlerInput.TaxClass
+	}
+
+	var dimensions *usecases.ProductDimensionsInput
+	if handlerInput.Dimensions != nil {
+		dimensions = &usecases.ProductDimensionsInput{
+			WeightGrams: *handlerInput.Dimensions.WeightGrams,
+			LengthCm:    *handlerInput.Dimensions.LengthCm,
+			WidthCm:     *handlerInput.Dimensions.WidthCm,
+			HeightCm:    *handlerInput.Dimensions.HeightCm,
+		}
+	}
+
+	err = h.UpdateProduct.Execute(c.Request().Context(), usecases.UpdateProductInput{
+		ProductId:   productId,
+		Name:        *handlerInput.Name,
+		Description: description,
+		Sku:         *handlerInput.Sku,
+		Price:       *handlerInput.Price,
+		Currency:    currency,
+		TaxClass:    taxClass,
+		Dimensions:  dimensions,
+	})
+
+	if err != nil {
+		return webhttp.NewErrorResponse(c, err)
+	}
+
+	return webhttp.NewOk(c, nil)
+}
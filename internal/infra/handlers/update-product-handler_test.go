@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateProductMock struct {
+	mock.Mock
+}
+
+func (u *UpdateProductMock) Execute(ctx context.Context, input usecases.UpdateProductInput) error {
+	args := u.Called(ctx, input)
+	return args.Error(0)
+}
+
+type UpdateProductHandlerSuite struct {
+	suite.Suite
+	updateProductMock    UpdateProductMock
+	updateProductHandler handlers.UpdateProductHandler
+}
+
+func (u *UpdateProductHandlerSuite) SetupTest() {
+	u.updateProductMock = UpdateProductMock{}
+	u.updateProductHandler = handlers.UpdateProductHandler{
+		Validator:     infra.NewValidator(),
+		UpdateProduct: &u.updateProductMock,
+	}
+}
+
+func (u *UpdateProductHandlerSuite) TestUpdateProductHandler_Handle_OnNoErrors_ReturnsOk() {
+	e := echo.New()
+	u.updateProductMock.On("Execute", mock.Anything, usecases.UpdateProductInput{
+		ProductId:   uuid.MustParse("632ef70b-4184-4704-ad7d-8b8f5dd534d9"),
+		Name:        "Wireless Keyboard",
+		Description: "",
+		Sku:         "KB-001",
+		Price:       39990,
+	}).Return(nil)
+	request := httptest.NewRequest("PUT", "/", strings.NewReader(`
+		{
+			"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+			"name": "Wireless Keyboard",
+			"sku": "KB-001",
+			"price": 39990
+		}
+	`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	context := e.NewContext(request, recorder)
+
+	u.updateProductHandler.Handle(context)
+
+	u.Equal(200, recorder.Code)
+	u.JSONEq(`
+	{
+		"status": "SUCCESS",
+		"statusCode": 200,
+		"statusText": "OK",
+		"data": null
+	}
+	`, recorder.Body.String())
+}
+
+func (u *UpdateProductHandlerSuite) TestUpdateProductHandler_Handle_OnErrors_ReturnsErrorResponse() {
+	errorsAndResponses := map[error]string{
+		usecases.ErrProductNotFound: `
+		{
+			"status": "ERROR",
+			"statusCode": 404,
+			"statusText": "NOT_FOUND",
+			"error": "We couldn't find this product. Please check the product ID and try again."
+		}`,
+		usecases.ErrProductSkuAlreadyExists: `
+		{
+			"status": "ERROR",
+			"statusCode": 409,
+			"statusText": "CONFLICT",
+			"error": "A product with this SKU already exists. Please use a different SKU."
+		}`,
+		errors.New("connection refused"): `
+		{
+			"status": "ERROR",
+			"statusCode": 500,
+			"statusText": "INTERNAL_SERVER_ERROR",
+			"error": "Something went wrong. Please try again later."
+		}`,
+	}
+
+	for useCaseError, response := range errorsAndResponses {
+		u.SetupTest()
+		e := echo.New()
+		u.updateProductMock.On("Execute", mock.Anything, mock.Anything).Return(useCaseError)
+		request := httptest.NewRequest("PUT", "/", strings.NewReader(`
+			{
+				"productId": "632ef70b-4184-4704-ad7d-8b8f5dd534d9",
+				"name": "Wireless Keyboard",
+				"sku": "KB-001",
+				"price": 39990
+			}
+		`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		u.updateProductHandler.Handle(context)
+
+		u.JSONEq(response, recorder.Body.String())
+	}
+}
+
+func (u *UpdateProductHandlerSuite) TestUpdateProductHandler_Handle_OnInvalidBody_ReturnsBadRequest() {
+	bodiesAndErrors := []map[string]string{
+		{
+			"body":   `abc`,
+			"errors": `["content-type must be application/json."]`,
+		},
+		{
+			"body":   `{}`,
+			"errors": `["productId is required", "name is required", "sku is required", "price is required"]`,
+		},
+		{
+			"body": `{
+				"productId": "abc",
+				"name": "Wireless Keyboard",
+				"sku": "KB-001",
+				"price": -1
+			}`,
+			"errors": `["productId must be uuidv4", "price must be greater than or equal to 0"]`,
+		},
+	}
+
+	for _, inputAndError := range bodiesAndErrors {
+		body := inputAndError["body"]
+		errorMessage := inputAndError["errors"]
+
+		e := echo.New()
+		request := httptest.NewRequest("PUT", "/", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		context := e.NewContext(request, recorder)
+
+		u.updateProductHandler.Handle(context)
+
+		u.Equal(400, recorder.Code)
+		u.JSONEq(fmt.Sprintf(`
+		{
+			"status": "ERROR",
+			"statusCode": 400,
+			"statusText": "BAD_REQUEST",
+			"errors": %s
+		}
+		`, errorMessage), recorder.Body.String())
+	}
+}
+
+func TestUpdateProductHandler(t *testing.T) {
+	suite.Run(t, new(UpdateProductHandlerSuite))
+}
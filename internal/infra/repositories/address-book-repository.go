@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+)
+
+type AddressBookRepository struct {
+	Conn database.IConn
+}
+
+func (a *AddressBookRepository) Save(ctx context.Context, addressBook address.AddressBook) error {
+	transaction, err := a.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	addressIds := []string{}
+	for _, address := range addressBook.Addresses {
+		addressIds = append(addressIds, address.Id.String())
+	}
+
+	_, err = transaction.Exec(ctx, "DELETE FROM addresses WHERE customer_id = $1 AND id <> ALL($2::uuid[])",
+		addressBook.CustomerId.String(), addressIds)
+
+	if err != nil {
+		return err
+	}
+
+	for position, address := range addressBook.Addresses {
+		_, err = transaction.Exec(ctx,
+			`INSERT INTO addresses (id, customer_id, recipient_name, line1, line2, city, region, postal_code, country,
+			 default_shipping, default_billing, position)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			 ON CONFLICT (id) DO UPDATE SET recipient_name = $3, line1 = $4, line2 = $5, city = $6, region = $7,
+			 postal_code = $8, country = $9, default_shipping = $10, default_billing = $11, position = $12`,
+			address.Id.String(), addressBook.CustomerId.String(), address.RecipientName, address.Line1, address.Line2,
+			address.City, address.Region, address.PostalCode, address.Country, address.DefaultShipping,
+			address.DefaultBilling, position)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AddressBookRepository) FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*address.AddressBook, error) {
+	type AddressSchema struct {
+		id              uuid.UUID
+		recipientName   string
+		line1           string
+		line2           string
+		city            string
+		region          string
+		postalCode      string
+		country         string
+		defaultShipping bool
+		defaultBilling  bool
+	}
+
+	rows, err := a.Conn.Query(ctx,
+		`SELECT id, recipient_name, line1, line2, city, region, postal_code, country, default_shipping, default_billing
+		 FROM addresses
+		 WHERE customer_id = $1
+		 ORDER BY position, created_at`, customerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	addressBook := address.NewAddressBook(customerId)
+	for rows.Next() {
+		var addressSchema AddressSchema
+		err := rows.Scan(&addressSchema.id, &addressSchema.recipientName, &addressSchema.line1, &addressSchema.line2,
+			&addressSchema.city, &addressSchema.region, &addressSchema.postalCode, &addressSchema.country,
+			&addressSchema.defaultShipping, &addressSchema.defaultBilling)
+
+		if err != nil {
+			return nil, err
+		}
+
+		addressBook.Addresses = append(addressBook.Addresses, address.Address{
+			Id:              addressSchema.id,
+			RecipientName:   addressSchema.recipientName,
+			Line1:           addressSchema.line1,
+			Line2:           addressSchema.line2,
+			City:            addressSchema.city,
+			Region:          addressSchema.region,
+			PostalCode:      addressSchema.postalCode,
+			Country:         addressSchema.country,
+			DefaultShipping: addressSchema.defaultShipping,
+			DefaultBilling:  addressSchema.defaultBilling,
+		})
+	}
+
+	return &addressBook, nil
+}
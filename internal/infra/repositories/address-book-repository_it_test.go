@@ -0,0 +1,148 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/address"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type AddressBookRepositorySuite struct {
+	conn                  *pgx.Conn
+	addressBookRepository repositories.AddressBookRepository
+	postgresContainer     testcontainers.Container
+	suite.Suite
+}
+
+func (a *AddressBookRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	a.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	a.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	a.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	a.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS customers (
+			id UUID PRIMARY KEY,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	a.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS addresses (
+			id UUID PRIMARY KEY,
+			customer_id UUID NOT NULL,
+			recipient_name VARCHAR(255) NOT NULL,
+			line1 VARCHAR(255) NOT NULL,
+			line2 VARCHAR(255) NOT NULL DEFAULT '',
+			city VARCHAR(128) NOT NULL,
+			region VARCHAR(64) NOT NULL DEFAULT '',
+			postal_code VARCHAR(16) NOT NULL,
+			country CHAR(2) NOT NULL,
+			default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+			default_billing BOOLEAN NOT NULL DEFAULT FALSE,
+			position INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (customer_id) REFERENCES customers (id)
+		)
+	`)
+	a.Require().NoError(err)
+
+	a.conn = conn
+	a.postgresContainer = postgresContainer
+	a.addressBookRepository = repositories.AddressBookRepository{
+		Conn: conn,
+	}
+}
+
+func (a *AddressBookRepositorySuite) TearDownTest() {
+	a.postgresContainer.Terminate(context.Background())
+}
+
+func (a *AddressBookRepositorySuite) TestAddressBookRepository_Save_OnNewAddresses_PersistsAddressBook() {
+	customerId := uuid.New()
+	_, err := a.conn.Exec(context.Background(), "INSERT INTO customers (id) VALUES ($1)", customerId)
+	a.Require().NoError(err)
+
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "Apt 12", "São Paulo", "SP", "01310-100", "BR")
+	work, _ := address.NewAddress("John Doe", "Rua Augusta, 500", "", "São Paulo", "SP", "01305-000", "BR")
+	a.Require().NoError(addressBook.Add(home))
+	a.Require().NoError(addressBook.Add(work))
+	a.Require().NoError(addressBook.SetDefaultBilling(work.Id))
+
+	err = a.addressBookRepository.Save(context.Background(), addressBook)
+	a.Require().NoError(err)
+
+	sut, err := a.addressBookRepository.FindOneByCustomerId(context.Background(), customerId)
+	a.Require().NoError(err)
+	a.Equal(addressBook, *sut)
+}
+
+func (a *AddressBookRepositorySuite) TestAddressBookRepository_Save_OnRemovedAddress_DeletesAddress() {
+	customerId := uuid.New()
+	_, err := a.conn.Exec(context.Background(), "INSERT INTO customers (id) VALUES ($1)", customerId)
+	a.Require().NoError(err)
+
+	addressBook := address.NewAddressBook(customerId)
+	home, _ := address.NewAddress("John Doe", "Av. Paulista, 1000", "", "São Paulo", "SP", "01310-100", "BR")
+	work, _ := address.NewAddress("John Doe", "Rua Augusta, 500", "", "São Paulo", "SP", "01305-000", "BR")
+	a.Require().NoError(addressBook.Add(home))
+	a.Require().NoError(addressBook.Add(work))
+	a.Require().NoError(a.addressBookRepository.Save(context.Background(), addressBook))
+
+	a.Require().NoError(addressBook.Remove(home.Id))
+	err = a.addressBookRepository.Save(context.Background(), addressBook)
+	a.Require().NoError(err)
+
+	sut, err := a.addressBookRepository.FindOneByCustomerId(context.Background(), customerId)
+	a.Require().NoError(err)
+	a.Len(sut.Addresses, 1)
+	a.Equal(work.Id, sut.Addresses[0].Id)
+	a.Equal(true, sut.Addresses[0].DefaultShipping)
+	a.Equal(true, sut.Addresses[0].DefaultBilling)
+}
+
+func (a *AddressBookRepositorySuite) TestAddressBookRepository_FindOneByCustomerId_OnNoAddresses_ReturnsEmptyAddressBook() {
+	customerId := uuid.New()
+
+	sut, err := a.addressBookRepository.FindOneByCustomerId(context.Background(), customerId)
+
+	a.Require().NoError(err)
+	a.Equal(address.NewAddressBook(customerId), *sut)
+}
+
+func TestAddressBookRepository(t *testing.T) {
+	suite.Run(t, new(AddressBookRepositorySuite))
+}
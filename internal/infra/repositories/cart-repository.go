@@ -2,20 +2,27 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type CartRepository struct {
-	Conn *pgx.Conn
+	Conn database.IConn
 }
 
-func (c *CartRepository) Create(cart cart.Cart) error {
-	ctx := context.Background()
+func (c *CartRepository) Create(ctx context.Context, cart cart.Cart) error {
+	totalPrice, err := cart.TotalPrice()
+	if err != nil {
+		return err
+	}
 
 	transaction, err := c.Conn.Begin(ctx)
 	if err != nil {
@@ -24,20 +31,33 @@ func (c *CartRepository) Create(cart cart.Cart) error {
 
 	defer transaction.Rollback(ctx)
 
-	_, err = transaction.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
-		cart.Id.String(), cart.CustomerId.String(), cart.TotalPrice().Value, cart.TotalQuantity().Value)
+	var customerId *string
+	if !cart.IsGuest() {
+		customerIdValue := cart.CustomerId.String()
+		customerId = &customerIdValue
+	}
+
+	_, err = transaction.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity, coupon_code, shipping_method, version) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		cart.Id.String(), customerId, totalPrice.Value, cart.TotalQuantity().Value, cart.CouponCode, cart.ShippingMethodCode, cart.Version)
+
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) && pgError.Code == "23505" {
+		return &repositories.CartVersionConflictError{CartId: cart.Id, Version: cart.Version}
+	}
 
 	if err != nil {
 		return err
 	}
 
+	batch := &pgx.Batch{}
 	for _, cartItem := range cart.Items {
-		_, err = transaction.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
-			cartItem.Id.String(), cart.Id.String(), cartItem.ProductId.String(), cart.TotalQuantity().Value)
+		batch.Queue("INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
+			cartItem.Id.String(), cart.Id.String(), cartItem.ProductId.String(), cartItem.Quantity.Value)
+	}
 
-		if err != nil {
-			return err
-		}
+	err = transaction.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return err
 	}
 
 	err = transaction.Commit(ctx)
@@ -48,36 +68,113 @@ func (c *CartRepository) Create(cart cart.Cart) error {
 	return nil
 }
 
-func (c *CartRepository) Update(cart cart.Cart) error {
-	ctx := context.Background()
+func (c *CartRepository) Update(ctx context.Context, cart cart.Cart) error {
+	totalPrice, err := cart.TotalPrice()
+	if err != nil {
+		return err
+	}
 
 	transaction, err := c.Conn.Begin(ctx)
 	if err != nil {
 		return err
 	}
 
-	defer transaction.Rollback(context.Background())
+	defer transaction.Rollback(ctx)
 
-	_, err = transaction.Exec(ctx, "UPDATE carts SET total_price = $1, total_quantity = $2 WHERE id = $3",
-		cart.TotalPrice().Value, cart.TotalQuantity().Value, cart.Id.String())
+	commandTag, err := transaction.Exec(ctx,
+		"UPDATE carts SET total_price = $1, total_quantity = $2, coupon_code = $3, shipping_method = $4, version = version + 1 WHERE id = $5 AND version = $6",
+		totalPrice.Value, cart.TotalQuantity().Value, cart.CouponCode, cart.ShippingMethodCode, cart.Id.String(), cart.Version)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = transaction.Exec(ctx, "DELETE FROM cart_items WHERE cart_id = $1", cart.Id.String())
+	if commandTag.RowsAffected() == 0 {
+		return &repositories.CartVersionConflictError{CartId: cart.Id, Version: cart.Version}
+	}
 
+	rows, err := transaction.Query(ctx, "SELECT id, quantity FROM cart_items WHERE cart_id = $1", cart.Id.String())
 	if err != nil {
 		return err
 	}
 
-	for _, cartItem := range cart.Items {
-		_, err = transaction.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
-			cartItem.Id.String(), cart.Id.String(), cartItem.ProductId.String(), cart.TotalQuantity().Value)
-
+	storedQuantities := map[uuid.UUID]int32{}
+	for rows.Next() {
+		var cartItemId uuid.UUID
+		var quantity int32
+		err := rows.Scan(&cartItemId, &quantity)
 		if err != nil {
+			rows.Close()
 			return err
 		}
+
+		storedQuantities[cartItemId] = quantity
+	}
+
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	currentCartItemIds := map[uuid.UUID]bool{}
+	for _, cartItem := range cart.Items {
+		currentCartItemIds[cartItem.Id] = true
+	}
+
+	removedCartItemIds := []uuid.UUID{}
+	for cartItemId := range storedQuantities {
+		if !currentCartItemIds[cartItemId] {
+			removedCartItemIds = append(removedCartItemIds, cartItemId)
+		}
+	}
+
+	batch := &pgx.Batch{}
+	if len(removedCartItemIds) > 0 {
+		batch.Queue("DELETE FROM cart_items WHERE cart_id = $1 AND id = ANY($2)", cart.Id.String(), removedCartItemIds)
+	}
+
+	for _, cartItem := range cart.Items {
+		storedQuantity, stored := storedQuantities[cartItem.Id]
+		if !stored {
+			batch.Queue("INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
+				cartItem.Id.String(), cart.Id.String(), cartItem.ProductId.String(), cartItem.Quantity.Value)
+			continue
+		}
+
+		if storedQuantity != cartItem.Quantity.Value {
+			batch.Queue("UPDATE cart_items SET quantity = $1 WHERE id = $2", cartItem.Quantity.Value, cartItem.Id.String())
+		}
+	}
+
+	err = transaction.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CartRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	transaction, err := c.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	_, err = transaction.Exec(ctx, "DELETE FROM cart_items WHERE cart_id = $1", id.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.Exec(ctx, "DELETE FROM carts WHERE id = $1", id.String())
+	if err != nil {
+		return err
 	}
 
 	err = transaction.Commit(ctx)
@@ -88,15 +185,24 @@ func (c *CartRepository) Update(cart cart.Cart) error {
 	return nil
 }
 
-func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart, error) {
-	ctx := context.Background()
+func (c *CartRepository) FindOneById(ctx context.Context, id uuid.UUID) (*cart.Cart, error) {
+	return c.findOne(ctx, "SELECT id, customer_id, total_price, total_quantity, coupon_code, shipping_method, version, created_at FROM carts WHERE id = $1", id)
+}
 
+func (c *CartRepository) FindOneByCustomerId(ctx context.Context, customerId uuid.UUID) (*cart.Cart, error) {
+	return c.findOne(ctx, "SELECT id, customer_id, total_price, total_quantity, coupon_code, shipping_method, version, created_at FROM carts WHERE customer_id = $1", customerId)
+}
+
+func (c *CartRepository) findOne(ctx context.Context, query string, arg interface{}) (*cart.Cart, error) {
 	type CartSchema struct {
-		id            uuid.UUID
-		customerId    uuid.UUID
-		totalPrice    int64
-		totalQuantity int32
-		createdAt     time.Time
+		id             uuid.UUID
+		customerId     *uuid.UUID
+		totalPrice     int64
+		totalQuantity  int32
+		couponCode     string
+		shippingMethod string
+		version        int32
+		createdAt      time.Time
 	}
 
 	type CartItemSchema struct {
@@ -105,15 +211,20 @@ func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart,
 		productId uuid.UUID
 		quantity  int32
 		price     int64
+		currency  string
 		createdAt time.Time
 	}
 
 	var cartSchema CartSchema
-	err := c.Conn.QueryRow(ctx,
-		"SELECT id, customer_id, total_price, total_quantity, created_at FROM carts WHERE customer_id = $1", customerId).
-		Scan(&cartSchema.id, &cartSchema.customerId, &cartSchema.totalPrice, &cartSchema.totalQuantity, &cartSchema.createdAt)
+	err := c.Conn.QueryRow(ctx, query, arg).
+		Scan(&cartSchema.id, &cartSchema.customerId, &cartSchema.totalPrice, &cartSchema.totalQuantity, &cartSchema.couponCode,
+			&cartSchema.shippingMethod, &cartSchema.version, &cartSchema.createdAt)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
 		return nil, err
 	}
 
@@ -124,7 +235,8 @@ func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart,
 					ci.product_id,
 					ci.quantity,
 					ci.created_at,
-					p.price
+					p.price,
+					p.currency
 			 FROM cart_items ci
 			 JOIN products p ON ci.product_id = p.id
 			 WHERE ci.cart_id = $1`, cartSchema.id)
@@ -137,7 +249,8 @@ func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart,
 	for rows.Next() {
 		var cartItemSchema CartItemSchema
 		err := rows.Scan(&cartItemSchema.id, &cartItemSchema.cartId, &cartItemSchema.productId,
-			&cartItemSchema.quantity, &cartItemSchema.createdAt, &cartItemSchema.price)
+			&cartItemSchema.quantity, &cartItemSchema.createdAt, &cartItemSchema.price,
+			&cartItemSchema.currency)
 
 		if err != nil {
 			return nil, err
@@ -148,6 +261,11 @@ func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart,
 
 	cartItems := []cart.CartItem{}
 	for _, cartItemSchema := range cartItemsSchema {
+		currency, err := models.NewCurrency(cartItemSchema.currency)
+		if err != nil {
+			return nil, err
+		}
+
 		cartItem := cart.CartItem{
 			Id:        cartItemSchema.id,
 			ProductId: cartItemSchema.productId,
@@ -155,16 +273,25 @@ func (c *CartRepository) FindOneByCustomerId(customerId uuid.UUID) (*cart.Cart,
 				Value: cartItemSchema.quantity,
 			},
 			Price: models.Money{
-				Value: cartItemSchema.price,
+				Value:    cartItemSchema.price,
+				Currency: currency,
 			},
 		}
 		cartItems = append(cartItems, cartItem)
 	}
 
+	customerId := uuid.Nil
+	if cartSchema.customerId != nil {
+		customerId = *cartSchema.customerId
+	}
+
 	cart := cart.Cart{
-		Id:         cartSchema.id,
-		CustomerId: cartSchema.customerId,
-		Items:      cartItems,
+		Id:                 cartSchema.id,
+		CustomerId:         customerId,
+		Items:              cartItems,
+		CouponCode:         cartSchema.couponCode,
+		ShippingMethodCode: cartSchema.shippingMethod,
+		Version:            cartSchema.version,
 	}
 
 	return &cart, nil
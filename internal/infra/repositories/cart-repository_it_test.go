@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	applicationrepositories "github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
 	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
 	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
@@ -57,6 +58,12 @@ func (c *CartRepositorySuite) SetupTest() {
 		CREATE TABLE IF NOT EXISTS products (
 			id UUID PRIMARY KEY,
 			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		)
 	`)
@@ -65,9 +72,12 @@ func (c *CartRepositorySuite) SetupTest() {
 	_, err = conn.Exec(ctx, `
 		CREATE TABLE IF NOT EXISTS carts (
 			id UUID PRIMARY KEY,
-			customer_id UUID NOT NULL UNIQUE,
+			customer_id UUID UNIQUE,
 			total_price INTEGER NOT NULL,
 			total_quantity INTEGER NOT NULL,
+			coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+			shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+			version INTEGER NOT NULL DEFAULT 0,
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		)
   `)
@@ -121,7 +131,7 @@ func (p *CartRepositorySuite) TestCartRepository_Create_OnSuccess_ReturnsNil() {
 	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
 	p.Require().NoError(err)
 
-	err = p.cartRepository.Create(cart)
+	err = p.cartRepository.Create(context.Background(), cart)
 	p.Require().NoError(err)
 
 	cartScheme := struct {
@@ -159,6 +169,7 @@ func (p *CartRepositorySuite) TestCartRepository_Create_OnSuccess_ReturnsNil() {
 		p.Equal(cartItemId, cartItemSchema.id)
 		p.Equal(cartId, cartItemSchema.cartId)
 		p.Equal(productId, cartItemSchema.productId)
+		p.Equal(int32(2), cartItemSchema.quantity)
 	}
 }
 
@@ -182,6 +193,7 @@ func (p *CartRepositorySuite) TestCartRepository_Update_OnSuccess_ReturnsNil() {
 		Id:         cartId,
 		CustomerId: customerId,
 		Items:      []cart.CartItem{cartItem},
+		CouponCode: "SUMMER10",
 	}
 
 	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
@@ -193,7 +205,7 @@ func (p *CartRepositorySuite) TestCartRepository_Update_OnSuccess_ReturnsNil() {
 		cartItemId, cartId, productId, 5)
 	p.NoError(err)
 
-	err = p.cartRepository.Update(cart)
+	err = p.cartRepository.Update(context.Background(), cart)
 	p.NoError(err)
 
 	cartScheme := struct {
@@ -201,16 +213,20 @@ func (p *CartRepositorySuite) TestCartRepository_Update_OnSuccess_ReturnsNil() {
 		customerId    uuid.UUID
 		totalPrice    int64
 		totalQuantity int32
+		couponCode    string
 		createdAt     time.Time
 	}{}
-	err = p.conn.QueryRow(ctx, "SELECT id, customer_id, total_price, total_quantity, created_at FROM carts WHERE customer_id = $1", customerId).
-		Scan(&cartScheme.id, &cartScheme.customerId, &cartScheme.totalPrice, &cartScheme.totalQuantity, &cartScheme.createdAt)
+	err = p.conn.QueryRow(ctx,
+		"SELECT id, customer_id, total_price, total_quantity, coupon_code, created_at FROM carts WHERE customer_id = $1", customerId).
+		Scan(&cartScheme.id, &cartScheme.customerId, &cartScheme.totalPrice, &cartScheme.totalQuantity, &cartScheme.couponCode,
+			&cartScheme.createdAt)
 	p.NoError(err)
 
 	p.Equal(cartId, cartScheme.id)
 	p.Equal(customerId, cartScheme.customerId)
 	p.Equal(int64(6495), cartScheme.totalPrice)
 	p.Equal(int32(5), cartScheme.totalQuantity)
+	p.Equal("SUMMER10", cartScheme.couponCode)
 
 	rows, err := p.conn.Query(ctx, "SELECT id, cart_id, product_id, quantity, created_at FROM cart_items WHERE cart_id = $1", cartScheme.id)
 	p.NoError(err)
@@ -233,6 +249,146 @@ func (p *CartRepositorySuite) TestCartRepository_Update_OnSuccess_ReturnsNil() {
 	}
 }
 
+func (p *CartRepositorySuite) TestCartRepository_Create_OnMultipleItems_RoundTripsEachItemQuantity() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	firstProductId := uuid.New()
+	secondProductId := uuid.New()
+	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2), ($3, $4)", firstProductId, 1000, secondProductId, 2500)
+	p.Require().NoError(err)
+	newCart, _ := cart.NewCart(customerId)
+	p.Require().NoError(newCart.AddItem(firstProductId, 2, 1000, "BRL"))
+	p.Require().NoError(newCart.AddItem(secondProductId, 7, 2500, "BRL"))
+
+	err = p.cartRepository.Create(context.Background(), newCart)
+	p.Require().NoError(err)
+
+	sut, err := p.cartRepository.FindOneByCustomerId(context.Background(), customerId)
+	p.Require().NoError(err)
+	p.Require().Len(sut.Items, 2)
+	quantities := map[uuid.UUID]int32{}
+	for _, item := range sut.Items {
+		quantities[item.ProductId] = item.Quantity.Value
+	}
+	p.Equal(int32(2), quantities[firstProductId])
+	p.Equal(int32(7), quantities[secondProductId])
+	p.Equal(int32(9), sut.TotalQuantity().Value)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Update_OnChangedItems_WritesOnlyTheDifference() {
+	ctx := context.Background()
+	cartId := uuid.New()
+	customerId := uuid.New()
+	updatedProductId, unchangedProductId, removedProductId, addedProductId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	updatedItemId, unchangedItemId, removedItemId := uuid.New(), uuid.New(), uuid.New()
+	createdAt := time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2), ($3, $4), ($5, $6), ($7, $8)",
+		updatedProductId, 1000, unchangedProductId, 1000, removedProductId, 1000, addedProductId, 1000)
+	p.Require().NoError(err)
+	_, err = p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		cartId, customerId, 7000, 7)
+	p.Require().NoError(err)
+	_, err = p.conn.Exec(ctx, `INSERT INTO cart_items (id, cart_id, product_id, quantity, created_at)
+		VALUES ($1, $2, $3, $4, $5), ($6, $2, $7, $8, $5), ($9, $2, $10, $11, $5)`,
+		updatedItemId, cartId, updatedProductId, 2, createdAt,
+		unchangedItemId, unchangedProductId, 1,
+		removedItemId, removedProductId, 4)
+	p.Require().NoError(err)
+
+	storedCart, err := p.cartRepository.FindOneByCustomerId(context.Background(), customerId)
+	p.Require().NoError(err)
+	p.Require().NoError(storedCart.SetItemQuantity(updatedProductId, 5))
+	p.Require().NoError(storedCart.RemoveItem(removedProductId))
+	p.Require().NoError(storedCart.AddItem(addedProductId, 3, 1000, "BRL"))
+
+	err = p.cartRepository.Update(context.Background(), *storedCart)
+	p.Require().NoError(err)
+
+	type cartItemRow struct {
+		id        uuid.UUID
+		quantity  int32
+		createdAt time.Time
+	}
+	rows, err := p.conn.Query(ctx, "SELECT id, product_id, quantity, created_at FROM cart_items WHERE cart_id = $1", cartId)
+	p.Require().NoError(err)
+	cartItemRows := map[uuid.UUID]cartItemRow{}
+	for rows.Next() {
+		var productId uuid.UUID
+		var row cartItemRow
+		p.Require().NoError(rows.Scan(&row.id, &productId, &row.quantity, &row.createdAt))
+		cartItemRows[productId] = row
+	}
+	p.Require().NoError(rows.Err())
+
+	p.Len(cartItemRows, 3)
+	p.Equal(updatedItemId, cartItemRows[updatedProductId].id)
+	p.Equal(int32(5), cartItemRows[updatedProductId].quantity)
+	p.True(createdAt.Equal(cartItemRows[updatedProductId].createdAt))
+	p.Equal(unchangedItemId, cartItemRows[unchangedProductId].id)
+	p.Equal(int32(1), cartItemRows[unchangedProductId].quantity)
+	p.True(createdAt.Equal(cartItemRows[unchangedProductId].createdAt))
+	p.Equal(int32(3), cartItemRows[addedProductId].quantity)
+	p.NotContains(cartItemRows, removedProductId)
+
+	sut, err := p.cartRepository.FindOneByCustomerId(context.Background(), customerId)
+	p.Require().NoError(err)
+	p.Equal(int32(9), sut.TotalQuantity().Value)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Update_OnSuccess_IncrementsVersion() {
+	ctx := context.Background()
+	cartId := uuid.New()
+	customerId := uuid.New()
+	_, err := p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity, version) VALUES ($1, $2, $3, $4, $5)",
+		cartId, customerId, 0, 0, 3)
+	p.Require().NoError(err)
+
+	err = p.cartRepository.Update(context.Background(), cart.Cart{Id: cartId, CustomerId: customerId, Items: []cart.CartItem{}, Version: 3})
+	p.Require().NoError(err)
+
+	sut, err := p.cartRepository.FindOneByCustomerId(context.Background(), customerId)
+	p.Require().NoError(err)
+	p.Equal(int32(4), sut.Version)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Update_OnStaleVersion_ReturnsVersionConflictError() {
+	ctx := context.Background()
+	cartId := uuid.New()
+	customerId := uuid.New()
+	_, err := p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity, coupon_code, version) VALUES ($1, $2, $3, $4, $5, $6)",
+		cartId, customerId, 0, 0, "SUMMER10", 4)
+	p.Require().NoError(err)
+
+	err = p.cartRepository.Update(context.Background(), cart.Cart{Id: cartId, CustomerId: customerId, Items: []cart.CartItem{}, Version: 3})
+
+	var versionConflictError *applicationrepositories.CartVersionConflictError
+	p.Require().ErrorAs(err, &versionConflictError)
+	p.Equal(cartId, versionConflictError.CartId)
+	p.Equal(int32(3), versionConflictError.Version)
+
+	var couponCode string
+	var version int32
+	err = p.conn.QueryRow(ctx, "SELECT coupon_code, version FROM carts WHERE id = $1", cartId).Scan(&couponCode, &version)
+	p.Require().NoError(err)
+	p.Equal("SUMMER10", couponCode)
+	p.Equal(int32(4), version)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Create_OnCustomerAlreadyHavingCart_ReturnsVersionConflictError() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	_, err := p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		uuid.New(), customerId, 0, 0)
+	p.Require().NoError(err)
+	newCart, _ := cart.NewCart(customerId)
+
+	err = p.cartRepository.Create(context.Background(), newCart)
+
+	var versionConflictError *applicationrepositories.CartVersionConflictError
+	p.ErrorAs(err, &versionConflictError)
+}
+
 func (p *CartRepositorySuite) TestCartRepository_FindOneByCustomerId_OnSuccess_ReturnsCart() {
 	ctx := context.Background()
 	cartId := uuid.New()
@@ -253,23 +409,113 @@ func (p *CartRepositorySuite) TestCartRepository_FindOneByCustomerId_OnSuccess_R
 		Id:         cartId,
 		CustomerId: customerId,
 		Items:      []cart.CartItem{cartItem},
+		CouponCode: "SUMMER10",
 	}
 
 	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4720)
 	p.NoError(err)
-	_, err = p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
-		cartId, customerId, 1640, 7)
+	_, err = p.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity, coupon_code) VALUES ($1, $2, $3, $4, $5)",
+		cartId, customerId, 1640, 7, "SUMMER10")
 	p.NoError(err)
 	_, err = p.conn.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
 		cartItemId, cartId, productId, 7)
 	p.NoError(err)
 
-	sut, err := p.cartRepository.FindOneByCustomerId(customerId)
+	sut, err := p.cartRepository.FindOneByCustomerId(context.Background(), customerId)
 	p.NoError(err)
 
 	p.Equal(cart, *sut)
 }
 
+func (p *CartRepositorySuite) TestCartRepository_FindOneByCustomerId_OnCartNotExists_ReturnsNil() {
+	sut, err := p.cartRepository.FindOneByCustomerId(context.Background(), uuid.New())
+
+	p.NoError(err)
+	p.Nil(sut)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Create_OnGuestCart_StoresNullCustomerId() {
+	ctx := context.Background()
+	guestCart := cart.NewGuestCart()
+
+	err := p.cartRepository.Create(context.Background(), guestCart)
+	p.Require().NoError(err)
+
+	var customerId *uuid.UUID
+	err = p.conn.QueryRow(ctx, "SELECT customer_id FROM carts WHERE id = $1", guestCart.Id).Scan(&customerId)
+	p.Require().NoError(err)
+
+	p.Nil(customerId)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_FindOneById_OnGuestCart_ReturnsCart() {
+	ctx := context.Background()
+	cartId := uuid.New()
+	cartItemId := uuid.New()
+	productId := uuid.New()
+	cartItem := cart.CartItem{
+		Id:        cartItemId,
+		ProductId: productId,
+		Quantity: models.Quantity{
+			Value: 3,
+		},
+		Price: models.Money{
+			Value: 1500,
+		},
+	}
+	guestCart := cart.Cart{
+		Id:    cartId,
+		Items: []cart.CartItem{cartItem},
+	}
+
+	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 1500)
+	p.NoError(err)
+	_, err = p.conn.Exec(ctx, "INSERT INTO carts (id, total_price, total_quantity) VALUES ($1, $2, $3)", cartId, 4500, 3)
+	p.NoError(err)
+	_, err = p.conn.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
+		cartItemId, cartId, productId, 3)
+	p.NoError(err)
+
+	sut, err := p.cartRepository.FindOneById(context.Background(), cartId)
+	p.NoError(err)
+
+	p.Equal(guestCart, *sut)
+	p.True(sut.IsGuest())
+}
+
+func (p *CartRepositorySuite) TestCartRepository_FindOneById_OnCartNotExists_ReturnsNil() {
+	sut, err := p.cartRepository.FindOneById(context.Background(), uuid.New())
+
+	p.NoError(err)
+	p.Nil(sut)
+}
+
+func (p *CartRepositorySuite) TestCartRepository_Delete_OnSuccess_RemovesCartAndItems() {
+	ctx := context.Background()
+	cartId := uuid.New()
+	productId := uuid.New()
+
+	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 1500)
+	p.NoError(err)
+	_, err = p.conn.Exec(ctx, "INSERT INTO carts (id, total_price, total_quantity) VALUES ($1, $2, $3)", cartId, 1500, 1)
+	p.NoError(err)
+	_, err = p.conn.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
+		uuid.New(), cartId, productId, 1)
+	p.NoError(err)
+
+	err = p.cartRepository.Delete(context.Background(), cartId)
+	p.NoError(err)
+
+	var cartsCount, cartItemsCount int
+	err = p.conn.QueryRow(ctx, "SELECT COUNT(*) FROM carts WHERE id = $1", cartId).Scan(&cartsCount)
+	p.NoError(err)
+	err = p.conn.QueryRow(ctx, "SELECT COUNT(*) FROM cart_items WHERE cart_id = $1", cartId).Scan(&cartItemsCount)
+	p.NoError(err)
+
+	p.Equal(0, cartsCount)
+	p.Equal(0, cartItemsCount)
+}
+
 func TestCartRepository(t *testing.T) {
 	suite.Run(t, new(CartRepositorySuite))
 }
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CustomerRepository struct {
+	Conn database.IConn
+}
+
+func (c *CustomerRepository) Create(ctx context.Context, customer customer.Customer) error {
+	_, err := c.Conn.Exec(ctx, "INSERT INTO customers (id, email, password_hash, role) VALUES ($1, $2, $3, $4)",
+		customer.Id.String(), customer.Email, customer.PasswordHash, customer.Role)
+
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) && pgError.Code == "23505" {
+		return repositories.ErrCustomerEmailAlreadyInUse
+	}
+
+	return err
+}
+
+func (c *CustomerRepository) FindOneById(ctx context.Context, id uuid.UUID) (*customer.Customer, error) {
+	return c.findOne(ctx, "SELECT id, email, password_hash, role FROM customers WHERE id = $1", id)
+}
+
+func (c *CustomerRepository) FindOneByEmail(ctx context.Context, email string) (*customer.Customer, error) {
+	return c.findOne(ctx, "SELECT id, email, password_hash, role FROM customers WHERE email = $1", email)
+}
+
+func (c *CustomerRepository) findOne(ctx context.Context, query string, arg interface{}) (*customer.Customer, error) {
+	type CustomerSchema struct {
+		id           uuid.UUID
+		email        string
+		passwordHash string
+		role         string
+	}
+
+	var customerSchema CustomerSchema
+	err := c.Conn.QueryRow(ctx, query, arg).
+		Scan(&customerSchema.id, &customerSchema.email, &customerSchema.passwordHash, &customerSchema.role)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &customer.Customer{
+		Id:           customerSchema.id,
+		Email:        customerSchema.email,
+		PasswordHash: customerSchema.passwordHash,
+		Role:         customerSchema.role,
+	}, nil
+}
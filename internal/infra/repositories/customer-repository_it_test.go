@@ -0,0 +1,124 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type CustomerRepositorySuite struct {
+	conn               *pgx.Conn
+	customerRepository repositories.CustomerRepository
+	postgresContainer  testcontainers.Container
+	suite.Suite
+}
+
+func (c *CustomerRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	c.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	c.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	c.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	c.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS customers (
+			id UUID PRIMARY KEY,
+			email VARCHAR(320) UNIQUE,
+			password_hash VARCHAR(255) NOT NULL DEFAULT '',
+			role VARCHAR(32) NOT NULL DEFAULT 'customer',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	c.Require().NoError(err)
+
+	c.conn = conn
+	c.postgresContainer = postgresContainer
+	c.customerRepository = repositories.CustomerRepository{
+		Conn: conn,
+	}
+}
+
+func (c *CustomerRepositorySuite) TearDownTest() {
+	c.postgresContainer.Terminate(context.Background())
+}
+
+func (c *CustomerRepositorySuite) TestCustomerRepository_Create_OnNewCustomer_PersistsCustomer() {
+	newCustomer, _ := customer.NewCustomer("john.doe@example.com", "$2a$10$hash")
+
+	err := c.customerRepository.Create(context.Background(), newCustomer)
+	c.Require().NoError(err)
+
+	sut, err := c.customerRepository.FindOneByEmail(context.Background(), "john.doe@example.com")
+	c.Require().NoError(err)
+	c.Equal(newCustomer, *sut)
+}
+
+func (c *CustomerRepositorySuite) TestCustomerRepository_Create_OnDuplicatedEmail_ReturnsError() {
+	firstCustomer, _ := customer.NewCustomer("john.doe@example.com", "$2a$10$hash")
+	secondCustomer, _ := customer.NewCustomer("john.doe@example.com", "$2a$10$other")
+	c.Require().NoError(c.customerRepository.Create(context.Background(), firstCustomer))
+
+	err := c.customerRepository.Create(context.Background(), secondCustomer)
+
+	c.EqualError(err, "customer email already in use")
+}
+
+func (c *CustomerRepositorySuite) TestCustomerRepository_FindOneById_OnAdmin_ReturnsCustomerWithAdminRole() {
+	admin, _ := customer.NewCustomer("admin@example.com", "$2a$10$hash")
+	admin.Role = customer.AdminRole
+	c.Require().NoError(c.customerRepository.Create(context.Background(), admin))
+
+	sut, err := c.customerRepository.FindOneById(context.Background(), admin.Id)
+
+	c.Require().NoError(err)
+	c.Equal(admin, *sut)
+}
+
+func (c *CustomerRepositorySuite) TestCustomerRepository_FindOneById_OnCustomerNotExists_ReturnsNil() {
+	sut, err := c.customerRepository.FindOneById(context.Background(), uuid.New())
+
+	c.Require().NoError(err)
+	c.Nil(sut)
+}
+
+func (c *CustomerRepositorySuite) TestCustomerRepository_FindOneByEmail_OnCustomerNotExists_ReturnsNil() {
+	sut, err := c.customerRepository.FindOneByEmail(context.Background(), "john.doe@example.com")
+
+	c.Require().NoError(err)
+	c.Nil(sut)
+}
+
+func TestCustomerRepository(t *testing.T) {
+	suite.Run(t, new(CustomerRepositorySuite))
+}
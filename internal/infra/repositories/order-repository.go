@@ -0,0 +1,301 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+)
+
+type OrderRepository struct {
+	Conn database.IConn
+}
+
+func (o *OrderRepository) CreateFromCart(ctx context.Context, order order.Order, checkedOutCart cart.Cart) error {
+	totalPrice, err := order.TotalPrice()
+	if err != nil {
+		return err
+	}
+
+	totalTax, err := order.TotalTax()
+	if err != nil {
+		return err
+	}
+
+	cartTotalPrice, err := checkedOutCart.TotalPrice()
+	if err != nil {
+		return err
+	}
+
+	transaction, err := o.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	_, err = transaction.Exec(ctx,
+		`INSERT INTO orders (id, customer_id, status, payment_id, coupon_code, discount, total_price, total_quantity, currency, tax_region, total_tax,
+		 shipping_method, shipping_cost, shipping_recipient_name, shipping_line1, shipping_line2, shipping_city, shipping_region,
+		 shipping_postal_code, shipping_country)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`,
+		order.Id.String(), order.CustomerId.String(), string(order.Status), order.PaymentId, order.CouponCode, order.Discount.Value,
+		totalPrice.Value, order.TotalQuantity().Value, totalPrice.Currency.Code, order.TaxRegion, totalTax.Value, order.ShippingMethod,
+		order.ShippingCost.Value, order.ShippingAddress.RecipientName, order.ShippingAddress.Line1, order.ShippingAddress.Line2,
+		order.ShippingAddress.City, order.ShippingAddress.Region, order.ShippingAddress.PostalCode, order.ShippingAddress.Country)
+
+	if err != nil {
+		return err
+	}
+
+	if order.CouponCode != "" {
+		commandTag, err := transaction.Exec(ctx,
+			"UPDATE promotions SET times_used = times_used + 1 WHERE code = $1 AND (usage_limit = 0 OR times_used < usage_limit)",
+			order.CouponCode)
+
+		if err != nil {
+			return err
+		}
+
+		if commandTag.RowsAffected() == 0 {
+			return promotion.ErrCouponUsageLimitReached
+		}
+	}
+
+	for _, orderLine := range order.Lines {
+		_, err = transaction.Exec(ctx,
+			`INSERT INTO order_lines (id, order_id, product_id, quantity, unit_price, tax_class, tax_rate, tax_inclusive, tax)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			orderLine.Id.String(), order.Id.String(), orderLine.ProductId.String(), orderLine.Quantity.Value, orderLine.UnitPrice.Value,
+			orderLine.TaxClass, orderLine.TaxRateBasisPoints, orderLine.TaxInclusive, orderLine.Tax.Value)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	commandTag, err := transaction.Exec(ctx,
+		"UPDATE carts SET total_price = $1, total_quantity = $2, coupon_code = $3, shipping_method = $4, version = version + 1 WHERE id = $5 AND version = $6",
+		cartTotalPrice.Value, checkedOutCart.TotalQuantity().Value, checkedOutCart.CouponCode, checkedOutCart.ShippingMethodCode,
+		checkedOutCart.Id.String(), checkedOutCart.Version)
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &repositories.CartVersionConflictError{CartId: checkedOutCart.Id, Version: checkedOutCart.Version}
+	}
+
+	_, err = transaction.Exec(ctx, "DELETE FROM cart_items WHERE cart_id = $1", checkedOutCart.Id.String())
+
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OrderRepository) Update(ctx context.Context, order order.Order, previousStatus order.OrderStatus) error {
+	transaction, err := o.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	commandTag, err := transaction.Exec(ctx, "UPDATE orders SET status = $1 WHERE id = $2 AND status = $3",
+		string(order.Status), order.Id.String(), string(previousStatus))
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return repositories.ErrOrderStatusConflict
+	}
+
+	for _, statusChange := range order.StatusHistory {
+		_, err = transaction.Exec(ctx,
+			`INSERT INTO order_status_history (id, order_id, from_status, to_status, actor, changed_at)
+			 VALUES ($1, $2, $3, $4, $5, $6)
+			 ON CONFLICT (id) DO NOTHING`,
+			statusChange.Id.String(), order.Id.String(), string(statusChange.From), string(statusChange.To),
+			statusChange.Actor, statusChange.ChangedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OrderRepository) FindOneById(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	type OrderSchema struct {
+		id              uuid.UUID
+		customerId      uuid.UUID
+		status          string
+		paymentId       string
+		couponCode      string
+		discount        int64
+		currency        string
+		taxRegion       string
+		shippingMethod  string
+		shippingCost    int64
+		shippingAddress order.OrderAddress
+	}
+
+	type OrderLineSchema struct {
+		id           uuid.UUID
+		productId    uuid.UUID
+		quantity     int32
+		unitPrice    int64
+		taxClass     string
+		taxRate      int64
+		taxInclusive bool
+		tax          int64
+	}
+
+	type OrderStatusChangeSchema struct {
+		id         uuid.UUID
+		fromStatus string
+		toStatus   string
+		actor      string
+		changedAt  time.Time
+	}
+
+	var orderSchema OrderSchema
+	err := o.Conn.QueryRow(ctx,
+		`SELECT id, customer_id, status, payment_id, coupon_code, discount, currency, tax_region, shipping_method, shipping_cost,
+		 shipping_recipient_name, shipping_line1, shipping_line2, shipping_city, shipping_region, shipping_postal_code, shipping_country
+		 FROM orders
+		 WHERE id = $1`,
+		id).
+		Scan(&orderSchema.id, &orderSchema.customerId, &orderSchema.status, &orderSchema.paymentId, &orderSchema.couponCode,
+			&orderSchema.discount, &orderSchema.currency, &orderSchema.taxRegion, &orderSchema.shippingMethod, &orderSchema.shippingCost,
+			&orderSchema.shippingAddress.RecipientName, &orderSchema.shippingAddress.Line1, &orderSchema.shippingAddress.Line2,
+			&orderSchema.shippingAddress.City, &orderSchema.shippingAddress.Region, &orderSchema.shippingAddress.PostalCode,
+			&orderSchema.shippingAddress.Country)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	currency, err := models.NewCurrency(orderSchema.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := o.Conn.Query(ctx,
+		`SELECT id, product_id, quantity, unit_price, tax_class, tax_rate, tax_inclusive, tax
+		 FROM order_lines
+		 WHERE order_id = $1
+		 ORDER BY created_at, id`, orderSchema.id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	orderLines := []order.OrderLine{}
+	for rows.Next() {
+		var orderLineSchema OrderLineSchema
+		err := rows.Scan(&orderLineSchema.id, &orderLineSchema.productId, &orderLineSchema.quantity, &orderLineSchema.unitPrice,
+			&orderLineSchema.taxClass, &orderLineSchema.taxRate, &orderLineSchema.taxInclusive, &orderLineSchema.tax)
+
+		if err != nil {
+			return nil, err
+		}
+
+		orderLines = append(orderLines, order.OrderLine{
+			Id:        orderLineSchema.id,
+			ProductId: orderLineSchema.productId,
+			Quantity: models.Quantity{
+				Value: orderLineSchema.quantity,
+			},
+			UnitPrice: models.Money{
+				Value:    orderLineSchema.unitPrice,
+				Currency: currency,
+			},
+			TaxClass:           orderLineSchema.taxClass,
+			TaxRateBasisPoints: orderLineSchema.taxRate,
+			TaxInclusive:       orderLineSchema.taxInclusive,
+			Tax: models.Money{
+				Value:    orderLineSchema.tax,
+				Currency: currency,
+			},
+		})
+	}
+
+	rows, err = o.Conn.Query(ctx,
+		`SELECT id, from_status, to_status, actor, changed_at
+		 FROM order_status_history
+		 WHERE order_id = $1
+		 ORDER BY changed_at`, orderSchema.id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statusHistory := []order.OrderStatusChange{}
+	for rows.Next() {
+		var statusChangeSchema OrderStatusChangeSchema
+		err := rows.Scan(&statusChangeSchema.id, &statusChangeSchema.fromStatus, &statusChangeSchema.toStatus,
+			&statusChangeSchema.actor, &statusChangeSchema.changedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statusHistory = append(statusHistory, order.OrderStatusChange{
+			Id:        statusChangeSchema.id,
+			From:      order.OrderStatus(statusChangeSchema.fromStatus),
+			To:        order.OrderStatus(statusChangeSchema.toStatus),
+			Actor:     statusChangeSchema.actor,
+			ChangedAt: statusChangeSchema.changedAt,
+		})
+	}
+
+	return &order.Order{
+		Id:            orderSchema.id,
+		CustomerId:    orderSchema.customerId,
+		Status:        order.OrderStatus(orderSchema.status),
+		Lines:         orderLines,
+		StatusHistory: statusHistory,
+		PaymentId:     orderSchema.paymentId,
+		CouponCode:    orderSchema.couponCode,
+		Discount: models.Money{
+			Value:    orderSchema.discount,
+			Currency: currency,
+		},
+		TaxRegion:      orderSchema.taxRegion,
+		ShippingMethod: orderSchema.shippingMethod,
+		ShippingCost: models.Money{
+			Value:    orderSchema.shippingCost,
+			Currency: currency,
+		},
+		ShippingAddress: orderSchema.shippingAddress,
+	}, nil
+}
@@ -0,0 +1,581 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	applicationrepositories "github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/order"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type OrderRepositorySuite struct {
+	conn              *pgx.Conn
+	orderRepository   repositories.OrderRepository
+	postgresContainer testcontainers.Container
+	suite.Suite
+}
+
+func (o *OrderRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	o.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	o.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	o.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS customers (
+			id UUID PRIMARY KEY,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS products (
+			id UUID PRIMARY KEY,
+			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS carts (
+			id UUID PRIMARY KEY,
+			customer_id UUID UNIQUE,
+			total_price INTEGER NOT NULL,
+			total_quantity INTEGER NOT NULL,
+			coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+			shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+			version INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS cart_items (
+			id UUID PRIMARY KEY,
+			cart_id UUID NOT NULL,
+			product_id UUID NOT NULL,
+			quantity INTEGER NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (cart_id) REFERENCES carts (id),
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS orders (
+			id UUID PRIMARY KEY,
+			customer_id UUID NOT NULL,
+			status VARCHAR(32) NOT NULL,
+			payment_id VARCHAR(255) NOT NULL DEFAULT '',
+			coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+			discount INTEGER NOT NULL DEFAULT 0,
+			total_price INTEGER NOT NULL,
+			total_quantity INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_region VARCHAR(64) NOT NULL DEFAULT '',
+			total_tax INTEGER NOT NULL DEFAULT 0,
+			shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+			shipping_cost INTEGER NOT NULL DEFAULT 0,
+			shipping_recipient_name VARCHAR(255) NOT NULL DEFAULT '',
+			shipping_line1 VARCHAR(255) NOT NULL DEFAULT '',
+			shipping_line2 VARCHAR(255) NOT NULL DEFAULT '',
+			shipping_city VARCHAR(128) NOT NULL DEFAULT '',
+			shipping_region VARCHAR(64) NOT NULL DEFAULT '',
+			shipping_postal_code VARCHAR(16) NOT NULL DEFAULT '',
+			shipping_country CHAR(2) NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (customer_id) REFERENCES customers (id)
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS order_lines (
+			id UUID PRIMARY KEY,
+			order_id UUID NOT NULL,
+			product_id UUID NOT NULL,
+			quantity INTEGER NOT NULL,
+			unit_price INTEGER NOT NULL,
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			tax_rate INTEGER NOT NULL DEFAULT 0,
+			tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+			tax INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (order_id) REFERENCES orders (id),
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS order_status_history (
+			id UUID PRIMARY KEY,
+			order_id UUID NOT NULL,
+			from_status VARCHAR(32) NOT NULL,
+			to_status VARCHAR(32) NOT NULL,
+			actor VARCHAR(255) NOT NULL,
+			changed_at TIMESTAMPTZ NOT NULL,
+			FOREIGN KEY (order_id) REFERENCES orders (id)
+		)
+	`)
+	o.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS promotions (
+			id UUID PRIMARY KEY,
+			code VARCHAR(64) NOT NULL UNIQUE,
+			type VARCHAR(32) NOT NULL,
+			usage_limit INTEGER NOT NULL DEFAULT 0,
+			times_used INTEGER NOT NULL DEFAULT 0
+		)
+	`)
+	o.Require().NoError(err)
+
+	o.conn = conn
+	o.postgresContainer = postgresContainer
+	o.orderRepository = repositories.OrderRepository{
+		Conn: conn,
+	}
+}
+
+func (o *OrderRepositorySuite) TearDownTest() {
+	o.postgresContainer.Terminate(context.Background())
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_CreateFromCart_OnSuccess_CreatesOrderAndEmptiesCart() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	cartId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		cartId, customerId, 12000, 3)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO cart_items (id, cart_id, product_id, quantity) VALUES ($1, $2, $3, $4)",
+		uuid.New(), cartId, productId, 3)
+	o.Require().NoError(err)
+
+	orderLine := order.OrderLine{
+		Id:        uuid.New(),
+		ProductId: productId,
+		Quantity:  models.Quantity{Value: 3},
+		UnitPrice: models.Money{Value: 4000},
+	}
+	newOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines:      []order.OrderLine{orderLine},
+		PaymentId:  "auth_0b5cd4a4",
+	}
+	checkedOutCart := cart.Cart{
+		Id:         cartId,
+		CustomerId: customerId,
+		Items:      []cart.CartItem{},
+	}
+
+	err = o.orderRepository.CreateFromCart(context.Background(), newOrder, checkedOutCart)
+	o.Require().NoError(err)
+
+	orderSchema := struct {
+		customerId    uuid.UUID
+		status        string
+		paymentId     string
+		totalPrice    int64
+		totalQuantity int32
+	}{}
+	err = o.conn.QueryRow(ctx, "SELECT customer_id, status, payment_id, total_price, total_quantity FROM orders WHERE id = $1", newOrder.Id).
+		Scan(&orderSchema.customerId, &orderSchema.status, &orderSchema.paymentId, &orderSchema.totalPrice, &orderSchema.totalQuantity)
+	o.Require().NoError(err)
+
+	o.Equal(customerId, orderSchema.customerId)
+	o.Equal("PENDING_PAYMENT", orderSchema.status)
+	o.Equal("auth_0b5cd4a4", orderSchema.paymentId)
+	o.Equal(int64(12000), orderSchema.totalPrice)
+	o.Equal(int32(3), orderSchema.totalQuantity)
+
+	orderLineSchema := struct {
+		id        uuid.UUID
+		productId uuid.UUID
+		quantity  int32
+		unitPrice int64
+	}{}
+	err = o.conn.QueryRow(ctx, "SELECT id, product_id, quantity, unit_price FROM order_lines WHERE order_id = $1", newOrder.Id).
+		Scan(&orderLineSchema.id, &orderLineSchema.productId, &orderLineSchema.quantity, &orderLineSchema.unitPrice)
+	o.Require().NoError(err)
+
+	o.Equal(orderLine.Id, orderLineSchema.id)
+	o.Equal(productId, orderLineSchema.productId)
+	o.Equal(int32(3), orderLineSchema.quantity)
+	o.Equal(int64(4000), orderLineSchema.unitPrice)
+
+	var cartItemsCount int
+	err = o.conn.QueryRow(ctx, "SELECT COUNT(*) FROM cart_items WHERE cart_id = $1", cartId).Scan(&cartItemsCount)
+	o.Require().NoError(err)
+	o.Equal(0, cartItemsCount)
+
+	cartSchema := struct {
+		totalPrice    int64
+		totalQuantity int32
+	}{}
+	err = o.conn.QueryRow(ctx, "SELECT total_price, total_quantity FROM carts WHERE id = $1", cartId).
+		Scan(&cartSchema.totalPrice, &cartSchema.totalQuantity)
+	o.Require().NoError(err)
+	o.Equal(int64(0), cartSchema.totalPrice)
+	o.Equal(int32(0), cartSchema.totalQuantity)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_CreateFromCart_OnTaxedOrder_PersistsFrozenTax() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	cartId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		cartId, customerId, 8000, 2)
+	o.Require().NoError(err)
+
+	newOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines: []order.OrderLine{
+			{
+				Id:                 uuid.New(),
+				ProductId:          productId,
+				Quantity:           models.Quantity{Value: 2},
+				UnitPrice:          models.Money{Value: 4000, Currency: models.USD},
+				TaxClass:           "standard",
+				TaxRateBasisPoints: 888,
+				TaxInclusive:       false,
+				Tax:                models.Money{Value: 710, Currency: models.USD},
+			},
+		},
+		PaymentId: "auth_0b5cd4a4",
+		TaxRegion: "NY",
+	}
+
+	err = o.orderRepository.CreateFromCart(context.Background(), newOrder, cart.Cart{Id: cartId, CustomerId: customerId, Items: []cart.CartItem{}})
+	o.Require().NoError(err)
+
+	var totalTax int64
+	err = o.conn.QueryRow(ctx, "SELECT total_tax FROM orders WHERE id = $1", newOrder.Id).Scan(&totalTax)
+	o.Require().NoError(err)
+	o.Equal(int64(710), totalTax)
+
+	sut, err := o.orderRepository.FindOneById(context.Background(), newOrder.Id)
+	o.Require().NoError(err)
+	o.Equal("NY", sut.TaxRegion)
+	o.Equal("standard", sut.Lines[0].TaxClass)
+	o.Equal(int64(888), sut.Lines[0].TaxRateBasisPoints)
+	o.Equal(false, sut.Lines[0].TaxInclusive)
+	o.Equal(models.Money{Value: 710, Currency: models.USD}, sut.Lines[0].Tax)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_CreateFromCart_OnShippingAddress_PersistsAddressSnapshot() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	cartId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		cartId, customerId, 4000, 1)
+	o.Require().NoError(err)
+
+	shippingAddress := order.OrderAddress{
+		RecipientName: "John Doe",
+		Line1:         "Av. Paulista, 1000",
+		Line2:         "Apt 12",
+		City:          "São Paulo",
+		Region:        "SP",
+		PostalCode:    "01310-100",
+		Country:       "BR",
+	}
+	newOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines: []order.OrderLine{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 1},
+				UnitPrice: models.Money{Value: 4000},
+			},
+		},
+		PaymentId:       "auth_0b5cd4a4",
+		ShippingAddress: shippingAddress,
+	}
+
+	err = o.orderRepository.CreateFromCart(context.Background(), newOrder, cart.Cart{Id: cartId, CustomerId: customerId, Items: []cart.CartItem{}})
+	o.Require().NoError(err)
+
+	sut, err := o.orderRepository.FindOneById(context.Background(), newOrder.Id)
+	o.Require().NoError(err)
+	o.Equal(shippingAddress, sut.ShippingAddress)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_CreateFromCart_OnCoupon_RedeemsCouponAndStoresDiscount() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	cartId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity, coupon_code) VALUES ($1, $2, $3, $4, $5)",
+		cartId, customerId, 8000, 2, "SUMMER10")
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO promotions (id, code, type, usage_limit, times_used) VALUES ($1, $2, $3, $4, $5)",
+		uuid.New(), "SUMMER10", "PERCENTAGE_OFF", 10, 4)
+	o.Require().NoError(err)
+
+	newOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines: []order.OrderLine{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 2},
+				UnitPrice: models.Money{Value: 4000},
+			},
+		},
+		StatusHistory: []order.OrderStatusChange{},
+		PaymentId:     "auth_0b5cd4a4",
+		CouponCode:    "SUMMER10",
+		Discount:      models.Money{Value: 800},
+	}
+	checkedOutCart := cart.Cart{
+		Id:         cartId,
+		CustomerId: customerId,
+		Items:      []cart.CartItem{},
+	}
+
+	err = o.orderRepository.CreateFromCart(context.Background(), newOrder, checkedOutCart)
+	o.Require().NoError(err)
+
+	sut, err := o.orderRepository.FindOneById(context.Background(), newOrder.Id)
+	o.Require().NoError(err)
+	o.Equal("SUMMER10", sut.CouponCode)
+	o.Equal(int64(800), sut.Discount.Value)
+
+	var timesUsed int32
+	err = o.conn.QueryRow(ctx, "SELECT times_used FROM promotions WHERE code = $1", "SUMMER10").Scan(&timesUsed)
+	o.Require().NoError(err)
+	o.Equal(int32(5), timesUsed)
+
+	var couponCode string
+	err = o.conn.QueryRow(ctx, "SELECT coupon_code FROM carts WHERE id = $1", cartId).Scan(&couponCode)
+	o.Require().NoError(err)
+	o.Equal("", couponCode)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_CreateFromCart_OnCouponUsageLimitReached_ReturnsErrorAndRollsBack() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	cartId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO carts (id, customer_id, total_price, total_quantity) VALUES ($1, $2, $3, $4)",
+		cartId, customerId, 4000, 1)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO promotions (id, code, type, usage_limit, times_used) VALUES ($1, $2, $3, $4, $5)",
+		uuid.New(), "SUMMER10", "PERCENTAGE_OFF", 10, 10)
+	o.Require().NoError(err)
+
+	newOrder := order.Order{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines: []order.OrderLine{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 1},
+				UnitPrice: models.Money{Value: 4000},
+			},
+		},
+		CouponCode: "SUMMER10",
+		Discount:   models.Money{Value: 400},
+	}
+
+	err = o.orderRepository.CreateFromCart(context.Background(), newOrder, cart.Cart{Id: cartId, CustomerId: customerId, Items: []cart.CartItem{}})
+
+	o.EqualError(err, "coupon usage limit reached")
+	sut, err := o.orderRepository.FindOneById(context.Background(), newOrder.Id)
+	o.Require().NoError(err)
+	o.Nil(sut)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_Update_OnStatusChanges_PersistsStatusAndHistory() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	orderId := uuid.New()
+	orderLineId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO orders (id, customer_id, status, payment_id, total_price, total_quantity) VALUES ($1, $2, $3, $4, $5, $6)",
+		orderId, customerId, "PENDING_PAYMENT", "auth_0b5cd4a4", 8000, 2)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO order_lines (id, order_id, product_id, quantity, unit_price) VALUES ($1, $2, $3, $4, $5)",
+		orderLineId, orderId, productId, 2, 4000)
+	o.Require().NoError(err)
+
+	existingOrder := order.Order{
+		Id:         orderId,
+		CustomerId: customerId,
+		Status:     order.PendingPayment,
+		Lines: []order.OrderLine{
+			{
+				Id:        orderLineId,
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 2},
+				UnitPrice: models.Money{Value: 4000},
+			},
+		},
+		StatusHistory: []order.OrderStatusChange{},
+		PaymentId:     "auth_0b5cd4a4",
+	}
+	paidAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+	err = existingOrder.ChangeStatus(order.Paid, "payment-provider", paidAt)
+	o.Require().NoError(err)
+	err = o.orderRepository.Update(context.Background(), existingOrder, order.PendingPayment)
+	o.Require().NoError(err)
+	err = existingOrder.ChangeStatus(order.Fulfilling, "admin", paidAt.Add(time.Hour))
+	o.Require().NoError(err)
+	err = o.orderRepository.Update(context.Background(), existingOrder, order.Paid)
+	o.Require().NoError(err)
+
+	sut, err := o.orderRepository.FindOneById(context.Background(), orderId)
+	o.Require().NoError(err)
+
+	o.Equal(order.Fulfilling, sut.Status)
+	o.Equal("auth_0b5cd4a4", sut.PaymentId)
+	o.Equal(existingOrder.Lines, sut.Lines)
+	o.Require().Equal(2, len(sut.StatusHistory))
+	o.Equal(existingOrder.StatusHistory[0].Id, sut.StatusHistory[0].Id)
+	o.Equal(order.PendingPayment, sut.StatusHistory[0].From)
+	o.Equal(order.Paid, sut.StatusHistory[0].To)
+	o.Equal("payment-provider", sut.StatusHistory[0].Actor)
+	o.True(paidAt.Equal(sut.StatusHistory[0].ChangedAt))
+	o.Equal(order.Paid, sut.StatusHistory[1].From)
+	o.Equal(order.Fulfilling, sut.StatusHistory[1].To)
+	o.Equal("admin", sut.StatusHistory[1].Actor)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_Update_OnStaleStatus_ReturnsConflictAndKeepsStatus() {
+	ctx := context.Background()
+	customerId := uuid.New()
+	productId := uuid.New()
+	orderId := uuid.New()
+	orderLineId := uuid.New()
+	_, err := o.conn.Exec(ctx, "INSERT INTO customers (id) VALUES ($1)", customerId)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 4000)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO orders (id, customer_id, status, payment_id, total_price, total_quantity) VALUES ($1, $2, $3, $4, $5, $6)",
+		orderId, customerId, "REFUNDED", "auth_0b5cd4a4", 8000, 2)
+	o.Require().NoError(err)
+	_, err = o.conn.Exec(ctx, "INSERT INTO order_lines (id, order_id, product_id, quantity, unit_price) VALUES ($1, $2, $3, $4, $5)",
+		orderLineId, orderId, productId, 2, 4000)
+	o.Require().NoError(err)
+
+	staleOrder := order.Order{
+		Id:         orderId,
+		CustomerId: customerId,
+		Status:     order.Paid,
+		Lines: []order.OrderLine{
+			{
+				Id:        orderLineId,
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 2},
+				UnitPrice: models.Money{Value: 4000},
+			},
+		},
+		StatusHistory: []order.OrderStatusChange{},
+		PaymentId:     "auth_0b5cd4a4",
+	}
+	err = staleOrder.ChangeStatus(order.Refunded, "customer", time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	o.Require().NoError(err)
+
+	err = o.orderRepository.Update(ctx, staleOrder, order.Paid)
+
+	o.ErrorIs(err, applicationrepositories.ErrOrderStatusConflict)
+	sut, err := o.orderRepository.FindOneById(ctx, orderId)
+	o.Require().NoError(err)
+	o.Equal(order.Refunded, sut.Status)
+	o.Empty(sut.StatusHistory)
+}
+
+func (o *OrderRepositorySuite) TestOrderRepository_FindOneById_OnOrderNotExists_ReturnsNil() {
+	sut, err := o.orderRepository.FindOneById(context.Background(), uuid.New())
+
+	o.NoError(err)
+	o.Nil(sut)
+}
+
+func TestOrderRepository(t *testing.T) {
+	suite.Run(t, new(OrderRepositorySuite))
+}
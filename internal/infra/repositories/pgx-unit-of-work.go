@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/gateways"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PgxUnitOfWork struct {
+	Pool *pgxpool.Pool
+}
+
+func (p *PgxUnitOfWork) Execute(ctx context.Context, work func(repositories repositories.UnitOfWorkRepositories) error) error {
+	transaction, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	err = work(repositories.UnitOfWorkRepositories{
+		CartRepository:        &CartRepository{Conn: transaction},
+		OrderRepository:       &OrderRepository{Conn: transaction},
+		ProductRepository:     &ProductRepository{Conn: transaction},
+		PromotionRepository:   &PromotionRepository{Conn: transaction},
+		AddressBookRepository: &AddressBookRepository{Conn: transaction},
+		InventoryGateway:      &gateways.InventoryGateway{Conn: transaction},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
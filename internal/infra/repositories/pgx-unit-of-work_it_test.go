@@ -0,0 +1,210 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	applicationrepositories "github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/cart"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type PgxUnitOfWorkSuite struct {
+	pool              *pgxpool.Pool
+	unitOfWork        repositories.PgxUnitOfWork
+	postgresContainer testcontainers.Container
+	suite.Suite
+}
+
+func (p *PgxUnitOfWorkSuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	p.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	p.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	p.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	pool, err := pgxpool.New(ctx, postgresUrl)
+	p.Require().NoError(err)
+
+	_, err = pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS products (
+			id UUID PRIMARY KEY,
+			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	p.Require().NoError(err)
+
+	_, err = pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS carts (
+			id UUID PRIMARY KEY,
+			customer_id UUID UNIQUE,
+			total_price INTEGER NOT NULL,
+			total_quantity INTEGER NOT NULL,
+			coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+			shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+			version INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	p.Require().NoError(err)
+
+	_, err = pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS cart_items (
+			id UUID PRIMARY KEY,
+			cart_id UUID NOT NULL,
+			product_id UUID NOT NULL,
+			quantity INTEGER NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (cart_id) REFERENCES carts (id),
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	p.Require().NoError(err)
+
+	p.pool = pool
+	p.postgresContainer = postgresContainer
+	p.unitOfWork = repositories.PgxUnitOfWork{
+		Pool: pool,
+	}
+}
+
+func (p *PgxUnitOfWorkSuite) TearDownTest() {
+	p.pool.Close()
+	p.postgresContainer.Terminate(context.Background())
+}
+
+func (p *PgxUnitOfWorkSuite) newCart(productId uuid.UUID) cart.Cart {
+	return cart.Cart{
+		Id:         uuid.New(),
+		CustomerId: uuid.New(),
+		Items: []cart.CartItem{
+			{
+				Id:        uuid.New(),
+				ProductId: productId,
+				Quantity:  models.Quantity{Value: 2},
+				Price:     models.Money{Value: 2550},
+			},
+		},
+	}
+}
+
+func (p *PgxUnitOfWorkSuite) countRows(table string) int {
+	var count int
+	err := p.pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM "+table).Scan(&count)
+	p.Require().NoError(err)
+
+	return count
+}
+
+func (p *PgxUnitOfWorkSuite) TestPgxUnitOfWork_Execute_OnNoErrors_CommitsEveryWrite() {
+	ctx := context.Background()
+	productId := uuid.New()
+	_, err := p.pool.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	p.Require().NoError(err)
+
+	err = p.unitOfWork.Execute(ctx, func(transaction applicationrepositories.UnitOfWorkRepositories) error {
+		err := transaction.CartRepository.Create(ctx, p.newCart(productId))
+		if err != nil {
+			return err
+		}
+
+		return transaction.CartRepository.Create(ctx, p.newCart(productId))
+	})
+
+	p.Require().NoError(err)
+	p.Equal(2, p.countRows("carts"))
+	p.Equal(2, p.countRows("cart_items"))
+}
+
+func (p *PgxUnitOfWorkSuite) TestPgxUnitOfWork_Execute_OnWorkError_RollsBackEveryWrite() {
+	ctx := context.Background()
+	productId := uuid.New()
+	_, err := p.pool.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	p.Require().NoError(err)
+
+	err = p.unitOfWork.Execute(ctx, func(transaction applicationrepositories.UnitOfWorkRepositories) error {
+		err := transaction.CartRepository.Create(ctx, p.newCart(productId))
+		if err != nil {
+			return err
+		}
+
+		return errors.New("stock reservation expired")
+	})
+
+	p.EqualError(err, "stock reservation expired")
+	p.Equal(0, p.countRows("carts"))
+	p.Equal(0, p.countRows("cart_items"))
+}
+
+func (p *PgxUnitOfWorkSuite) TestPgxUnitOfWork_Execute_OnRepositoryError_RollsBackEarlierWrites() {
+	ctx := context.Background()
+	productId := uuid.New()
+	_, err := p.pool.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	p.Require().NoError(err)
+
+	err = p.unitOfWork.Execute(ctx, func(transaction applicationrepositories.UnitOfWorkRepositories) error {
+		err := transaction.CartRepository.Create(ctx, p.newCart(productId))
+		if err != nil {
+			return err
+		}
+
+		return transaction.CartRepository.Create(ctx, p.newCart(uuid.New()))
+	})
+
+	p.Error(err)
+	p.Equal(0, p.countRows("carts"))
+}
+
+func (p *PgxUnitOfWorkSuite) TestPgxUnitOfWork_Execute_OnOpenTransaction_IsolatesWritesFromOtherQueries() {
+	ctx := context.Background()
+	productId := uuid.New()
+	_, err := p.pool.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 2550)
+	p.Require().NoError(err)
+
+	err = p.unitOfWork.Execute(ctx, func(transaction applicationrepositories.UnitOfWorkRepositories) error {
+		err := transaction.CartRepository.Create(ctx, p.newCart(productId))
+		if err != nil {
+			return err
+		}
+
+		p.Equal(0, p.countRows("carts"))
+		return nil
+	})
+
+	p.Require().NoError(err)
+	p.Equal(1, p.countRows("carts"))
+}
+
+func TestPgxUnitOfWork(t *testing.T) {
+	suite.Run(t, new(PgxUnitOfWorkSuite))
+}
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+)
+
+type ProductRepository struct {
+	Conn database.IConn
+}
+
+type productSchema struct {
+	id          uuid.UUID
+	name        string
+	description string
+	sku         string
+	price       int64
+	currency    string
+	taxClass    string
+	weightGrams int32
+	lengthCm    int32
+	widthCm     int32
+	heightCm    int32
+	active      bool
+}
+
+func (p *productSchema) toDomain() (product.Product, error) {
+	currency, err := models.NewCurrency(p.currency)
+	if err != nil {
+		return product.Product{}, err
+	}
+
+	return product.Product{
+		Id:          p.id,
+		Name:        p.name,
+		Description: p.description,
+		Sku:         p.sku,
+		Price: models.Money{
+			Value:    p.price,
+			Currency: currency,
+		},
+		TaxClass:    p.taxClass,
+		WeightGrams: p.weightGrams,
+		LengthCm:    p.lengthCm,
+		WidthCm:     p.widthCm,
+		HeightCm:    p.heightCm,
+		Active:      p.active,
+	}, nil
+}
+
+func (p *ProductRepository) Create(ctx context.Context, product product.Product) error {
+	_, err := p.Conn.Exec(ctx,
+		"INSERT INTO products (id, name, description, sku, price, currency, tax_class, weight_grams, length_cm, width_cm, height_cm, active) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+		product.Id.String(), product.Name, product.Description, product.Sku, product.Price.Value, product.Price.Currency.Code, product.TaxClass,
+		product.WeightGrams, product.LengthCm, product.WidthCm, product.HeightCm, product.Active)
+
+	return err
+}
+
+func (p *ProductRepository) Update(ctx context.Context, product product.Product) error {
+	_, err := p.Conn.Exec(ctx,
+		"UPDATE products SET name = $1, description = $2, sku = $3, price = $4, currency = $5, tax_class = $6, weight_grams = $7, "+
+			"length_cm = $8, width_cm = $9, height_cm = $10, active = $11 WHERE id = $12",
+		product.Name, product.Description, product.Sku, product.Price.Value, product.Price.Currency.Code, product.TaxClass, product.WeightGrams,
+		product.LengthCm, product.WidthCm, product.HeightCm, product.Active, product.Id.String())
+
+	return err
+}
+
+func (p *ProductRepository) FindOneById(ctx context.Context, id uuid.UUID) (*product.Product, error) {
+	return p.findOne(ctx, "SELECT id, name, description, sku, price, currency, tax_class, weight_grams, length_cm, width_cm, height_cm, active FROM products WHERE id = $1", id)
+}
+
+func (p *ProductRepository) FindOneBySku(ctx context.Context, sku string) (*product.Product, error) {
+	return p.findOne(ctx, "SELECT id, name, description, sku, price, currency, tax_class, weight_grams, length_cm, width_cm, height_cm, active FROM products WHERE sku = $1", sku)
+}
+
+func (p *ProductRepository) FindAll(ctx context.Context) ([]product.Product, error) {
+	rows, err := p.Conn.Query(ctx,
+		"SELECT id, name, description, sku, price, currency, tax_class, weight_grams, length_cm, width_cm, height_cm, active FROM products ORDER BY created_at, id")
+
+	if err != nil {
+		return nil, err
+	}
+
+	products := []product.Product{}
+	for rows.Next() {
+		var schema productSchema
+		err := rows.Scan(&schema.id, &schema.name, &schema.description, &schema.sku, &schema.price, &schema.currency, &schema.taxClass, &schema.weightGrams,
+			&schema.lengthCm, &schema.widthCm, &schema.heightCm, &schema.active)
+
+		if err != nil {
+			return nil, err
+		}
+
+		product, err := schema.toDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+func (p *ProductRepository) findOne(ctx context.Context, query string, argument any) (*product.Product, error) {
+	var schema productSchema
+	err := p.Conn.QueryRow(ctx, query, argument).
+		Scan(&schema.id, &schema.name, &schema.description, &schema.sku, &schema.price, &schema.currency, &schema.taxClass, &schema.weightGrams,
+			&schema.lengthCm, &schema.widthCm, &schema.heightCm, &schema.active)
+
+	if err == nil {
+		product, err := schema.toDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		return &product, nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return nil, err
+}
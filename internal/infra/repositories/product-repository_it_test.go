@@ -0,0 +1,149 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/product"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type ProductRepositorySuite struct {
+	conn              *pgx.Conn
+	productRepository repositories.ProductRepository
+	postgresContainer testcontainers.Container
+	suite.Suite
+}
+
+func (p *ProductRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	p.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	p.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	p.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	p.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS products (
+			id UUID PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			sku VARCHAR(64) NOT NULL UNIQUE,
+			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
+			active BOOLEAN NOT NULL DEFAULT TRUE,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	p.Require().NoError(err)
+
+	p.conn = conn
+	p.postgresContainer = postgresContainer
+	p.productRepository = repositories.ProductRepository{
+		Conn: conn,
+	}
+}
+
+func (p *ProductRepositorySuite) TearDownTest() {
+	p.postgresContainer.Terminate(context.Background())
+}
+
+func (p *ProductRepositorySuite) TestProductRepository_CreateAndFindOneById_OnSuccess_ReturnsProduct() {
+	newProduct := product.Product{
+		Id:          uuid.New(),
+		Name:        "Mechanical Keyboard",
+		Description: "Hot-swappable switches",
+		Sku:         "KB-001",
+		Price:       models.Money{Value: 45990},
+		Active:      true,
+	}
+
+	err := p.productRepository.Create(context.Background(), newProduct)
+	p.Require().NoError(err)
+
+	sut, err := p.productRepository.FindOneById(context.Background(), newProduct.Id)
+	p.Require().NoError(err)
+
+	p.Equal(newProduct, *sut)
+}
+
+func (p *ProductRepositorySuite) TestProductRepository_Update_OnSuccess_PersistsChanges() {
+	existingProduct := product.Product{
+		Id:     uuid.New(),
+		Name:   "Mechanical Keyboard",
+		Sku:    "KB-001",
+		Price:  models.Money{Value: 45990},
+		Active: true,
+	}
+	err := p.productRepository.Create(context.Background(), existingProduct)
+	p.Require().NoError(err)
+
+	existingProduct.Name = "Wireless Keyboard"
+	existingProduct.Price = models.Money{Value: 39990}
+	existingProduct.SetDimensions(1200, 45, 15, 4)
+	existingProduct.Active = false
+	err = p.productRepository.Update(context.Background(), existingProduct)
+	p.Require().NoError(err)
+
+	sut, err := p.productRepository.FindOneBySku(context.Background(), "KB-001")
+	p.Require().NoError(err)
+
+	p.Equal(existingProduct, *sut)
+}
+
+func (p *ProductRepositorySuite) TestProductRepository_FindAll_OnProductsExist_ReturnsProducts() {
+	firstProduct := product.Product{Id: uuid.New(), Name: "Keyboard", Sku: "KB-001", Price: models.Money{Value: 45990}, Active: true}
+	secondProduct := product.Product{Id: uuid.New(), Name: "Mouse", Sku: "MS-001", Price: models.Money{Value: 12990}, Active: false}
+	p.Require().NoError(p.productRepository.Create(context.Background(), firstProduct))
+	p.Require().NoError(p.productRepository.Create(context.Background(), secondProduct))
+
+	sut, err := p.productRepository.FindAll(context.Background())
+	p.Require().NoError(err)
+
+	p.ElementsMatch([]product.Product{firstProduct, secondProduct}, sut)
+}
+
+func (p *ProductRepositorySuite) TestProductRepository_FindOneById_OnProductNotExists_ReturnsNil() {
+	sut, err := p.productRepository.FindOneById(context.Background(), uuid.New())
+
+	p.NoError(err)
+	p.Nil(sut)
+}
+
+func TestProductRepository(t *testing.T) {
+	suite.Run(t, new(ProductRepositorySuite))
+}
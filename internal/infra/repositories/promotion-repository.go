@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+)
+
+type PromotionRepository struct {
+	Conn database.IConn
+}
+
+func (p *PromotionRepository) FindOneByCode(ctx context.Context, code string) (*promotion.Promotion, error) {
+	promotionSchema := struct {
+		id           uuid.UUID
+		code         string
+		promoType    string
+		percentOff   int32
+		amountOff    int64
+		buyQuantity  int32
+		getQuantity  int32
+		productId    *uuid.UUID
+		minimumSpend int64
+		currency     string
+		startsAt     time.Time
+		endsAt       time.Time
+		usageLimit   int32
+		timesUsed    int32
+	}{}
+
+	err := p.Conn.QueryRow(ctx,
+		`SELECT id, code, type, percent_off, amount_off, buy_quantity, get_quantity, product_id,
+		        minimum_spend, currency, starts_at, ends_at, usage_limit, times_used
+		 FROM promotions
+		 WHERE code = $1`, code).
+		Scan(&promotionSchema.id, &promotionSchema.code, &promotionSchema.promoType, &promotionSchema.percentOff,
+			&promotionSchema.amountOff, &promotionSchema.buyQuantity, &promotionSchema.getQuantity, &promotionSchema.productId,
+			&promotionSchema.minimumSpend, &promotionSchema.currency, &promotionSchema.startsAt, &promotionSchema.endsAt, &promotionSchema.usageLimit,
+			&promotionSchema.timesUsed)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	currency, err := models.NewCurrency(promotionSchema.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	productId := uuid.Nil
+	if promotionSchema.productId != nil {
+		productId = *promotionSchema.productId
+	}
+
+	return &promotion.Promotion{
+		Id:         promotionSchema.id,
+		Code:       promotionSchema.code,
+		Type:       promotion.PromotionType(promotionSchema.promoType),
+		PercentOff: promotionSchema.percentOff,
+		AmountOff: models.Money{
+			Value:    promotionSchema.amountOff,
+			Currency: currency,
+		},
+		BuyQuantity: promotionSchema.buyQuantity,
+		GetQuantity: promotionSchema.getQuantity,
+		ProductId:   productId,
+		MinimumSpend: models.Money{
+			Value:    promotionSchema.minimumSpend,
+			Currency: currency,
+		},
+		StartsAt:   promotionSchema.startsAt,
+		EndsAt:     promotionSchema.endsAt,
+		UsageLimit: promotionSchema.usageLimit,
+		TimesUsed:  promotionSchema.timesUsed,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/promotion"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type PromotionRepositorySuite struct {
+	conn                *pgx.Conn
+	promotionRepository repositories.PromotionRepository
+	postgresContainer   testcontainers.Container
+	suite.Suite
+}
+
+func (p *PromotionRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	p.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	p.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	p.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	p.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS products (
+			id UUID PRIMARY KEY,
+			price INTEGER NOT NULL,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+			weight_grams INTEGER NOT NULL DEFAULT 0,
+			length_cm INTEGER NOT NULL DEFAULT 0,
+			width_cm INTEGER NOT NULL DEFAULT 0,
+			height_cm INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	p.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS promotions (
+			id UUID PRIMARY KEY,
+			code VARCHAR(64) NOT NULL UNIQUE,
+			type VARCHAR(32) NOT NULL,
+			percent_off INTEGER NOT NULL DEFAULT 0,
+			amount_off INTEGER NOT NULL DEFAULT 0,
+			buy_quantity INTEGER NOT NULL DEFAULT 0,
+			get_quantity INTEGER NOT NULL DEFAULT 0,
+			product_id UUID,
+			minimum_spend INTEGER NOT NULL DEFAULT 0,
+			currency CHAR(3) NOT NULL DEFAULT 'BRL',
+			starts_at TIMESTAMPTZ NOT NULL,
+			ends_at TIMESTAMPTZ NOT NULL,
+			usage_limit INTEGER NOT NULL DEFAULT 0,
+			times_used INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (product_id) REFERENCES products (id)
+		)
+	`)
+	p.Require().NoError(err)
+
+	p.conn = conn
+	p.postgresContainer = postgresContainer
+	p.promotionRepository = repositories.PromotionRepository{
+		Conn: conn,
+	}
+}
+
+func (p *PromotionRepositorySuite) TearDownTest() {
+	p.postgresContainer.Terminate(context.Background())
+}
+
+func (p *PromotionRepositorySuite) TestPromotionRepository_FindOneByCode_OnBuyXGetYPromotion_ReturnsPromotion() {
+	ctx := context.Background()
+	promotionId := uuid.New()
+	productId := uuid.New()
+	startsAt := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	_, err := p.conn.Exec(ctx, "INSERT INTO products (id, price) VALUES ($1, $2)", productId, 1000)
+	p.Require().NoError(err)
+	_, err = p.conn.Exec(ctx,
+		`INSERT INTO promotions (id, code, type, buy_quantity, get_quantity, product_id, minimum_spend, starts_at, ends_at, usage_limit, times_used)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		promotionId, "BUY2GET1", "BUY_X_GET_Y", 2, 1, productId, 3000, startsAt, endsAt, 50, 7)
+	p.Require().NoError(err)
+
+	sut, err := p.promotionRepository.FindOneByCode(context.Background(), "BUY2GET1")
+	p.Require().NoError(err)
+
+	p.Equal(promotionId, sut.Id)
+	p.Equal(promotion.BuyXGetY, sut.Type)
+	p.Equal(int32(2), sut.BuyQuantity)
+	p.Equal(int32(1), sut.GetQuantity)
+	p.Equal(productId, sut.ProductId)
+	p.Equal(models.Money{Value: 3000}, sut.MinimumSpend)
+	p.True(startsAt.Equal(sut.StartsAt))
+	p.True(endsAt.Equal(sut.EndsAt))
+	p.Equal(int32(50), sut.UsageLimit)
+	p.Equal(int32(7), sut.TimesUsed)
+}
+
+func (p *PromotionRepositorySuite) TestPromotionRepository_FindOneByCode_OnPromotionWithoutProduct_ReturnsNilProductId() {
+	ctx := context.Background()
+	_, err := p.conn.Exec(ctx,
+		`INSERT INTO promotions (id, code, type, percent_off, starts_at, ends_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		uuid.New(), "SUMMER10", "PERCENTAGE_OFF", 10, time.Now(), time.Now().Add(time.Hour))
+	p.Require().NoError(err)
+
+	sut, err := p.promotionRepository.FindOneByCode(context.Background(), "SUMMER10")
+	p.Require().NoError(err)
+
+	p.Equal(promotion.PercentageOff, sut.Type)
+	p.Equal(int32(10), sut.PercentOff)
+	p.Equal(uuid.Nil, sut.ProductId)
+}
+
+func (p *PromotionRepositorySuite) TestPromotionRepository_FindOneByCode_OnPromotionNotExists_ReturnsNil() {
+	sut, err := p.promotionRepository.FindOneByCode(context.Background(), "UNKNOWN")
+
+	p.NoError(err)
+	p.Nil(sut)
+}
+
+func TestPromotionRepository(t *testing.T) {
+	suite.Run(t, new(PromotionRepositorySuite))
+}
@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/application/repositories"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/database"
+	"github.com/jackc/pgx/v5"
+)
+
+type RefreshTokenRepository struct {
+	Conn database.IConn
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, refreshToken customer.RefreshToken) error {
+	_, err := r.Conn.Exec(ctx,
+		`INSERT INTO refresh_tokens (id, customer_id, family_id, token_hash, expires_at, revoked_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		refreshToken.Id.String(), refreshToken.CustomerId.String(), refreshToken.FamilyId.String(), refreshToken.TokenHash,
+		refreshToken.ExpiresAt, refreshToken.RevokedAt)
+
+	return err
+}
+
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, current customer.RefreshToken, next customer.RefreshToken) error {
+	transaction, err := r.Conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer transaction.Rollback(ctx)
+
+	commandTag, err := transaction.Exec(ctx, "UPDATE refresh_tokens SET revoked_at = $1 WHERE id = $2 AND revoked_at IS NULL",
+		current.RevokedAt, current.Id.String())
+
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return repositories.ErrRefreshTokenReused
+	}
+
+	_, err = transaction.Exec(ctx,
+		`INSERT INTO refresh_tokens (id, customer_id, family_id, token_hash, expires_at, revoked_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		next.Id.String(), next.CustomerId.String(), next.FamilyId.String(), next.TokenHash, next.ExpiresAt, next.RevokedAt)
+
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, revokedAt time.Time) error {
+	_, err := r.Conn.Exec(ctx,
+		"UPDATE refresh_tokens SET revoked_at = $1 WHERE family_id = $2 AND revoked_at IS NULL", revokedAt, familyId.String())
+
+	return err
+}
+
+func (r *RefreshTokenRepository) FindOneByTokenHash(ctx context.Context, tokenHash string) (*customer.RefreshToken, error) {
+	type RefreshTokenSchema struct {
+		id         uuid.UUID
+		customerId uuid.UUID
+		familyId   uuid.UUID
+		tokenHash  string
+		expiresAt  time.Time
+		revokedAt  *time.Time
+	}
+
+	var refreshTokenSchema RefreshTokenSchema
+	err := r.Conn.QueryRow(ctx,
+		"SELECT id, customer_id, family_id, token_hash, expires_at, revoked_at FROM refresh_tokens WHERE token_hash = $1", tokenHash).
+		Scan(&refreshTokenSchema.id, &refreshTokenSchema.customerId, &refreshTokenSchema.familyId, &refreshTokenSchema.tokenHash,
+			&refreshTokenSchema.expiresAt, &refreshTokenSchema.revokedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &customer.RefreshToken{
+		Id:         refreshTokenSchema.id,
+		CustomerId: refreshTokenSchema.customerId,
+		FamilyId:   refreshTokenSchema.familyId,
+		TokenHash:  refreshTokenSchema.tokenHash,
+		ExpiresAt:  refreshTokenSchema.expiresAt,
+		RevokedAt:  refreshTokenSchema.revokedAt,
+	}, nil
+}
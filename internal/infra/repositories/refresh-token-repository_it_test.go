@@ -0,0 +1,172 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/models/customer"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type RefreshTokenRepositorySuite struct {
+	conn                   *pgx.Conn
+	refreshTokenRepository repositories.RefreshTokenRepository
+	postgresContainer      testcontainers.Container
+	suite.Suite
+}
+
+func (r *RefreshTokenRepositorySuite) SetupTest() {
+	ctx := context.Background()
+	os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:latest",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "postgres",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+	})
+
+	r.Require().NoError(err)
+
+	host, err := postgresContainer.Host(ctx)
+	r.Require().NoError(err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	r.Require().NoError(err)
+
+	postgresUrl := fmt.Sprintf("postgres://postgres:postgres@%s:%s/postgres", host, port.Port())
+	conn, err := pgx.Connect(ctx, postgresUrl)
+	r.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS customers (
+			id UUID PRIMARY KEY,
+			email VARCHAR(320) UNIQUE,
+			password_hash VARCHAR(255) NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	r.Require().NoError(err)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS refresh_tokens (
+			id UUID PRIMARY KEY,
+			customer_id UUID NOT NULL,
+			family_id UUID NOT NULL,
+			token_hash CHAR(64) NOT NULL UNIQUE,
+			expires_at TIMESTAMPTZ NOT NULL,
+			revoked_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (customer_id) REFERENCES customers (id)
+		)
+	`)
+	r.Require().NoError(err)
+
+	r.conn = conn
+	r.postgresContainer = postgresContainer
+	r.refreshTokenRepository = repositories.RefreshTokenRepository{
+		Conn: conn,
+	}
+}
+
+func (r *RefreshTokenRepositorySuite) TearDownTest() {
+	r.postgresContainer.Terminate(context.Background())
+}
+
+func (r *RefreshTokenRepositorySuite) insertCustomer() uuid.UUID {
+	customerId := uuid.New()
+	_, err := r.conn.Exec(context.Background(), "INSERT INTO customers (id) VALUES ($1)", customerId)
+	r.Require().NoError(err)
+	return customerId
+}
+
+func (r *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Create_OnNewToken_PersistsToken() {
+	refreshToken, rawToken, _ := customer.NewRefreshToken(r.insertCustomer(), uuid.New(), time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC))
+
+	err := r.refreshTokenRepository.Create(context.Background(), refreshToken)
+	r.Require().NoError(err)
+
+	sut, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(rawToken))
+	r.Require().NoError(err)
+	r.Equal(refreshToken.Id, sut.Id)
+	r.Equal(refreshToken.FamilyId, sut.FamilyId)
+	r.True(refreshToken.ExpiresAt.Equal(sut.ExpiresAt))
+	r.Nil(sut.RevokedAt)
+}
+
+func (r *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Rotate_OnActiveToken_RevokesCurrentAndStoresNext() {
+	customerId := r.insertCustomer()
+	familyId := uuid.New()
+	current, currentRawToken, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC))
+	r.Require().NoError(r.refreshTokenRepository.Create(context.Background(), current))
+	next, nextRawToken, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC))
+	current.Revoke(time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC))
+
+	err := r.refreshTokenRepository.Rotate(context.Background(), current, next)
+	r.Require().NoError(err)
+
+	revoked, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(currentRawToken))
+	r.Require().NoError(err)
+	r.NotNil(revoked.RevokedAt)
+	rotated, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(nextRawToken))
+	r.Require().NoError(err)
+	r.Equal(next.Id, rotated.Id)
+}
+
+func (r *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Rotate_OnAlreadyRevokedToken_ReturnsErrorAndRollsBack() {
+	customerId := r.insertCustomer()
+	familyId := uuid.New()
+	current, _, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC))
+	current.Revoke(time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC))
+	r.Require().NoError(r.refreshTokenRepository.Create(context.Background(), current))
+	next, nextRawToken, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC))
+
+	err := r.refreshTokenRepository.Rotate(context.Background(), current, next)
+
+	r.EqualError(err, "refresh token was reused")
+	sut, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(nextRawToken))
+	r.Require().NoError(err)
+	r.Nil(sut)
+}
+
+func (r *RefreshTokenRepositorySuite) TestRefreshTokenRepository_RevokeFamily_OnFamily_RevokesEveryActiveToken() {
+	customerId := r.insertCustomer()
+	familyId := uuid.New()
+	first, firstRawToken, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC))
+	second, secondRawToken, _ := customer.NewRefreshToken(customerId, familyId, time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC))
+	other, otherRawToken, _ := customer.NewRefreshToken(customerId, uuid.New(), time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC))
+	r.Require().NoError(r.refreshTokenRepository.Create(context.Background(), first))
+	r.Require().NoError(r.refreshTokenRepository.Create(context.Background(), second))
+	r.Require().NoError(r.refreshTokenRepository.Create(context.Background(), other))
+
+	err := r.refreshTokenRepository.RevokeFamily(context.Background(), familyId, time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC))
+	r.Require().NoError(err)
+
+	for _, rawToken := range []string{firstRawToken, secondRawToken} {
+		sut, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(rawToken))
+		r.Require().NoError(err)
+		r.NotNil(sut.RevokedAt)
+	}
+
+	sut, err := r.refreshTokenRepository.FindOneByTokenHash(context.Background(), customer.HashRefreshToken(otherRawToken))
+	r.Require().NoError(err)
+	r.Nil(sut.RevokedAt)
+}
+
+func TestRefreshTokenRepository(t *testing.T) {
+	suite.Run(t, new(RefreshTokenRepositorySuite))
+}
@@ -34,6 +34,10 @@ func (h *Validator) Validate(body interface{}) []string {
 				errorMessages = append(errorMessages, fmt.Sprintf("%s is required", field))
 			case "uuid4":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be uuidv4", field))
+			case "email":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be a valid email", field))
+			case "credit_card":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be a valid card number", field))
 			case "gte":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be greater than or equal to %s", field, param))
 			}
@@ -0,0 +1,106 @@
+package webhttp
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	"github.com/labstack/echo/v4"
+)
+
+var fieldErrorMessages = map[string]string{
+	"ADDRESS_RECIPIENT_NAME_EMPTY": "recipientName cannot be empty",
+	"ADDRESS_LINE_EMPTY":           "line1 cannot be empty",
+	"ADDRESS_CITY_EMPTY":           "city cannot be empty",
+	"ADDRESS_REGION_EMPTY":         "region is required for this country",
+	"ADDRESS_POSTAL_CODE_INVALID":  "postalCode is not valid for this country",
+	"COUPON_CODE_EMPTY":            "couponCode cannot be empty",
+	"CURRENCY_NOT_SUPPORTED":       "currency must be one of BRL, USD or EUR",
+	"CUSTOMER_EMAIL_INVALID":       "email must be a valid email",
+	"CUSTOMER_PASSWORD_TOO_SHORT":  "password must have at least 8 characters",
+	"CUSTOMER_PASSWORD_TOO_LONG":   "password must have at most 72 characters",
+	"PRODUCT_NAME_EMPTY":           "name cannot be empty",
+	"PRODUCT_SKU_EMPTY":            "sku cannot be empty",
+	"PRODUCT_TAX_CLASS_EMPTY":      "taxClass cannot be empty",
+	"PRODUCT_DIMENSIONS_NEGATIVE":  "dimensions cannot be negative",
+	"SHIPPING_METHOD_CODE_EMPTY":   "shippingMethod cannot be empty",
+	"TAX_COUNTRY_EMPTY":            "country is required when region is given",
+}
+
+var errorMessages = map[string]string{
+	"ADDRESS_BOOK_FULL":                   "You can save up to 10 addresses. Please remove one before adding a new address.",
+	"ADDRESS_COUNTRY_NOT_SUPPORTED":       "We don't deliver to this country yet. Please use another address.",
+	"ADDRESS_NOT_FOUND":                   "We couldn't find this address in your address book.",
+	"CART_CANNOT_MIX_CURRENCIES":          "This product is priced in a different currency than the products already in your cart.",
+	"CART_EMPTY":                          "Your cart is empty. Please add a product to your cart first.",
+	"CART_HAS_NO_COUPON":                  "There is no coupon applied to your cart.",
+	"CART_NOT_FOUND":                      "We couldn't find a cart for your account. Please add a product to your cart first.",
+	"CART_VERSION_CONFLICT":               "Your cart was changed by another request at the same time. Please try again.",
+	"COUPON_CURRENCY_MISMATCH":            "This coupon cannot be used with the currency of your cart.",
+	"COUPON_EXPIRED":                      "This coupon has expired.",
+	"COUPON_MINIMUM_SPEND_NOT_MET":        "Your cart does not meet the minimum spend required by this coupon.",
+	"COUPON_NOT_ACTIVE_YET":               "This coupon is not active yet. Please try again later.",
+	"COUPON_NOT_APPLICABLE":               "Your cart does not contain the products required by this coupon.",
+	"COUPON_NOT_FOUND":                    "We couldn't find this coupon. Please check the code and try again.",
+	"COUPON_USAGE_LIMIT_REACHED":          "This coupon has reached its usage limit.",
+	"CUSTOMER_EMAIL_ALREADY_IN_USE":       "An account with this email already exists. Please log in instead.",
+	"EXCHANGE_RATE_EXPIRED":               "We couldn't convert prices to this currency right now. Please try again later.",
+	"EXCHANGE_RATE_NOT_FOUND":             "We couldn't convert prices to this currency right now. Please try again later.",
+	"INSUFFICIENT_STOCK":                  "There is not enough stock for one of the products. Please review your cart and try again.",
+	"INVALID_CREDENTIALS":                 "Email or password is incorrect.",
+	"ORDER_CANNOT_MIX_CURRENCIES":         "The products in your cart are priced in different currencies. Please review your cart and try again.",
+	"ORDER_HAS_NO_PAYMENT":                "This order cannot be refunded.",
+	"ORDER_NOT_FOUND":                     "We couldn't find this order. Please check the order ID and try again.",
+	"ORDER_REFUND_REQUIRED":               "Paid orders can only be cancelled through the refund endpoint.",
+	"ORDER_STATUS_CONFLICT":               "This order was changed by another request at the same time. Please refresh the order and try again.",
+	"ORDER_STATUS_INVALID":                "This is not a valid order status.",
+	"ORDER_STATUS_TRANSITION_NOT_ALLOWED": "The order cannot be moved to this status from its current status.",
+	"PAYMENT_DECLINED":                    "Your payment was declined. Please use a different card and try again.",
+	"PAYMENT_PROVIDER_TIMEOUT":            "Our payment provider is not responding. Please try again in a few minutes.",
+	"PRODUCT_ALREADY_ARCHIVED":            "This product is already archived.",
+	"PRODUCT_NOT_FOUND":                   "We couldn't find this product. Please check the product ID and try again.",
+	"PRODUCT_NOT_IN_CART":                 "We couldn't find this product in your cart. Please check the product ID and try again.",
+	"PRODUCT_SKU_ALREADY_EXISTS":          "A product with this SKU already exists. Please use a different SKU.",
+	"REFRESH_TOKEN_EXPIRED":               "Refresh token has expired. Please log in again.",
+	"REFRESH_TOKEN_INVALID":               "Refresh token is invalid.",
+	"REFRESH_TOKEN_REUSED":                "Refresh token was already used. Please log in again.",
+	"SHIPPING_ADDRESS_REQUIRED":           "Please add a shipping address to your account or choose one before checking out.",
+	"SHIPPING_METHOD_CURRENCY_MISMATCH":   "This delivery method is not available for the currency of your cart. Please choose another method.",
+	"SHIPPING_METHOD_NOT_FOUND":           "We couldn't find this delivery method. Please choose one of the available methods.",
+	"SHIPPING_WEIGHT_NOT_SUPPORTED":       "This delivery method can't ship a cart of this size or weight. Please choose another method.",
+	"STOCK_RESERVATION_EXPIRED":           "Your checkout took too long and the reserved stock was released. Please try again.",
+	"TAX_RULE_NOT_FOUND":                  "We can't calculate taxes for your shipping address. Please check its country and region and try again.",
+}
+
+func NewErrorResponse(c echo.Context, err error) error {
+	var typedError *errs.Error
+	if !errors.As(err, &typedError) || typedError.Message == "" {
+		return NewInternalServerError(c, "Something went wrong. Please try again later.")
+	}
+
+	if fieldErrorMessage, ok := fieldErrorMessages[typedError.Code]; ok {
+		return NewBadRequestValidation(c, []string{fieldErrorMessage})
+	}
+
+	message, ok := errorMessages[typedError.Code]
+	if !ok {
+		message = strings.ToUpper(typedError.Message[:1]) + typedError.Message[1:] + "."
+	}
+
+	switch typedError.Kind {
+	case errs.KindNotFound:
+		return NewNotFound(c, message)
+	case errs.KindConflict:
+		return NewConflict(c, message)
+	case errs.KindValidation:
+		return NewBadRequest(c, message)
+	case errs.KindUnauthorized:
+		return NewUnauthorizedRequest(c, message)
+	case errs.KindPaymentRequired:
+		return NewPaymentRequired(c, message)
+	case errs.KindUnavailable:
+		return NewServiceUnavailable(c, message)
+	}
+
+	return NewInternalServerError(c, "Something went wrong. Please try again later.")
+}
@@ -0,0 +1,53 @@
+package webhttp_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/domain/errs"
+	webhttp "github.com/gsaaraujo/ecommerce-go/internal/infra/web-http"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors_NewErrorResponse_OnUnmappedCodes_ReturnsStatusByKindAndMessage(t *testing.T) {
+	errorsAndResponses := map[error]string{
+		errs.NotFound("WIDGET_NOT_FOUND", "widget not found"):                             `{"status": "ERROR", "statusCode": 404, "statusText": "NOT_FOUND", "error": "Widget not found."}`,
+		errs.Conflict("WIDGET_LOCKED", "widget is locked"):                                `{"status": "ERROR", "statusCode": 409, "statusText": "CONFLICT", "error": "Widget is locked."}`,
+		errs.Validation("WIDGET_SIZE_INVALID", "widget size is invalid"):                  `{"status": "ERROR", "statusCode": 400, "statusText": "BAD_REQUEST", "error": "Widget size is invalid."}`,
+		errs.Unauthorized("WIDGET_KEY_INVALID", "widget key is invalid"):                  `{"status": "ERROR", "statusCode": 401, "statusText": "UNAUTHORIZED", "error": "Widget key is invalid."}`,
+		errs.Unavailable("WIDGET_SERVICE_DOWN", "widget service is down"):                 `{"status": "ERROR", "statusCode": 503, "statusText": "SERVICE_UNAVAILABLE", "error": "Widget service is down."}`,
+		fmt.Errorf("checkout: %w", errs.NotFound("WIDGET_NOT_FOUND", "widget not found")): `{"status": "ERROR", "statusCode": 404, "statusText": "NOT_FOUND", "error": "Widget not found."}`,
+		errors.New("connection refused"):                                                  `{"status": "ERROR", "statusCode": 500, "statusText": "INTERNAL_SERVER_ERROR", "error": "Something went wrong. Please try again later."}`,
+	}
+
+	for err, response := range errorsAndResponses {
+		recorder := httptest.NewRecorder()
+		context := echo.New().NewContext(httptest.NewRequest("GET", "/", nil), recorder)
+
+		webhttp.NewErrorResponse(context, err)
+
+		assert.JSONEq(t, response, recorder.Body.String())
+	}
+}
+
+func TestErrors_NewErrorResponse_OnMappedCodes_ReturnsStatusByKindAndCodeMessage(t *testing.T) {
+	errorsAndResponses := map[error]string{
+		errs.Conflict("CART_EMPTY", "cart is empty"):                                             `{"status": "ERROR", "statusCode": 409, "statusText": "CONFLICT", "error": "Your cart is empty. Please add a product to your cart first."}`,
+		errs.NotFound("PRODUCT_NOT_FOUND", "product not found"):                                  `{"status": "ERROR", "statusCode": 404, "statusText": "NOT_FOUND", "error": "We couldn't find this product. Please check the product ID and try again."}`,
+		errs.PaymentRequired("PAYMENT_DECLINED", "payment declined"):                             `{"status": "ERROR", "statusCode": 402, "statusText": "PAYMENT_REQUIRED", "error": "Your payment was declined. Please use a different card and try again."}`,
+		errs.Validation("PRODUCT_NAME_EMPTY", "product name cannot be empty"):                    `{"status": "ERROR", "statusCode": 400, "statusText": "BAD_REQUEST", "errors": ["name cannot be empty"]}`,
+		fmt.Errorf("login: %w", errs.Unauthorized("INVALID_CREDENTIALS", "invalid credentials")): `{"status": "ERROR", "statusCode": 401, "statusText": "UNAUTHORIZED", "error": "Email or password is incorrect."}`,
+	}
+
+	for err, response := range errorsAndResponses {
+		recorder := httptest.NewRecorder()
+		context := echo.New().NewContext(httptest.NewRequest("GET", "/", nil), recorder)
+
+		webhttp.NewErrorResponse(context, err)
+
+		assert.JSONEq(t, response, recorder.Body.String())
+	}
+}
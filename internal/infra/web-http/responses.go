@@ -60,14 +60,23 @@ func NewUnauthorizedRequest(c echo.Context, errorMessage string) error {
 }
 
 func NewForbiddenRequest(c echo.Context, errorMessage string) error {
-	return c.JSON(401, ResponseError{
+	return c.JSON(403, ResponseError{
 		Status:       "ERROR",
-		StatusCode:   401,
+		StatusCode:   403,
 		StatusText:   "FORBIDDEN",
 		ErrorMessage: errorMessage,
 	})
 }
 
+func NewPaymentRequired(c echo.Context, errorMessage string) error {
+	return c.JSON(402, ResponseError{
+		Status:       "ERROR",
+		StatusCode:   402,
+		StatusText:   "PAYMENT_REQUIRED",
+		ErrorMessage: errorMessage,
+	})
+}
+
 func NewNotFound(c echo.Context, errorMessage string) error {
 	return c.JSON(404, ResponseError{
 		Status:       "ERROR",
@@ -94,3 +103,12 @@ func NewInternalServerError(c echo.Context, errorMessage string) error {
 		ErrorMessage: errorMessage,
 	})
 }
+
+func NewServiceUnavailable(c echo.Context, errorMessage string) error {
+	return c.JSON(503, ResponseError{
+		Status:       "ERROR",
+		StatusCode:   503,
+		StatusText:   "SERVICE_UNAVAILABLE",
+		ErrorMessage: errorMessage,
+	})
+}
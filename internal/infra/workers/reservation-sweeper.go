@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+)
+
+type ReservationSweeper struct {
+	Interval                   time.Duration
+	ReleaseExpiredReservations usecases.IReleaseExpiredReservations
+}
+
+func (r *ReservationSweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := r.ReleaseExpiredReservations.Execute(ctx)
+			if err != nil {
+				log.Printf("failed to release expired stock reservations: %v", err)
+			}
+		}
+	}
+}
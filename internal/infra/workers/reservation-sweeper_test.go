@@ -0,0 +1,81 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-go/internal/application/usecases"
+	"github.com/gsaaraujo/ecommerce-go/internal/infra/workers"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReleaseExpiredReservationsMock struct {
+	mock.Mock
+}
+
+func (r *ReleaseExpiredReservationsMock) Execute(ctx context.Context) (usecases.ReleaseExpiredReservationsOutput, error) {
+	args := r.Called(ctx)
+	return args.Get(0).(usecases.ReleaseExpiredReservationsOutput), args.Error(1)
+}
+
+type ReservationSweeperSuite struct {
+	suite.Suite
+	executions                     atomic.Int32
+	releaseExpiredReservationsMock ReleaseExpiredReservationsMock
+	reservationSweeper             workers.ReservationSweeper
+}
+
+func (r *ReservationSweeperSuite) SetupTest() {
+	r.executions.Store(0)
+	r.releaseExpiredReservationsMock = ReleaseExpiredReservationsMock{}
+	r.reservationSweeper = workers.ReservationSweeper{
+		Interval:                   time.Millisecond,
+		ReleaseExpiredReservations: &r.releaseExpiredReservationsMock,
+	}
+}
+
+func (r *ReservationSweeperSuite) runUntilExecutedTwice() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	go func() {
+		r.reservationSweeper.Start(ctx)
+		close(stopped)
+	}()
+
+	r.Eventually(func() bool {
+		return r.executions.Load() >= 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		r.Fail("reservation sweeper did not stop after its context was cancelled")
+	}
+}
+
+func (r *ReservationSweeperSuite) TestReservationSweeper_Start_OnEachTick_ReleasesExpiredReservations() {
+	r.releaseExpiredReservationsMock.On("Execute", mock.Anything).
+		Run(func(mock.Arguments) { r.executions.Add(1) }).
+		Return(usecases.ReleaseExpiredReservationsOutput{ReleasedReservations: 1}, nil)
+
+	r.runUntilExecutedTwice()
+}
+
+func (r *ReservationSweeperSuite) TestReservationSweeper_Start_OnUseCaseError_KeepsRunning() {
+	r.releaseExpiredReservationsMock.On("Execute", mock.Anything).
+		Run(func(mock.Arguments) { r.executions.Add(1) }).
+		Return(usecases.ReleaseExpiredReservationsOutput{}, errors.New("connection refused"))
+
+	r.runUntilExecutedTwice()
+}
+
+func TestReservationSweeper(t *testing.T) {
+	suite.Run(t, new(ReservationSweeperSuite))
+}
@@ -1,19 +1,69 @@
 CREATE TABLE IF NOT EXISTS customers (
   id UUID PRIMARY KEY,
+  email VARCHAR(320) UNIQUE,
+  password_hash VARCHAR(255) NOT NULL DEFAULT '',
+  role VARCHAR(32) NOT NULL DEFAULT 'customer',
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL,
+  family_id UUID NOT NULL,
+  token_hash CHAR(64) NOT NULL UNIQUE,
+  expires_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES customers (id)
+);
+
+CREATE TABLE IF NOT EXISTS revoked_access_tokens (
+  id VARCHAR(64) PRIMARY KEY,
+  expires_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS addresses (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL,
+  recipient_name VARCHAR(255) NOT NULL,
+  line1 VARCHAR(255) NOT NULL,
+  line2 VARCHAR(255) NOT NULL DEFAULT '',
+  city VARCHAR(128) NOT NULL,
+  region VARCHAR(64) NOT NULL DEFAULT '',
+  postal_code VARCHAR(16) NOT NULL,
+  country CHAR(2) NOT NULL,
+  default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+  default_billing BOOLEAN NOT NULL DEFAULT FALSE,
+  position INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES customers (id)
+);
+
 CREATE TABLE IF NOT EXISTS products (
   id UUID PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  sku VARCHAR(64) NOT NULL UNIQUE,
   price INTEGER NOT NULL,
+  currency CHAR(3) NOT NULL DEFAULT 'BRL',
+  tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+  weight_grams INTEGER NOT NULL DEFAULT 0,
+  length_cm INTEGER NOT NULL DEFAULT 0,
+  width_cm INTEGER NOT NULL DEFAULT 0,
+  height_cm INTEGER NOT NULL DEFAULT 0,
+  active BOOLEAN NOT NULL DEFAULT TRUE,
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS carts (
   id UUID PRIMARY KEY,
-  customer_id UUID NOT NULL UNIQUE,
+  customer_id UUID UNIQUE,
   total_price INTEGER NOT NULL,
   total_quantity INTEGER NOT NULL,
+  coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+  shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+  version INTEGER NOT NULL DEFAULT 0,
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
@@ -25,4 +75,94 @@ CREATE TABLE IF NOT EXISTS cart_items (
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
   FOREIGN KEY (cart_id) REFERENCES carts (id),
   FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+CREATE TABLE IF NOT EXISTS orders (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL,
+  status VARCHAR(32) NOT NULL,
+  payment_id VARCHAR(255) NOT NULL DEFAULT '',
+  coupon_code VARCHAR(64) NOT NULL DEFAULT '',
+  discount INTEGER NOT NULL DEFAULT 0,
+  total_price INTEGER NOT NULL,
+  total_quantity INTEGER NOT NULL,
+  currency CHAR(3) NOT NULL DEFAULT 'BRL',
+  tax_region VARCHAR(64) NOT NULL DEFAULT '',
+  total_tax INTEGER NOT NULL DEFAULT 0,
+  shipping_method VARCHAR(64) NOT NULL DEFAULT '',
+  shipping_cost INTEGER NOT NULL DEFAULT 0,
+  shipping_recipient_name VARCHAR(255) NOT NULL DEFAULT '',
+  shipping_line1 VARCHAR(255) NOT NULL DEFAULT '',
+  shipping_line2 VARCHAR(255) NOT NULL DEFAULT '',
+  shipping_city VARCHAR(128) NOT NULL DEFAULT '',
+  shipping_region VARCHAR(64) NOT NULL DEFAULT '',
+  shipping_postal_code VARCHAR(16) NOT NULL DEFAULT '',
+  shipping_country CHAR(2) NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (customer_id) REFERENCES customers (id)
+);
+
+CREATE TABLE IF NOT EXISTS order_lines (
+  id UUID PRIMARY KEY,
+  order_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  quantity INTEGER NOT NULL,
+  unit_price INTEGER NOT NULL,
+  tax_class VARCHAR(64) NOT NULL DEFAULT 'standard',
+  tax_rate INTEGER NOT NULL DEFAULT 0,
+  tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+  tax INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (order_id) REFERENCES orders (id),
+  FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+  id UUID PRIMARY KEY,
+  order_id UUID NOT NULL,
+  from_status VARCHAR(32) NOT NULL,
+  to_status VARCHAR(32) NOT NULL,
+  actor VARCHAR(255) NOT NULL,
+  changed_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (order_id) REFERENCES orders (id)
+);
+
+CREATE TABLE IF NOT EXISTS inventories (
+  product_id UUID PRIMARY KEY,
+  on_hand INTEGER NOT NULL DEFAULT 0 CHECK (on_hand >= 0),
+  reserved INTEGER NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+  updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  CHECK (reserved <= on_hand),
+  FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_reservations (
+  id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  quantity INTEGER NOT NULL CHECK (quantity > 0),
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id, product_id),
+  FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+CREATE INDEX IF NOT EXISTS stock_reservations_expires_at_idx ON stock_reservations (expires_at);
+
+CREATE TABLE IF NOT EXISTS promotions (
+  id UUID PRIMARY KEY,
+  code VARCHAR(64) NOT NULL UNIQUE,
+  type VARCHAR(32) NOT NULL,
+  percent_off INTEGER NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+  amount_off INTEGER NOT NULL DEFAULT 0,
+  buy_quantity INTEGER NOT NULL DEFAULT 0,
+  get_quantity INTEGER NOT NULL DEFAULT 0,
+  product_id UUID,
+  minimum_spend INTEGER NOT NULL DEFAULT 0,
+  currency CHAR(3) NOT NULL DEFAULT 'BRL',
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  usage_limit INTEGER NOT NULL DEFAULT 0,
+  times_used INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (product_id) REFERENCES products (id)
 );
\ No newline at end of file